@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure"
+	"github.com/Leon180/tabelogo-v2/pkg/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// runCatalogCommand runs the import, export or reindex subcommand and
+// returns the process exit code
+func runCatalogCommand(command string, args []string) int {
+	var err error
+	switch command {
+	case "import":
+		err = runImport(args)
+	case "export":
+		err = runExport(args)
+	case "reindex":
+		err = runReindex(args)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "restaurant-service %s: %v\n", command, err)
+		return 1
+	}
+	return 0
+}
+
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := flags.String("format", "csv", "file format: csv, jsonl or geojson")
+	input := flags.String("in", "-", "file to import, - for stdin")
+	dryRun := flags.Bool("dry-run", false, "validate and report without saving")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	catalogFormat, err := application.ParseCatalogFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	r := io.Reader(os.Stdin)
+	if *input != "-" {
+		f, err := os.Open(*input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return withCatalogService(func(ctx context.Context, service application.CatalogService) error {
+		report, err := service.Import(ctx, catalogFormat, r, application.ImportOptions{
+			DryRun:  *dryRun,
+			ActorID: "cli",
+		})
+		if report != nil {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if encErr := enc.Encode(report); encErr != nil {
+				return encErr
+			}
+		}
+		return err
+	})
+}
+
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "csv", "file format: csv, jsonl or geojson")
+	output := flags.String("out", "-", "file to write, - for stdout")
+	area := flags.String("area", "", "only export restaurants in this area")
+	cuisineType := flags.String("cuisine-type", "", "only export restaurants of this cuisine type")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	catalogFormat, err := application.ParseCatalogFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return withCatalogService(func(ctx context.Context, service application.CatalogService) error {
+		count, err := service.Export(ctx, catalogFormat, w, application.ExportFilter{
+			Area:        *area,
+			CuisineType: *cuisineType,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "exported %d restaurants\n", count)
+		return nil
+	})
+}
+
+func runReindex(args []string) error {
+	flags := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	return withCatalogService(func(ctx context.Context, service application.CatalogService) error {
+		count, err := service.Reindex(ctx)
+		fmt.Fprintf(os.Stderr, "reindexed %d restaurants\n", count)
+		return err
+	})
+}
+
+// withCatalogService starts only the dependencies of the catalog service
+// (configuration and database) and runs fn with it
+func withCatalogService(fn func(ctx context.Context, service application.CatalogService) error) error {
+	var service application.CatalogService
+	app := fx.New(
+		config.Module,
+		infrastructure.Module,
+		fx.Provide(
+			newCommandLogger,
+			application.NewCatalogService,
+		),
+		fx.Populate(&service),
+		fx.NopLogger,
+	)
+
+	startCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := app.Start(startCtx); err != nil {
+		return err
+	}
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = app.Stop(stopCtx)
+	}()
+
+	return fn(context.Background(), service)
+}
+
+// newCommandLogger logs to stderr so that stdout only carries command output
+func newCommandLogger() (*zap.Logger, error) {
+	cfg := zap.NewProductionConfig()
+	cfg.OutputPaths = []string{"stderr"}
+	return cfg.Build()
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/Leon180/tabelogo-v2/internal/restaurant"
 	"go.uber.org/fx"
 )
@@ -24,7 +26,22 @@ import (
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
+// The service runs when started without arguments. The import and export
+// subcommands load or dump restaurants in bulk, see -h of each for flags.
+// The reindex subcommand recomputes the data derived from every restaurant,
+// run it after migrations or taxonomy changes that need a backfill:
+//
+//	restaurant-service import -format csv -in restaurants.csv -dry-run
+//	restaurant-service export -format geojson -area Tokyo -out tokyo.geojson
+//	restaurant-service reindex
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import", "export", "reindex":
+			os.Exit(runCatalogCommand(os.Args[1], os.Args[2:]))
+		}
+	}
+
 	fx.New(
 		restaurant.Module,
 	).Run()
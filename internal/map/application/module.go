@@ -10,5 +10,6 @@ var Module = fx.Module("map.application",
 	fx.Provide(
 		usecases.NewQuickSearchUseCase,
 		usecases.NewAdvanceSearchUseCase,
+		usecases.NewPhotoMediaUseCase,
 	),
 )
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Leon180/tabelogo-v2/internal/map/infrastructure/external"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultPhotoMaxWidthPx is used when no maximum width is requested
+	DefaultPhotoMaxWidthPx = 1600
+
+	// maxPhotoWidthPx is the largest width the Places API serves
+	maxPhotoWidthPx = 4800
+)
+
+// ErrInvalidPhotoName is returned for names that are not place photo references
+var ErrInvalidPhotoName = errors.New("photo name must be a place photo reference")
+
+// PhotoMediaUseCase downloads place photos on behalf of other services
+type PhotoMediaUseCase struct {
+	placesClient external.PlacesClient
+	logger       *zap.Logger
+}
+
+// NewPhotoMediaUseCase creates a new PhotoMediaUseCase
+func NewPhotoMediaUseCase(
+	placesClient external.PlacesClient,
+	logger *zap.Logger,
+) *PhotoMediaUseCase {
+	return &PhotoMediaUseCase{
+		placesClient: placesClient,
+		logger:       logger.With(zap.String("usecase", "photo_media")),
+	}
+}
+
+// Execute downloads the photo with the given reference name
+func (uc *PhotoMediaUseCase) Execute(
+	ctx context.Context,
+	photoName string,
+	maxWidthPx int,
+) ([]byte, string, error) {
+	// Only place photo references are accepted so the endpoint cannot be
+	// used to call arbitrary Places API resources
+	if !strings.HasPrefix(photoName, "places/") || !strings.Contains(photoName, "/photos/") {
+		return nil, "", ErrInvalidPhotoName
+	}
+	if maxWidthPx <= 0 {
+		maxWidthPx = DefaultPhotoMaxWidthPx
+	}
+	if maxWidthPx > maxPhotoWidthPx {
+		maxWidthPx = maxPhotoWidthPx
+	}
+
+	data, contentType, err := uc.placesClient.GetPhotoMedia(ctx, photoName, maxWidthPx)
+	if err != nil {
+		uc.logger.Error("Failed to download photo",
+			zap.String("photo_name", photoName),
+			zap.Error(err),
+		)
+		return nil, "", err
+	}
+
+	uc.logger.Info("Photo downloaded",
+		zap.String("photo_name", photoName),
+		zap.Int("bytes", len(data)),
+	)
+
+	return data, contentType, nil
+}
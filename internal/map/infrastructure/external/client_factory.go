@@ -2,6 +2,9 @@ package external
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"strings"
 
@@ -27,6 +30,38 @@ type PlacesClient interface {
 		languageCode string,
 		fieldMask string,
 	) (map[string]interface{}, error)
+
+	// GetPhotoMedia downloads a place photo by its reference name and
+	// returns the image bytes and their content type
+	GetPhotoMedia(
+		ctx context.Context,
+		photoName string,
+		maxWidthPx int,
+	) ([]byte, string, error)
+}
+
+// maxPhotoBytes caps the size of a downloaded photo
+const maxPhotoBytes = 10 << 20
+
+// readPhoto reads a photo response body, failing on non-image or oversized content
+func readPhoto(resp *http.Response) ([]byte, string, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("photo download error: status %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, "", fmt.Errorf("photo download returned %q instead of an image", contentType)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxPhotoBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read photo: %w", err)
+	}
+	if len(data) > maxPhotoBytes {
+		return nil, "", fmt.Errorf("photo exceeds %d bytes", maxPhotoBytes)
+	}
+	return data, contentType, nil
 }
 
 // NewPlacesClient creates a Places API client based on configuration
@@ -59,7 +59,8 @@ func (c *GooglePlacesClient) GetPlaceDetails(
 		req.Header.Set("X-Goog-FieldMask", fieldMask)
 	} else {
 		// Default field mask - includes addressComponents for area extraction
-		req.Header.Set("X-Goog-FieldMask", "id,displayName,formattedAddress,location,rating,priceLevel,photos,currentOpeningHours,addressComponents")
+		// and editorialSummary for localized summaries
+		req.Header.Set("X-Goog-FieldMask", "id,displayName,formattedAddress,location,rating,priceLevel,photos,currentOpeningHours,regularOpeningHours,addressComponents,editorialSummary")
 	}
 
 	c.logger.Info("Calling Google Places API",
@@ -157,7 +158,7 @@ func (c *GooglePlacesClient) TextSearch(
 		req.Header.Set("X-Goog-FieldMask", fieldMask)
 	} else {
 		// Default field mask - includes addressComponents for area extraction
-		req.Header.Set("X-Goog-FieldMask", "places.id,places.displayName,places.formattedAddress,places.location,places.rating,places.priceLevel,places.currentOpeningHours,places.addressComponents")
+		req.Header.Set("X-Goog-FieldMask", "places.id,places.displayName,places.formattedAddress,places.location,places.rating,places.priceLevel,places.currentOpeningHours,places.regularOpeningHours,places.addressComponents")
 	}
 
 	c.logger.Info("Calling Google Text Search API",
@@ -205,3 +206,73 @@ func (c *GooglePlacesClient) TextSearch(
 
 	return result, nil
 }
+
+// GetPhotoMedia downloads a place photo. The media endpoint is asked for the
+// photo URI instead of a redirect so the API key is never sent to the
+// image host.
+func (c *GooglePlacesClient) GetPhotoMedia(
+	ctx context.Context,
+	photoName string,
+	maxWidthPx int,
+) ([]byte, string, error) {
+	url := fmt.Sprintf("https://places.googleapis.com/v1/%s/media", photoName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		c.logger.Error("Failed to create request", zap.Error(err))
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("maxWidthPx", fmt.Sprintf("%d", maxWidthPx))
+	q.Add("skipHttpRedirect", "true")
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("X-Goog-Api-Key", c.apiKey)
+
+	c.logger.Info("Calling Google Place Photos API",
+		zap.String("photo_name", photoName),
+		zap.Int("max_width_px", maxWidthPx),
+	)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("Photo media request failed", zap.Error(err))
+		return nil, "", fmt.Errorf("photo media request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.logger.Error("Failed to read response body", zap.Error(err))
+		return nil, "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Warn("Google Place Photos API returned non-200 status",
+			zap.Int("status", resp.StatusCode),
+			zap.String("body", string(body)),
+		)
+		return nil, "", fmt.Errorf("google photo media error: status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var media struct {
+		PhotoURI string `json:"photoUri"`
+	}
+	if err := json.Unmarshal(body, &media); err != nil || media.PhotoURI == "" {
+		c.logger.Error("Failed to parse photo media response", zap.Error(err))
+		return nil, "", fmt.Errorf("failed to parse photo media response")
+	}
+
+	imageReq, err := http.NewRequestWithContext(ctx, "GET", media.PhotoURI, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	imageResp, err := c.httpClient.Do(imageReq)
+	if err != nil {
+		c.logger.Error("Photo download failed", zap.Error(err))
+		return nil, "", fmt.Errorf("photo download failed: %w", err)
+	}
+	defer imageResp.Body.Close()
+
+	return readPhoto(imageResp)
+}
@@ -168,3 +168,32 @@ func (c *MockPlacesClient) TextSearch(
 
 	return result, nil
 }
+
+// GetPhotoMedia downloads a place photo from Mock service
+func (c *MockPlacesClient) GetPhotoMedia(
+	ctx context.Context,
+	photoName string,
+	maxWidthPx int,
+) ([]byte, string, error) {
+	url := fmt.Sprintf("%s/v1/%s/media?maxWidthPx=%d", c.baseURL, photoName, maxWidthPx)
+
+	c.logger.Info("Calling Mock Place Photos API",
+		zap.String("photo_name", photoName),
+		zap.String("url", url),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		c.logger.Error("Failed to create request", zap.Error(err))
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("Mock photo media request failed", zap.Error(err))
+		return nil, "", fmt.Errorf("mock photo media request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return readPhoto(resp)
+}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	mapv1 "github.com/Leon180/tabelogo-v2/api/gen/map/v1"
 	"github.com/Leon180/tabelogo-v2/internal/map/application/usecases"
@@ -16,6 +18,7 @@ type Server struct {
 	mapv1.UnimplementedMapServiceServer
 	quickSearchUC   *usecases.QuickSearchUseCase
 	advanceSearchUC *usecases.AdvanceSearchUseCase
+	photoMediaUC    *usecases.PhotoMediaUseCase
 	logger          *zap.Logger
 }
 
@@ -23,11 +26,13 @@ type Server struct {
 func NewServer(
 	quickSearchUC *usecases.QuickSearchUseCase,
 	advanceSearchUC *usecases.AdvanceSearchUseCase,
+	photoMediaUC *usecases.PhotoMediaUseCase,
 	logger *zap.Logger,
 ) *Server {
 	return &Server{
 		quickSearchUC:   quickSearchUC,
 		advanceSearchUC: advanceSearchUC,
+		photoMediaUC:    photoMediaUC,
 		logger:          logger.With(zap.String("component", "grpc_server")),
 	}
 }
@@ -214,6 +219,39 @@ func (s *Server) BatchGetPlaces(
 	return resp, nil
 }
 
+// GetPhotoMedia implements the GetPhotoMedia RPC method
+func (s *Server) GetPhotoMedia(
+	ctx context.Context,
+	req *mapv1.GetPhotoMediaRequest,
+) (*mapv1.GetPhotoMediaResponse, error) {
+	s.logger.Info("GetPhotoMedia gRPC called",
+		zap.String("photo_name", req.PhotoName),
+		zap.Int32("max_width_px", req.MaxWidthPx),
+	)
+
+	// Validate request
+	if req.PhotoName == "" {
+		return nil, status.Error(codes.InvalidArgument, "photo_name is required")
+	}
+	if req.MaxWidthPx < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_width_px must not be negative")
+	}
+
+	data, contentType, err := s.photoMediaUC.Execute(ctx, req.PhotoName, int(req.MaxWidthPx))
+	if errors.Is(err, usecases.ErrInvalidPhotoName) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("GetPhotoMedia failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to download photo")
+	}
+
+	return &mapv1.GetPhotoMediaResponse{
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
 // convertToProtoPlace converts a map[string]interface{} to a proto Place
 func convertToProtoPlace(data map[string]interface{}) *mapv1.Place {
 	place := &mapv1.Place{}
@@ -300,6 +338,18 @@ func convertToProtoPlace(data map[string]interface{}) *mapv1.Place {
 		}
 	}
 
+	// Extract the regular weekly periods and the dates the place is closed
+	// despite them, e.g. public holidays
+	if hours, ok := data["regularOpeningHours"].(map[string]interface{}); ok {
+		if place.OpeningHours == nil {
+			place.OpeningHours = &mapv1.OpeningHours{}
+		}
+		place.OpeningHours.Periods = convertOpeningPeriods(hours)
+	}
+	if hours, ok := data["currentOpeningHours"].(map[string]interface{}); ok && place.OpeningHours != nil {
+		place.OpeningHours.ClosedDates = closedSpecialDays(hours)
+	}
+
 	// Extract photos
 	if photos, ok := data["photos"].([]interface{}); ok {
 		for _, p := range photos {
@@ -362,3 +412,82 @@ func getFloat64(m map[string]interface{}, key string) float64 {
 	}
 	return 0
 }
+
+// convertOpeningPeriods converts the periods of a Places API opening hours object
+func convertOpeningPeriods(hours map[string]interface{}) []*mapv1.OpeningPeriod {
+	periods, ok := hours["periods"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]*mapv1.OpeningPeriod, 0, len(periods))
+	for _, p := range periods {
+		periodMap, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		open, ok := periodMap["open"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		period := &mapv1.OpeningPeriod{Open: convertOpeningPoint(open)}
+		if closing, ok := periodMap["close"].(map[string]interface{}); ok {
+			period.Close = convertOpeningPoint(closing)
+		}
+		result = append(result, period)
+	}
+	return result
+}
+
+func convertOpeningPoint(point map[string]interface{}) *mapv1.OpeningPoint {
+	return &mapv1.OpeningPoint{
+		Day:    int32(getFloat64(point, "day")),
+		Hour:   int32(getFloat64(point, "hour")),
+		Minute: int32(getFloat64(point, "minute")),
+	}
+}
+
+// closedSpecialDays returns the special days of current opening hours on
+// which no period opens, i.e. the days the place is closed
+func closedSpecialDays(hours map[string]interface{}) []string {
+	specialDays, ok := hours["specialDays"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	openDates := make(map[string]bool)
+	if periods, ok := hours["periods"].([]interface{}); ok {
+		for _, p := range periods {
+			if periodMap, ok := p.(map[string]interface{}); ok {
+				if open, ok := periodMap["open"].(map[string]interface{}); ok {
+					if date := formatPlacesDate(open["date"]); date != "" {
+						openDates[date] = true
+					}
+				}
+			}
+		}
+	}
+
+	var closed []string
+	for _, d := range specialDays {
+		if dayMap, ok := d.(map[string]interface{}); ok {
+			if date := formatPlacesDate(dayMap["date"]); date != "" && !openDates[date] {
+				closed = append(closed, date)
+			}
+		}
+	}
+	return closed
+}
+
+// formatPlacesDate formats a Places API date object as YYYY-MM-DD
+func formatPlacesDate(v interface{}) string {
+	date, ok := v.(map[string]interface{})
+	if !ok || getFloat64(date, "year") == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d",
+		int(getFloat64(date, "year")),
+		int(getFloat64(date, "month")),
+		int(getFloat64(date, "day")),
+	)
+}
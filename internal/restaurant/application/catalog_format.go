@@ -0,0 +1,458 @@
+package application
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+)
+
+// CatalogFormat is a file format restaurants are imported and exported in
+type CatalogFormat string
+
+const (
+	CatalogFormatCSV     CatalogFormat = "csv"
+	CatalogFormatJSONL   CatalogFormat = "jsonl"
+	CatalogFormatGeoJSON CatalogFormat = "geojson"
+)
+
+// ParseCatalogFormat parses a format name
+func ParseCatalogFormat(s string) (CatalogFormat, error) {
+	switch f := CatalogFormat(strings.ToLower(s)); f {
+	case CatalogFormatCSV, CatalogFormatJSONL, CatalogFormatGeoJSON:
+		return f, nil
+	}
+	return "", domainerrors.ErrInvalidCatalogFormat
+}
+
+// ContentType returns the MIME type of the format
+func (f CatalogFormat) ContentType() string {
+	switch f {
+	case CatalogFormatCSV:
+		return "text/csv; charset=utf-8"
+	case CatalogFormatGeoJSON:
+		return "application/geo+json"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// CatalogRecord is a restaurant as a row of an import or export file.
+// ID and UpdatedAt are only written on export and ignored on import.
+// Latitude and Longitude are pointers so that missing coordinates can be
+// told apart from 0.
+type CatalogRecord struct {
+	ID          string   `json:"id,omitempty"`
+	Source      string   `json:"source"`
+	ExternalID  string   `json:"external_id"`
+	Name        string   `json:"name"`
+	NameJa      string   `json:"name_ja,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	Address     string   `json:"address,omitempty"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+	Rating      float64  `json:"rating,omitempty"`
+	PriceRange  string   `json:"price_range,omitempty"`
+	CuisineType string   `json:"cuisine_type,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	Website     string   `json:"website,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+}
+
+// catalogColumns are the CSV columns, in export order
+var catalogColumns = []string{
+	"id", "source", "external_id", "name", "name_ja", "area", "address",
+	"latitude", "longitude", "rating", "price_range", "cuisine_type",
+	"phone", "website", "updated_at",
+}
+
+// requiredImportColumns must be present in the header of an imported CSV
+var requiredImportColumns = []string{"source", "external_id", "name", "latitude", "longitude"}
+
+// catalogReader reads the records of an import file one at a time. Next
+// returns io.EOF after the last record. A *catalogRowError describes a
+// malformed record the reader can skip; any other error ends the import.
+type catalogReader interface {
+	Next() (*CatalogRecord, error)
+}
+
+// catalogWriter writes the records of an export file
+type catalogWriter interface {
+	Write(record *CatalogRecord) error
+	// Close terminates the file; it does not close the underlying writer
+	Close() error
+}
+
+// catalogRowError is a record that could not be parsed
+type catalogRowError struct {
+	message string
+}
+
+func (e *catalogRowError) Error() string { return e.message }
+
+func newCatalogReader(format CatalogFormat, r io.Reader) (catalogReader, error) {
+	switch format {
+	case CatalogFormatCSV:
+		return newCSVCatalogReader(r)
+	case CatalogFormatJSONL:
+		return newJSONLCatalogReader(r), nil
+	case CatalogFormatGeoJSON:
+		return newGeoJSONCatalogReader(r)
+	}
+	return nil, domainerrors.ErrInvalidCatalogFormat
+}
+
+func newCatalogWriter(format CatalogFormat, w io.Writer) (catalogWriter, error) {
+	switch format {
+	case CatalogFormatCSV:
+		return newCSVCatalogWriter(w)
+	case CatalogFormatJSONL:
+		return &jsonlCatalogWriter{enc: json.NewEncoder(w)}, nil
+	case CatalogFormatGeoJSON:
+		return &geoJSONCatalogWriter{w: w}, nil
+	}
+	return nil, domainerrors.ErrInvalidCatalogFormat
+}
+
+// CSV
+
+type csvCatalogReader struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVCatalogReader(r io.Reader) (*csvCatalogReader, error) {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading CSV header: %w", domainerrors.ErrInvalidImportFile, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheet tools often prefix UTF-8 files with a byte order mark
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredImportColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: CSV header has no %q column", domainerrors.ErrInvalidImportFile, name)
+		}
+	}
+
+	return &csvCatalogReader{r: cr, columns: columns}, nil
+}
+
+func (c *csvCatalogReader) Next() (*CatalogRecord, error) {
+	row, err := c.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &catalogRowError{message: parseErr.Err.Error()}
+		}
+		return nil, err
+	}
+
+	field := func(name string) string {
+		if i, ok := c.columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	record := &CatalogRecord{
+		Source:      field("source"),
+		ExternalID:  field("external_id"),
+		Name:        field("name"),
+		NameJa:      field("name_ja"),
+		Area:        field("area"),
+		Address:     field("address"),
+		PriceRange:  field("price_range"),
+		CuisineType: field("cuisine_type"),
+		Phone:       field("phone"),
+		Website:     field("website"),
+	}
+	if record.Latitude, err = parseOptionalFloat("latitude", field("latitude")); err != nil {
+		return nil, err
+	}
+	if record.Longitude, err = parseOptionalFloat("longitude", field("longitude")); err != nil {
+		return nil, err
+	}
+	rating, err := parseOptionalFloat("rating", field("rating"))
+	if err != nil {
+		return nil, err
+	}
+	if rating != nil {
+		record.Rating = *rating
+	}
+	return record, nil
+}
+
+func parseOptionalFloat(column, value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, &catalogRowError{message: fmt.Sprintf("%s is not a number: %q", column, value)}
+	}
+	return &f, nil
+}
+
+type csvCatalogWriter struct {
+	w *csv.Writer
+}
+
+func newCSVCatalogWriter(w io.Writer) (*csvCatalogWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(catalogColumns); err != nil {
+		return nil, err
+	}
+	return &csvCatalogWriter{w: cw}, nil
+}
+
+func (c *csvCatalogWriter) Write(record *CatalogRecord) error {
+	return c.w.Write([]string{
+		record.ID,
+		record.Source,
+		record.ExternalID,
+		record.Name,
+		record.NameJa,
+		record.Area,
+		record.Address,
+		formatOptionalFloat(record.Latitude),
+		formatOptionalFloat(record.Longitude),
+		strconv.FormatFloat(record.Rating, 'f', -1, 64),
+		record.PriceRange,
+		record.CuisineType,
+		record.Phone,
+		record.Website,
+		record.UpdatedAt,
+	})
+}
+
+func (c *csvCatalogWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func formatOptionalFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+// JSON Lines
+
+// maxJSONLLineBytes bounds a single JSON Lines record
+const maxJSONLLineBytes = 1 << 20
+
+type jsonlCatalogReader struct {
+	scanner *bufio.Scanner
+}
+
+func newJSONLCatalogReader(r io.Reader) *jsonlCatalogReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxJSONLLineBytes)
+	return &jsonlCatalogReader{scanner: scanner}
+}
+
+func (j *jsonlCatalogReader) Next() (*CatalogRecord, error) {
+	for j.scanner.Scan() {
+		line := bytes.TrimSpace(j.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var record CatalogRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, &catalogRowError{message: "invalid JSON: " + err.Error()}
+		}
+		return &record, nil
+	}
+	if err := j.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domainerrors.ErrInvalidImportFile, err)
+	}
+	return nil, io.EOF
+}
+
+type jsonlCatalogWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonlCatalogWriter) Write(record *CatalogRecord) error {
+	return j.enc.Encode(record)
+}
+
+func (j *jsonlCatalogWriter) Close() error { return nil }
+
+// GeoJSON
+
+// geoJSONFeature is a restaurant as a GeoJSON Point feature. The coordinates
+// are kept in the geometry only, so the properties omit them.
+type geoJSONFeature struct {
+	Type       string             `json:"type"`
+	Geometry   *geoJSONPoint      `json:"geometry"`
+	Properties *geoJSONProperties `json:"properties"`
+}
+
+type geoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"` // [longitude, latitude]
+}
+
+type geoJSONProperties struct {
+	CatalogRecord
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// geoJSONCatalogReader streams the features of a FeatureCollection without
+// loading the whole document
+type geoJSONCatalogReader struct {
+	dec        *json.Decoder
+	inFeatures bool
+	done       bool
+}
+
+func newGeoJSONCatalogReader(r io.Reader) (*geoJSONCatalogReader, error) {
+	g := &geoJSONCatalogReader{dec: json.NewDecoder(r)}
+	if err := g.expectDelim('{'); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *geoJSONCatalogReader) Next() (*CatalogRecord, error) {
+	if g.done {
+		return nil, io.EOF
+	}
+	if !g.inFeatures {
+		if err := g.seekFeatures(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !g.dec.More() {
+		// End of the features array; the rest of the document is not needed
+		if err := g.expectDelim(']'); err != nil {
+			return nil, err
+		}
+		g.done = true
+		return nil, io.EOF
+	}
+
+	var feature geoJSONFeature
+	if err := g.dec.Decode(&feature); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			// The decoder has consumed the whole feature and can go on
+			return nil, &catalogRowError{message: "invalid feature: " + err.Error()}
+		}
+		return nil, fmt.Errorf("%w: %w", domainerrors.ErrInvalidImportFile, err)
+	}
+	if feature.Properties == nil {
+		return nil, &catalogRowError{message: "feature has no properties"}
+	}
+
+	record := feature.Properties.CatalogRecord
+	if feature.Geometry != nil {
+		if feature.Geometry.Type != "Point" || len(feature.Geometry.Coordinates) < 2 {
+			return nil, &catalogRowError{message: "geometry must be a Point"}
+		}
+		lng, lat := feature.Geometry.Coordinates[0], feature.Geometry.Coordinates[1]
+		record.Latitude, record.Longitude = &lat, &lng
+	}
+	return &record, nil
+}
+
+// seekFeatures advances the decoder into the "features" array, skipping
+// the other members of the collection
+func (g *geoJSONCatalogReader) seekFeatures() error {
+	for g.dec.More() {
+		token, err := g.dec.Token()
+		if err != nil {
+			return fmt.Errorf("%w: %w", domainerrors.ErrInvalidImportFile, err)
+		}
+		if key, ok := token.(string); ok && key == "features" {
+			if err := g.expectDelim('['); err != nil {
+				return err
+			}
+			g.inFeatures = true
+			return nil
+		}
+
+		var skip json.RawMessage
+		if err := g.dec.Decode(&skip); err != nil {
+			return fmt.Errorf("%w: %w", domainerrors.ErrInvalidImportFile, err)
+		}
+	}
+	return fmt.Errorf("%w: GeoJSON document has no features", domainerrors.ErrInvalidImportFile)
+}
+
+func (g *geoJSONCatalogReader) expectDelim(delim json.Delim) error {
+	token, err := g.dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %w", domainerrors.ErrInvalidImportFile, err)
+	}
+	if token != delim {
+		return fmt.Errorf("%w: expected %q in GeoJSON document, got %v", domainerrors.ErrInvalidImportFile, delim, token)
+	}
+	return nil
+}
+
+type geoJSONCatalogWriter struct {
+	w        io.Writer
+	features int
+}
+
+func (g *geoJSONCatalogWriter) Write(record *CatalogRecord) error {
+	prefix := ",\n"
+	if g.features == 0 {
+		prefix = `{"type":"FeatureCollection","features":[` + "\n"
+	}
+
+	feature := geoJSONFeature{
+		Type:       "Feature",
+		Properties: &geoJSONProperties{CatalogRecord: *record},
+	}
+	if record.Latitude != nil && record.Longitude != nil {
+		feature.Geometry = &geoJSONPoint{
+			Type:        "Point",
+			Coordinates: []float64{*record.Longitude, *record.Latitude},
+		}
+	}
+	data, err := json.Marshal(feature)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(g.w, prefix); err != nil {
+		return err
+	}
+	if _, err := g.w.Write(data); err != nil {
+		return err
+	}
+	g.features++
+	return nil
+}
+
+func (g *geoJSONCatalogWriter) Close() error {
+	end := "\n]}\n"
+	if g.features == 0 {
+		end = `{"type":"FeatureCollection","features":[]}` + "\n"
+	}
+	_, err := io.WriteString(g.w, end)
+	return err
+}
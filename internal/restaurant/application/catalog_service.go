@@ -0,0 +1,477 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxImportRowErrors caps the row errors kept in an import report; rows
+	// beyond it are still counted as failed
+	MaxImportRowErrors = 1000
+
+	// MaxBatchSize caps the restaurants of a batch get or upsert
+	MaxBatchSize = 500
+
+	// exportPageSize is the number of restaurants read per repository call
+	exportPageSize = 500
+)
+
+// ImportOptions controls an import run
+type ImportOptions struct {
+	// DryRun validates the file and reports what would change without saving
+	DryRun bool
+	// ActorID is recorded on the revisions of updated restaurants
+	ActorID string
+}
+
+// ImportRowError is a row that was rejected
+type ImportRowError struct {
+	Row        int // 1-based position of the record in the file, header excluded
+	ExternalID string
+	Message    string
+}
+
+// ImportReport summarizes an import run
+type ImportReport struct {
+	DryRun          bool
+	Total           int
+	Created         int
+	Updated         int
+	Unchanged       int
+	Failed          int
+	Errors          []ImportRowError
+	ErrorsTruncated bool // more than MaxImportRowErrors rows failed
+}
+
+// ExportFilter selects the restaurants to export. Empty fields are not applied.
+type ExportFilter struct {
+	Area         string
+	CuisineType  string
+	Source       model.RestaurantSource
+	UpdatedSince *time.Time
+}
+
+// UpsertOutcome is what happened to an upserted restaurant
+type UpsertOutcome string
+
+const (
+	UpsertCreated   UpsertOutcome = "created"
+	UpsertUpdated   UpsertOutcome = "updated"
+	UpsertUnchanged UpsertOutcome = "unchanged"
+	UpsertFailed    UpsertOutcome = "failed"
+)
+
+// UpsertResult is the outcome of one record of a batch upsert. Restaurant is
+// the saved restaurant unless the record failed, in which case Error says why.
+type UpsertResult struct {
+	Outcome    UpsertOutcome
+	Restaurant *model.Restaurant
+	Error      string
+}
+
+// CatalogService gives bulk access to restaurants: import and export of
+// CSV, JSON Lines or GeoJSON files, and the batch operations internal
+// services use to sync restaurants. Files are streamed record by record
+// instead of being loaded whole, so large catalogs can be processed.
+type CatalogService interface {
+	// Import upserts every record of the file on (source, external_id).
+	// Invalid rows are reported and skipped; a malformed file or a
+	// repository failure stops the import and is returned along with the
+	// report of the rows processed so far.
+	Import(ctx context.Context, format CatalogFormat, r io.Reader, opts ImportOptions) (*ImportReport, error)
+
+	// Export writes the restaurants matching the filter and returns how many
+	// were written
+	Export(ctx context.Context, format CatalogFormat, w io.Writer, filter ExportFilter) (int, error)
+
+	// BatchGet finds restaurants by ID and by external identity. Identities
+	// of merged restaurants resolve to the canonical restaurant; IDs and
+	// identities without a restaurant are left out of the maps.
+	BatchGet(ctx context.Context, ids []uuid.UUID, refs []repository.ExternalRef) (map[uuid.UUID]*model.Restaurant, map[repository.ExternalRef]*model.Restaurant, error)
+
+	// BatchUpsert upserts records on (source, external_id) like Import and
+	// returns one result per record, in order. A failed record does not stop
+	// the others.
+	BatchUpsert(ctx context.Context, records []*CatalogRecord, opts ImportOptions) ([]UpsertResult, error)
+
+	// Stream calls fn with every restaurant matching the filter, newest
+	// first, and returns how many were streamed. It stops at the first error
+	// of fn.
+	Stream(ctx context.Context, filter ExportFilter, fn func(*model.Restaurant) error) (int, error)
+
+	// Reindex recomputes the derived data of every restaurant, the cuisine
+	// categories its cuisine type names and its search text, and returns how
+	// many restaurants were reindexed. Run it after changing model.Cuisines
+	// or the search tokenization of textnorm.
+	Reindex(ctx context.Context) (int, error)
+}
+
+type catalogService struct {
+	restaurantRepo repository.RestaurantRepository
+	revisionRepo   repository.RevisionRepository
+	logger         *zap.Logger
+}
+
+// NewCatalogService creates a new catalog service
+func NewCatalogService(
+	restaurantRepo repository.RestaurantRepository,
+	revisionRepo repository.RevisionRepository,
+	logger *zap.Logger,
+) CatalogService {
+	return &catalogService{
+		restaurantRepo: restaurantRepo,
+		revisionRepo:   revisionRepo,
+		logger:         logger,
+	}
+}
+
+func (s *catalogService) Import(ctx context.Context, format CatalogFormat, r io.Reader, opts ImportOptions) (*ImportReport, error) {
+	reader, err := newCatalogReader(format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{DryRun: opts.DryRun, Errors: []ImportRowError{}}
+	// The rows of each key, so that a key repeated in the file is rejected
+	// instead of silently overwriting the earlier row
+	seen := make(map[string]int)
+
+	for row := 1; ; row++ {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var rowErr *catalogRowError
+		if err != nil && !errors.As(err, &rowErr) {
+			return report, err
+		}
+
+		report.Total++
+		if rowErr != nil {
+			report.addError(ImportRowError{Row: row, Message: rowErr.Error()})
+			continue
+		}
+
+		if msg := validateCatalogRecord(record); msg != "" {
+			report.addError(ImportRowError{Row: row, ExternalID: record.ExternalID, Message: msg})
+			continue
+		}
+		key := record.Source + "/" + record.ExternalID
+		if first, ok := seen[key]; ok {
+			report.addError(ImportRowError{
+				Row:        row,
+				ExternalID: record.ExternalID,
+				Message:    fmt.Sprintf("duplicate of row %d", first),
+			})
+			continue
+		}
+		seen[key] = row
+
+		outcome, _, err := s.upsert(ctx, record, opts)
+		if err != nil {
+			return report, fmt.Errorf("row %d: %w", row, err)
+		}
+		switch outcome {
+		case UpsertCreated:
+			report.Created++
+		case UpsertUpdated:
+			report.Updated++
+		default:
+			report.Unchanged++
+		}
+	}
+
+	s.logger.Info("Restaurants imported",
+		zap.String("format", string(format)),
+		zap.Bool("dry_run", opts.DryRun),
+		zap.Int("total", report.Total),
+		zap.Int("created", report.Created),
+		zap.Int("updated", report.Updated),
+		zap.Int("unchanged", report.Unchanged),
+		zap.Int("failed", report.Failed),
+	)
+
+	return report, nil
+}
+
+func (r *ImportReport) addError(e ImportRowError) {
+	r.Failed++
+	if len(r.Errors) < MaxImportRowErrors {
+		r.Errors = append(r.Errors, e)
+	} else {
+		r.ErrorsTruncated = true
+	}
+}
+
+// validateCatalogRecord returns why a record cannot be imported, or "" if it can
+func validateCatalogRecord(record *CatalogRecord) string {
+	switch {
+	case !model.RestaurantSource(record.Source).IsValid():
+		return fmt.Sprintf("invalid source %q", record.Source)
+	case record.ExternalID == "":
+		return "external_id is required"
+	case record.Name == "":
+		return "name is required"
+	case record.Latitude == nil || record.Longitude == nil:
+		return "latitude and longitude are required"
+	case record.Rating < 0 || record.Rating > 5:
+		return "rating must be between 0 and 5"
+	}
+	if _, err := model.NewLocation(*record.Latitude, *record.Longitude); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// upsert creates the restaurant of a record or updates the existing one.
+// Empty optional fields of the record keep the stored values.
+func (s *catalogService) upsert(ctx context.Context, record *CatalogRecord, opts ImportOptions) (UpsertOutcome, *model.Restaurant, error) {
+	// Records are validated, so the location is valid
+	location, _ := model.NewLocation(*record.Latitude, *record.Longitude)
+	source := model.RestaurantSource(record.Source)
+
+	restaurant, err := s.restaurantRepo.FindByExternalID(ctx, source, record.ExternalID)
+	if err != nil && !errors.Is(err, domainerrors.ErrRestaurantNotFound) {
+		return UpsertFailed, nil, err
+	}
+
+	if restaurant == nil {
+		restaurant = model.NewRestaurant(record.Name, record.Area, source, record.ExternalID, record.Address, location)
+		applyCatalogRecord(restaurant, record)
+		if !opts.DryRun {
+			if err := s.restaurantRepo.Create(ctx, restaurant); err != nil {
+				return UpsertFailed, nil, err
+			}
+		}
+		return UpsertCreated, restaurant, nil
+	}
+
+	before := restaurant.TrackedFields()
+	restaurant.UpdateName(record.Name)
+	restaurant.UpdateLocation(location)
+	if record.Area != "" {
+		restaurant.UpdateArea(record.Area)
+	}
+	if record.Address != "" {
+		restaurant.UpdateAddress(record.Address)
+	}
+	applyCatalogRecord(restaurant, record)
+
+	if len(model.DiffFields(before, restaurant.TrackedFields())) == 0 {
+		return UpsertUnchanged, restaurant, nil
+	}
+	if !opts.DryRun {
+		err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, model.RevisionSourceImport, opts.ActorID)
+		if err != nil {
+			return UpsertFailed, nil, err
+		}
+	}
+	return UpsertUpdated, restaurant, nil
+}
+
+// applyCatalogRecord sets the optional fields a record has values for
+func applyCatalogRecord(restaurant *model.Restaurant, record *CatalogRecord) {
+	if record.NameJa != "" {
+		restaurant.UpdateNameJa(record.NameJa)
+	}
+	if record.Rating > 0 {
+		restaurant.UpdateRating(record.Rating)
+	}
+	if record.PriceRange != "" {
+		restaurant.UpdatePriceRange(record.PriceRange)
+	}
+	if record.CuisineType != "" {
+		restaurant.UpdateCuisineType(record.CuisineType)
+	}
+	if record.Phone != "" {
+		restaurant.UpdatePhone(record.Phone)
+	}
+	if record.Website != "" {
+		restaurant.UpdateWebsite(record.Website)
+	}
+}
+
+func (s *catalogService) Export(ctx context.Context, format CatalogFormat, w io.Writer, filter ExportFilter) (int, error) {
+	writer, err := newCatalogWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := s.Stream(ctx, filter, func(r *model.Restaurant) error {
+		return writer.Write(toCatalogRecord(r))
+	})
+	if err != nil {
+		return count, err
+	}
+	if err := writer.Close(); err != nil {
+		return count, err
+	}
+
+	s.logger.Info("Restaurants exported",
+		zap.String("format", string(format)),
+		zap.String("area", filter.Area),
+		zap.String("cuisine_type", filter.CuisineType),
+		zap.Int("count", count),
+	)
+
+	return count, nil
+}
+
+func (s *catalogService) BatchGet(ctx context.Context, ids []uuid.UUID, refs []repository.ExternalRef) (map[uuid.UUID]*model.Restaurant, map[repository.ExternalRef]*model.Restaurant, error) {
+	if len(ids)+len(refs) > MaxBatchSize {
+		return nil, nil, domainerrors.ErrBatchTooLarge
+	}
+
+	byID := make(map[uuid.UUID]*model.Restaurant, len(ids))
+	if len(ids) > 0 {
+		restaurants, err := s.restaurantRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, r := range restaurants {
+			byID[r.ID()] = r
+		}
+	}
+
+	byRef := make(map[repository.ExternalRef]*model.Restaurant, len(refs))
+	if len(refs) > 0 {
+		found, err := s.restaurantRepo.FindByExternalIDs(ctx, refs)
+		if err != nil {
+			return nil, nil, err
+		}
+		byRef = found
+	}
+
+	return byID, byRef, nil
+}
+
+func (s *catalogService) BatchUpsert(ctx context.Context, records []*CatalogRecord, opts ImportOptions) ([]UpsertResult, error) {
+	if len(records) > MaxBatchSize {
+		return nil, domainerrors.ErrBatchTooLarge
+	}
+
+	results := make([]UpsertResult, len(records))
+	seen := make(map[string]int, len(records))
+	for i, record := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if msg := validateCatalogRecord(record); msg != "" {
+			results[i] = UpsertResult{Outcome: UpsertFailed, Error: msg}
+			continue
+		}
+		key := record.Source + "/" + record.ExternalID
+		if first, ok := seen[key]; ok {
+			results[i] = UpsertResult{Outcome: UpsertFailed, Error: fmt.Sprintf("duplicate of item %d", first)}
+			continue
+		}
+		seen[key] = i
+
+		outcome, restaurant, err := s.upsert(ctx, record, opts)
+		if err != nil {
+			s.logger.Error("Failed to upsert restaurant",
+				zap.String("source", record.Source),
+				zap.String("external_id", record.ExternalID),
+				zap.Error(err),
+			)
+			results[i] = UpsertResult{Outcome: UpsertFailed, Error: "internal error"}
+			continue
+		}
+		results[i] = UpsertResult{Outcome: outcome, Restaurant: restaurant}
+	}
+
+	return results, nil
+}
+
+func (s *catalogService) Stream(ctx context.Context, filter ExportFilter, fn func(*model.Restaurant) error) (int, error) {
+	// Creation order keeps the keyset pages stable while restaurants are
+	// updated during the stream
+	query := repository.RestaurantFilter{
+		Area:         filter.Area,
+		CuisineType:  filter.CuisineType,
+		Source:       filter.Source,
+		UpdatedSince: filter.UpdatedSince,
+		Sort:         repository.SortByNewest,
+	}
+
+	count := 0
+	var cursor *repository.Cursor
+	for {
+		page, next, err := s.restaurantRepo.FindPageByFilter(ctx, query, cursor, exportPageSize)
+		if err != nil {
+			return count, err
+		}
+		for _, item := range page {
+			if err := fn(item.Restaurant); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if next == nil {
+			return count, nil
+		}
+		cursor = next
+	}
+}
+
+func (s *catalogService) Reindex(ctx context.Context) (int, error) {
+	count := 0
+	var cursor *repository.Cursor
+	for {
+		page, next, err := s.restaurantRepo.ListAfter(ctx, cursor, exportPageSize)
+		if err != nil {
+			return count, err
+		}
+		for _, r := range page {
+			// Adds the category the cuisine type names; categories set by
+			// the map refresh or a Tabelog match are kept
+			r.UpdateCuisineType(r.CuisineType())
+		}
+		if err := s.restaurantRepo.Reindex(ctx, page); err != nil {
+			return count, err
+		}
+		count += len(page)
+		if next == nil {
+			return count, nil
+		}
+		cursor = next
+	}
+}
+
+func toCatalogRecord(r *model.Restaurant) *CatalogRecord {
+	record := &CatalogRecord{
+		ID:          r.ID().String(),
+		Source:      string(r.Source()),
+		ExternalID:  r.ExternalID(),
+		Name:        r.Name(),
+		NameJa:      r.NameJa(),
+		Area:        r.Area(),
+		Address:     r.Address(),
+		Rating:      r.Rating(),
+		PriceRange:  r.PriceRange(),
+		CuisineType: r.CuisineType(),
+		Phone:       r.Phone(),
+		Website:     r.Website(),
+		UpdatedAt:   r.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+	if loc := r.Location(); loc != nil {
+		lat, lng := loc.Latitude(), loc.Longitude()
+		record.Latitude, record.Longitude = &lat, &lng
+	}
+	return record
+}
@@ -0,0 +1,357 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestCatalogService() (CatalogService, *MockRestaurantRepository, *MockRevisionRepository) {
+	restaurantRepo := new(MockRestaurantRepository)
+	revisionRepo := new(MockRevisionRepository)
+	return NewCatalogService(restaurantRepo, revisionRepo, zap.NewNop()), restaurantRepo, revisionRepo
+}
+
+const testImportCSV = `source,external_id,name,latitude,longitude,rating,cuisine_type
+google,new-1,Afuri,35.6467,139.7101,4.2,Ramen
+google,existing,Ichiran Shibuya,35.6595,139.7005,,
+google,same,Kyubey,35.6700,139.7600,,
+tabelog,,No ID,35.0,139.0,,
+yelp,y-1,Wrong Source,35.0,139.0,,
+google,bad-loc,Nowhere,95.0,139.0,,
+google,bad-num,Typo,35.1,east,,
+google,new-1,Afuri Again,35.6467,139.7101,,
+`
+
+// Test Import
+func TestCatalogService_Import_CSV(t *testing.T) {
+	service, restaurantRepo, revisionRepo := newTestCatalogService()
+	ctx := context.Background()
+	existing := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	same := newTestRestaurant("Kyubey", "", model.SourceGoogle, 35.67, 139.76)
+
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "new-1").Return(nil, domainerrors.ErrRestaurantNotFound)
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "existing").Return(existing, nil)
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "same").Return(same, nil)
+	restaurantRepo.On("Create", ctx, mock.MatchedBy(func(r *model.Restaurant) bool {
+		return r.ExternalID() == "new-1" && r.Rating() == 4.2 && r.CuisineType() == "Ramen"
+	})).Return(nil)
+	revisionRepo.On("UpdateWithRevision", ctx, existing, mock.MatchedBy(func(rev *model.RestaurantRevision) bool {
+		return rev.Source() == model.RevisionSourceImport && rev.ActorID() == "admin-1"
+	})).Return(nil)
+
+	report, err := service.Import(ctx, CatalogFormatCSV, strings.NewReader(testImportCSV), ImportOptions{ActorID: "admin-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, report.Total)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, 1, report.Updated)
+	assert.Equal(t, 1, report.Unchanged)
+	assert.Equal(t, 5, report.Failed)
+	assert.Equal(t, "Ichiran Shibuya", existing.Name())
+
+	rows := make([]int, len(report.Errors))
+	for i, e := range report.Errors {
+		rows[i] = e.Row
+	}
+	assert.Equal(t, []int{4, 5, 6, 7, 8}, rows)
+	assert.Equal(t, "duplicate of row 1", report.Errors[4].Message)
+	restaurantRepo.AssertExpectations(t)
+	revisionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_Import_DryRun(t *testing.T) {
+	service, restaurantRepo, revisionRepo := newTestCatalogService()
+	ctx := context.Background()
+	existing := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	input := `{"source":"google","external_id":"existing","name":"Ichiran Shibuya","latitude":35.6595,"longitude":139.7005}
+
+{"source":"google","external_id":"new-1","name":"Afuri","latitude":35.6467,"longitude":139.7101}
+{"source":"google",`
+
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "existing").Return(existing, nil)
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "new-1").Return(nil, domainerrors.ErrRestaurantNotFound)
+
+	report, err := service.Import(ctx, CatalogFormatJSONL, strings.NewReader(input), ImportOptions{DryRun: true})
+
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, 1, report.Updated)
+	require.Len(t, report.Errors, 1)
+	assert.Equal(t, 3, report.Errors[0].Row)
+	restaurantRepo.AssertNotCalled(t, "Create")
+	restaurantRepo.AssertNotCalled(t, "Update")
+	revisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestCatalogService_Import_GeoJSON(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	input := `{
+		"type": "FeatureCollection",
+		"name": "tokyo",
+		"features": [
+			{"type": "Feature", "geometry": {"type": "Point", "coordinates": [139.7101, 35.6467]},
+			 "properties": {"source": "tabelog", "external_id": "t-1", "name": "Afuri", "area": "Tokyo"}},
+			{"type": "Feature", "geometry": {"type": "Point", "coordinates": "here"},
+			 "properties": {"source": "tabelog", "external_id": "t-2", "name": "Broken"}},
+			{"type": "Feature", "geometry": null,
+			 "properties": {"source": "tabelog", "external_id": "t-3", "name": "No Geometry"}}
+		]
+	}`
+
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceTabelog, "t-1").Return(nil, domainerrors.ErrRestaurantNotFound)
+	restaurantRepo.On("Create", ctx, mock.MatchedBy(func(r *model.Restaurant) bool {
+		return r.Area() == "Tokyo" && r.Location().Latitude() == 35.6467 && r.Location().Longitude() == 139.7101
+	})).Return(nil)
+
+	report, err := service.Import(ctx, CatalogFormatGeoJSON, strings.NewReader(input), ImportOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 1, report.Created)
+	require.Len(t, report.Errors, 2)
+	assert.Equal(t, "t-3", report.Errors[1].ExternalID)
+	restaurantRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_Import_RepositoryErrorKeepsReport(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	input := `{"source":"google","external_id":"new-1","name":"Afuri","latitude":35.6467,"longitude":139.7101}
+{"source":"google","external_id":"new-2","name":"Kyubey","latitude":35.67,"longitude":139.76}
+`
+	dbErr := errors.New("connection reset")
+
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "new-1").Return(nil, domainerrors.ErrRestaurantNotFound)
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "new-2").Return(nil, dbErr)
+	restaurantRepo.On("Create", ctx, mock.AnythingOfType("*model.Restaurant")).Return(nil).Once()
+
+	report, err := service.Import(ctx, CatalogFormatJSONL, strings.NewReader(input), ImportOptions{})
+
+	assert.ErrorIs(t, err, dbErr)
+	require.NotNil(t, report)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, 1, report.Created)
+}
+
+func TestCatalogService_Import_InvalidFile(t *testing.T) {
+	service, _, _ := newTestCatalogService()
+
+	tests := []struct {
+		name   string
+		format CatalogFormat
+		input  string
+	}{
+		{"csv without required column", CatalogFormatCSV, "source,external_id,name\ngoogle,1,A\n"},
+		{"empty csv", CatalogFormatCSV, ""},
+		{"geojson without features", CatalogFormatGeoJSON, `{"type":"FeatureCollection"}`},
+		{"geojson array", CatalogFormatGeoJSON, `[]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Import(context.Background(), tt.format, strings.NewReader(tt.input), ImportOptions{})
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidImportFile)
+		})
+	}
+}
+
+// Test Export
+func TestCatalogService_Export_GeoJSON(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	first := newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7101)
+	second := newTestRestaurant("Kyubey", "", model.SourceTabelog, 35.67, 139.76)
+	cursor := &repository.Cursor{Order: "newest"}
+	filter := repository.RestaurantFilter{Area: "Tokyo", Sort: repository.SortByNewest}
+
+	restaurantRepo.On("FindPageByFilter", ctx, filter, (*repository.Cursor)(nil), exportPageSize).
+		Return([]*repository.FilteredRestaurant{{Restaurant: first}}, cursor, nil)
+	restaurantRepo.On("FindPageByFilter", ctx, filter, cursor, exportPageSize).
+		Return([]*repository.FilteredRestaurant{{Restaurant: second}}, nil, nil)
+
+	var out bytes.Buffer
+	count, err := service.Export(ctx, CatalogFormatGeoJSON, &out, ExportFilter{Area: "Tokyo"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	var collection struct {
+		Type     string
+		Features []struct {
+			Geometry struct {
+				Coordinates []float64
+			}
+			Properties map[string]interface{}
+		}
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &collection))
+	assert.Equal(t, "FeatureCollection", collection.Type)
+	require.Len(t, collection.Features, 2)
+	assert.Equal(t, []float64{139.7101, 35.6467}, collection.Features[0].Geometry.Coordinates)
+	assert.Equal(t, "Afuri", collection.Features[0].Properties["name"])
+	assert.NotContains(t, collection.Features[0].Properties, "latitude")
+	assert.Equal(t, "tabelog", collection.Features[1].Properties["source"])
+}
+
+func TestCatalogService_Export_RoundTrip(t *testing.T) {
+	for _, format := range []CatalogFormat{CatalogFormatCSV, CatalogFormatJSONL, CatalogFormatGeoJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			service, restaurantRepo, _ := newTestCatalogService()
+			ctx := context.Background()
+			restaurant := newTestRestaurant("Afuri, Ebisu", "", model.SourceGoogle, 35.6467, 139.7101)
+			restaurant.UpdateCuisineType("Ramen")
+
+			restaurantRepo.On("FindPageByFilter", ctx, mock.Anything, (*repository.Cursor)(nil), exportPageSize).
+				Return([]*repository.FilteredRestaurant{{Restaurant: restaurant}}, nil, nil)
+			restaurantRepo.On("FindByExternalID", ctx, restaurant.Source(), restaurant.ExternalID()).Return(restaurant, nil)
+
+			var out bytes.Buffer
+			_, err := service.Export(ctx, format, &out, ExportFilter{})
+			require.NoError(t, err)
+
+			// Importing an export changes nothing
+			report, err := service.Import(ctx, format, &out, ImportOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.Total)
+			assert.Equal(t, 1, report.Unchanged, report.Errors)
+		})
+	}
+}
+
+func TestParseCatalogFormat(t *testing.T) {
+	format, err := ParseCatalogFormat("GeoJSON")
+	require.NoError(t, err)
+	assert.Equal(t, CatalogFormatGeoJSON, format)
+
+	_, err = ParseCatalogFormat("xlsx")
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidCatalogFormat)
+}
+
+// Test BatchGet
+func TestCatalogService_BatchGet(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	byID := newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7101)
+	byRef := newTestRestaurant("Kyubey", "", model.SourceTabelog, 35.67, 139.76)
+	missingID := uuid.New()
+	ref := repository.ExternalRef{Source: model.SourceTabelog, ExternalID: byRef.ExternalID()}
+	missingRef := repository.ExternalRef{Source: model.SourceGoogle, ExternalID: "gone"}
+
+	restaurantRepo.On("FindByIDs", ctx, []uuid.UUID{byID.ID(), missingID}).Return([]*model.Restaurant{byID}, nil)
+	restaurantRepo.On("FindByExternalIDs", ctx, []repository.ExternalRef{ref, missingRef}).
+		Return(map[repository.ExternalRef]*model.Restaurant{ref: byRef}, nil)
+
+	foundByID, foundByRef, err := service.BatchGet(ctx, []uuid.UUID{byID.ID(), missingID}, []repository.ExternalRef{ref, missingRef})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]*model.Restaurant{byID.ID(): byID}, foundByID)
+	assert.Equal(t, map[repository.ExternalRef]*model.Restaurant{ref: byRef}, foundByRef)
+}
+
+func TestCatalogService_BatchGet_TooLarge(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+
+	_, _, err := service.BatchGet(context.Background(), make([]uuid.UUID, MaxBatchSize), make([]repository.ExternalRef, 1))
+
+	assert.ErrorIs(t, err, domainerrors.ErrBatchTooLarge)
+	restaurantRepo.AssertNotCalled(t, "FindByIDs")
+}
+
+// Test BatchUpsert
+func TestCatalogService_BatchUpsert(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	lat, lng := 35.6467, 139.7101
+	records := []*CatalogRecord{
+		{Source: "google", ExternalID: "new-1", Name: "Afuri", Latitude: &lat, Longitude: &lng},
+		{Source: "google", ExternalID: "new-2", Name: "No Location"},
+		{Source: "google", ExternalID: "new-1", Name: "Afuri Again", Latitude: &lat, Longitude: &lng},
+		{Source: "google", ExternalID: "broken", Name: "Broken", Latitude: &lat, Longitude: &lng},
+	}
+
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "new-1").Return(nil, domainerrors.ErrRestaurantNotFound)
+	restaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, "broken").Return(nil, errors.New("connection reset"))
+	restaurantRepo.On("Create", ctx, mock.AnythingOfType("*model.Restaurant")).Return(nil)
+
+	results, err := service.BatchUpsert(ctx, records, ImportOptions{})
+
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	assert.Equal(t, UpsertCreated, results[0].Outcome)
+	assert.Equal(t, "new-1", results[0].Restaurant.ExternalID())
+	assert.Equal(t, UpsertResult{Outcome: UpsertFailed, Error: "latitude and longitude are required"}, results[1])
+	assert.Equal(t, UpsertResult{Outcome: UpsertFailed, Error: "duplicate of item 0"}, results[2])
+	// Repository errors are not exposed to callers
+	assert.Equal(t, UpsertResult{Outcome: UpsertFailed, Error: "internal error"}, results[3])
+	restaurantRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+// Test Stream
+func TestCatalogService_Stream_StopsOnCallbackError(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	since := time.Now().Add(-time.Hour)
+	first := newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7101)
+	second := newTestRestaurant("Kyubey", "", model.SourceGoogle, 35.67, 139.76)
+	filter := repository.RestaurantFilter{Source: model.SourceGoogle, UpdatedSince: &since, Sort: repository.SortByNewest}
+
+	restaurantRepo.On("FindPageByFilter", ctx, filter, (*repository.Cursor)(nil), exportPageSize).
+		Return([]*repository.FilteredRestaurant{{Restaurant: first}, {Restaurant: second}}, nil, nil)
+
+	sendErr := errors.New("client gone")
+	var streamed []string
+	count, err := service.Stream(ctx, ExportFilter{Source: model.SourceGoogle, UpdatedSince: &since}, func(r *model.Restaurant) error {
+		if len(streamed) == 1 {
+			return sendErr
+		}
+		streamed = append(streamed, r.Name())
+		return nil
+	})
+
+	assert.ErrorIs(t, err, sendErr)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"Afuri"}, streamed)
+}
+
+func TestCatalogService_Reindex_AddsCuisineCategories(t *testing.T) {
+	service, restaurantRepo, _ := newTestCatalogService()
+	ctx := context.Background()
+	// Written before cuisine categories existed
+	ramen := newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7101)
+	ramen.UpdateCuisineType("Ramen")
+	ramen.SetCuisines(nil)
+	sushi := newTestRestaurant("Kyubey", "", model.SourceGoogle, 35.67, 139.76)
+	sushi.UpdateCuisineType("Sushi")
+	cursor := &repository.Cursor{ID: ramen.ID()}
+
+	restaurantRepo.On("ListAfter", ctx, (*repository.Cursor)(nil), exportPageSize).
+		Return([]*model.Restaurant{ramen}, cursor, nil)
+	restaurantRepo.On("ListAfter", ctx, cursor, exportPageSize).
+		Return([]*model.Restaurant{sushi}, nil, nil)
+	restaurantRepo.On("Reindex", ctx, []*model.Restaurant{ramen}).Return(nil)
+	restaurantRepo.On("Reindex", ctx, []*model.Restaurant{sushi}).Return(nil)
+
+	count, err := service.Reindex(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"ramen"}, ramen.Cuisines())
+	assert.Equal(t, []string{"sushi"}, sushi.Cuisines())
+	restaurantRepo.AssertExpectations(t)
+}
@@ -0,0 +1,396 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CollectionDetail is a collection with its favorites in order
+type CollectionDetail struct {
+	Collection *model.Collection
+	Items      []*model.CollectionItem
+}
+
+// SharedList is a collection published as a public list, with the full data
+// of its restaurants in order
+type SharedList struct {
+	Collection *model.Collection
+	Items      []*SharedListItem
+}
+
+// SharedListItem is a restaurant at its place in a shared list
+type SharedListItem struct {
+	Restaurant *model.Restaurant
+	Position   int
+	AddedAt    time.Time
+}
+
+// UpdateCollectionRequest changes the fields that are set
+type UpdateCollectionRequest struct {
+	Name        *string
+	Description *string
+}
+
+// CollectionService manages named lists of a user's favorites. Every method
+// takes the owner's ID; another user's collection is reported as not found.
+type CollectionService interface {
+	CreateCollection(ctx context.Context, userID uuid.UUID, name, description string) (*model.Collection, error)
+	ListCollections(ctx context.Context, userID uuid.UUID) ([]*model.Collection, error)
+	GetCollection(ctx context.Context, userID, collectionID uuid.UUID) (*CollectionDetail, error)
+	UpdateCollection(ctx context.Context, userID, collectionID uuid.UUID, req UpdateCollectionRequest) (*model.Collection, error)
+	DeleteCollection(ctx context.Context, userID, collectionID uuid.UUID) error
+
+	// AddToCollection appends the user's favorite of the restaurant to the
+	// collection; the restaurant must be among the user's favorites
+	AddToCollection(ctx context.Context, userID, collectionID, restaurantID uuid.UUID) (*CollectionDetail, error)
+	RemoveFromCollection(ctx context.Context, userID, collectionID, restaurantID uuid.UUID) error
+
+	// ReorderCollection orders the collection by restaurant ID; the IDs must
+	// list every restaurant in the collection exactly once
+	ReorderCollection(ctx context.Context, userID, collectionID uuid.UUID, restaurantIDs []uuid.UUID) (*CollectionDetail, error)
+
+	// ShareCollection publishes the collection as a read-only public list.
+	// Sharing a shared collection keeps its slug.
+	ShareCollection(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error)
+
+	// RotateShareSlug gives a shared collection a new slug; links with the
+	// old slug stop working
+	RotateShareSlug(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error)
+
+	// UnshareCollection revokes the public list
+	UnshareCollection(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error)
+
+	// GetSharedList returns the public list with the slug, for anyone
+	GetSharedList(ctx context.Context, slug string) (*SharedList, error)
+}
+
+type collectionService struct {
+	collectionRepo repository.CollectionRepository
+	favoriteRepo   repository.FavoriteRepository
+	restaurantRepo repository.RestaurantRepository
+	logger         *zap.Logger
+}
+
+// NewCollectionService creates a new collection service
+func NewCollectionService(
+	collectionRepo repository.CollectionRepository,
+	favoriteRepo repository.FavoriteRepository,
+	restaurantRepo repository.RestaurantRepository,
+	logger *zap.Logger,
+) CollectionService {
+	return &collectionService{
+		collectionRepo: collectionRepo,
+		favoriteRepo:   favoriteRepo,
+		restaurantRepo: restaurantRepo,
+		logger:         logger,
+	}
+}
+
+func (s *collectionService) CreateCollection(ctx context.Context, userID uuid.UUID, name, description string) (*model.Collection, error) {
+	if !model.IsValidCollectionName(name) {
+		return nil, domainerrors.ErrInvalidCollectionName
+	}
+
+	collection := model.NewCollection(userID, name, description)
+	if err := s.collectionRepo.Create(ctx, collection); err != nil {
+		if !errors.Is(err, domainerrors.ErrCollectionAlreadyExists) {
+			s.logger.Error("Failed to create collection", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Collection created",
+		zap.String("collection_id", collection.ID().String()),
+		zap.String("user_id", userID.String()),
+	)
+	return collection, nil
+}
+
+func (s *collectionService) ListCollections(ctx context.Context, userID uuid.UUID) ([]*model.Collection, error) {
+	collections, err := s.collectionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list collections", zap.Error(err))
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+func (s *collectionService) GetCollection(ctx context.Context, userID, collectionID uuid.UUID) (*CollectionDetail, error) {
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.detail(ctx, collection)
+}
+
+func (s *collectionService) UpdateCollection(ctx context.Context, userID, collectionID uuid.UUID, req UpdateCollectionRequest) (*model.Collection, error) {
+	if req.Name != nil && !model.IsValidCollectionName(*req.Name) {
+		return nil, domainerrors.ErrInvalidCollectionName
+	}
+
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		collection.Rename(*req.Name)
+	}
+	if req.Description != nil {
+		collection.UpdateDescription(*req.Description)
+	}
+
+	if err := s.collectionRepo.Update(ctx, collection); err != nil {
+		if !errors.Is(err, domainerrors.ErrCollectionAlreadyExists) {
+			s.logger.Error("Failed to update collection", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func (s *collectionService) DeleteCollection(ctx context.Context, userID, collectionID uuid.UUID) error {
+	if _, err := s.ownedCollection(ctx, userID, collectionID); err != nil {
+		return err
+	}
+
+	if err := s.collectionRepo.Delete(ctx, collectionID); err != nil {
+		s.logger.Error("Failed to delete collection", zap.Error(err))
+		return err
+	}
+
+	s.logger.Info("Collection deleted", zap.String("collection_id", collectionID.String()))
+	return nil
+}
+
+func (s *collectionService) AddToCollection(ctx context.Context, userID, collectionID, restaurantID uuid.UUID) (*CollectionDetail, error) {
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite, err := s.favoriteRepo.FindByUserAndRestaurant(ctx, userID, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.AddItem(ctx, collectionID, favorite.ID()); err != nil {
+		s.logger.Error("Failed to add favorite to collection", zap.Error(err))
+		return nil, err
+	}
+
+	return s.detail(ctx, collection)
+}
+
+func (s *collectionService) RemoveFromCollection(ctx context.Context, userID, collectionID, restaurantID uuid.UUID) error {
+	if _, err := s.ownedCollection(ctx, userID, collectionID); err != nil {
+		return err
+	}
+
+	favorite, err := s.favoriteRepo.FindByUserAndRestaurant(ctx, userID, restaurantID)
+	if errors.Is(err, domainerrors.ErrFavoriteNotFound) {
+		return domainerrors.ErrNotInCollection
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.collectionRepo.RemoveItem(ctx, collectionID, favorite.ID()); err != nil {
+		if !errors.Is(err, domainerrors.ErrNotInCollection) {
+			s.logger.Error("Failed to remove favorite from collection", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *collectionService) ReorderCollection(ctx context.Context, userID, collectionID uuid.UUID, restaurantIDs []uuid.UUID) (*CollectionDetail, error) {
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.collectionRepo.ListItems(ctx, collectionID)
+	if err != nil {
+		s.logger.Error("Failed to list collection items", zap.Error(err))
+		return nil, err
+	}
+
+	favoriteIDs, ok := collectionOrder(items, restaurantIDs)
+	if !ok {
+		return nil, domainerrors.ErrInvalidCollectionOrder
+	}
+
+	if err := s.collectionRepo.ReorderItems(ctx, collectionID, favoriteIDs); err != nil {
+		s.logger.Error("Failed to reorder collection", zap.Error(err))
+		return nil, err
+	}
+
+	return s.detail(ctx, collection)
+}
+
+func (s *collectionService) ShareCollection(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error) {
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.IsShared() {
+		return collection, nil
+	}
+
+	return s.share(ctx, collection)
+}
+
+func (s *collectionService) RotateShareSlug(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error) {
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.IsShared() {
+		return nil, domainerrors.ErrCollectionNotShared
+	}
+
+	return s.share(ctx, collection)
+}
+
+func (s *collectionService) share(ctx context.Context, collection *model.Collection) (*model.Collection, error) {
+	slug, err := model.NewShareSlug()
+	if err != nil {
+		s.logger.Error("Failed to generate share slug", zap.Error(err))
+		return nil, err
+	}
+	collection.Share(slug)
+
+	if err := s.collectionRepo.Update(ctx, collection); err != nil {
+		s.logger.Error("Failed to share collection", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Collection shared", zap.String("collection_id", collection.ID().String()))
+	return collection, nil
+}
+
+func (s *collectionService) UnshareCollection(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error) {
+	collection, err := s.ownedCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.IsShared() {
+		return collection, nil
+	}
+
+	collection.Unshare()
+	if err := s.collectionRepo.Update(ctx, collection); err != nil {
+		s.logger.Error("Failed to unshare collection", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Collection unshared", zap.String("collection_id", collection.ID().String()))
+	return collection, nil
+}
+
+func (s *collectionService) GetSharedList(ctx context.Context, slug string) (*SharedList, error) {
+	if slug == "" {
+		return nil, domainerrors.ErrSharedListNotFound
+	}
+
+	collection, err := s.collectionRepo.FindByShareSlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.collectionRepo.ListItems(ctx, collection.ID())
+	if err != nil {
+		s.logger.Error("Failed to list shared list items", zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.Favorite.RestaurantID()
+	}
+	var restaurants []*model.Restaurant
+	if len(ids) > 0 {
+		restaurants, err = s.restaurantRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			s.logger.Error("Failed to find shared list restaurants", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	byID := make(map[uuid.UUID]*model.Restaurant, len(restaurants))
+	for _, r := range restaurants {
+		byID[r.ID()] = r
+	}
+
+	// Restaurants deleted since they were saved are left out
+	list := &SharedList{Collection: collection, Items: make([]*SharedListItem, 0, len(items))}
+	for _, item := range items {
+		if r, ok := byID[item.Favorite.RestaurantID()]; ok {
+			list.Items = append(list.Items, &SharedListItem{
+				Restaurant: r,
+				Position:   item.Position,
+				AddedAt:    item.AddedAt,
+			})
+		}
+	}
+	return list, nil
+}
+
+// collectionOrder maps the restaurant IDs to the favorite IDs of the items,
+// reporting false unless they list every item exactly once
+func collectionOrder(items []*model.CollectionItem, restaurantIDs []uuid.UUID) ([]uuid.UUID, bool) {
+	if len(restaurantIDs) != len(items) {
+		return nil, false
+	}
+
+	byRestaurant := make(map[uuid.UUID]uuid.UUID, len(items))
+	for _, item := range items {
+		byRestaurant[item.Favorite.RestaurantID()] = item.Favorite.ID()
+	}
+
+	favoriteIDs := make([]uuid.UUID, 0, len(restaurantIDs))
+	for _, restaurantID := range restaurantIDs {
+		favoriteID, ok := byRestaurant[restaurantID]
+		if !ok {
+			return nil, false
+		}
+		delete(byRestaurant, restaurantID)
+		favoriteIDs = append(favoriteIDs, favoriteID)
+	}
+	return favoriteIDs, true
+}
+
+// ownedCollection finds a collection of the user. Another user's collection is
+// reported as not found so its existence is not revealed.
+func (s *collectionService) ownedCollection(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error) {
+	collection, err := s.collectionRepo.FindByID(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.UserID() != userID {
+		return nil, domainerrors.ErrCollectionNotFound
+	}
+	return collection, nil
+}
+
+func (s *collectionService) detail(ctx context.Context, collection *model.Collection) (*CollectionDetail, error) {
+	items, err := s.collectionRepo.ListItems(ctx, collection.ID())
+	if err != nil {
+		s.logger.Error("Failed to list collection items", zap.Error(err))
+		return nil, err
+	}
+
+	return &CollectionDetail{Collection: collection, Items: items}, nil
+}
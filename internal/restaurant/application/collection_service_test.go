@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestCollectionService(t *testing.T) (CollectionService, *mocks.MockCollectionRepository, *mocks.MockFavoriteRepository) {
+	service, collectionRepo, favoriteRepo, _ := newTestSharingService(t)
+	return service, collectionRepo, favoriteRepo
+}
+
+func newTestSharingService(t *testing.T) (CollectionService, *mocks.MockCollectionRepository, *mocks.MockFavoriteRepository, *mocks.MockRestaurantRepository) {
+	ctrl := gomock.NewController(t)
+	collectionRepo := mocks.NewMockCollectionRepository(ctrl)
+	favoriteRepo := mocks.NewMockFavoriteRepository(ctrl)
+	restaurantRepo := mocks.NewMockRestaurantRepository(ctrl)
+	service := NewCollectionService(collectionRepo, favoriteRepo, restaurantRepo, zap.NewNop())
+	return service, collectionRepo, favoriteRepo, restaurantRepo
+}
+
+func newCollectionItems(favorites ...*model.Favorite) []*model.CollectionItem {
+	items := make([]*model.CollectionItem, len(favorites))
+	for i, f := range favorites {
+		items[i] = &model.CollectionItem{Favorite: f, Position: i}
+	}
+	return items
+}
+
+// Test CreateCollection
+func TestCollectionService_CreateCollection(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	collectionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	collection, err := service.CreateCollection(ctx, userID, "  Osaka trip ", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Osaka trip", collection.Name())
+	assert.Equal(t, userID, collection.UserID())
+}
+
+func TestCollectionService_CreateCollection_InvalidName(t *testing.T) {
+	service, _, _ := newTestCollectionService(t)
+
+	_, err := service.CreateCollection(context.Background(), uuid.New(), "   ", "")
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidCollectionName)
+}
+
+// Test GetCollection
+func TestCollectionService_GetCollection_OtherUser(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	collection := model.NewCollection(uuid.New(), "Osaka trip", "")
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+
+	_, err := service.GetCollection(ctx, uuid.New(), collection.ID())
+	assert.ErrorIs(t, err, domainerrors.ErrCollectionNotFound)
+}
+
+// Test UpdateCollection
+func TestCollectionService_UpdateCollection(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "spring")
+	name := "Kyoto trip"
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+	collectionRepo.EXPECT().Update(ctx, collection).Return(nil)
+
+	updated, err := service.UpdateCollection(ctx, userID, collection.ID(), UpdateCollectionRequest{Name: &name})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Kyoto trip", updated.Name())
+	assert.Equal(t, "spring", updated.Description(), "unset fields are kept")
+}
+
+// Test AddToCollection
+func TestCollectionService_AddToCollection(t *testing.T) {
+	service, collectionRepo, favoriteRepo := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+	favorite := model.NewFavorite(userID, uuid.New())
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+	favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, favorite.RestaurantID()).Return(favorite, nil)
+	collectionRepo.EXPECT().AddItem(ctx, collection.ID(), favorite.ID()).Return(nil)
+	collectionRepo.EXPECT().ListItems(ctx, collection.ID()).Return(newCollectionItems(favorite), nil)
+
+	detail, err := service.AddToCollection(ctx, userID, collection.ID(), favorite.RestaurantID())
+
+	require.NoError(t, err)
+	require.Len(t, detail.Items, 1)
+	assert.Equal(t, favorite.ID(), detail.Items[0].Favorite.ID())
+}
+
+func TestCollectionService_AddToCollection_NotFavorite(t *testing.T) {
+	service, collectionRepo, favoriteRepo := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+	favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(nil, domainerrors.ErrFavoriteNotFound)
+
+	_, err := service.AddToCollection(ctx, userID, collection.ID(), restaurantID)
+	assert.ErrorIs(t, err, domainerrors.ErrFavoriteNotFound)
+}
+
+// Test RemoveFromCollection
+func TestCollectionService_RemoveFromCollection_NotFavorite(t *testing.T) {
+	service, collectionRepo, favoriteRepo := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+	favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(nil, domainerrors.ErrFavoriteNotFound)
+
+	err := service.RemoveFromCollection(ctx, userID, collection.ID(), restaurantID)
+	assert.ErrorIs(t, err, domainerrors.ErrNotInCollection)
+}
+
+// Test ReorderCollection
+func TestCollectionService_ReorderCollection(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+	first := model.NewFavorite(userID, uuid.New())
+	second := model.NewFavorite(userID, uuid.New())
+	items := newCollectionItems(first, second)
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+	collectionRepo.EXPECT().ListItems(ctx, collection.ID()).Return(items, nil)
+	collectionRepo.EXPECT().ReorderItems(ctx, collection.ID(), []uuid.UUID{second.ID(), first.ID()}).Return(nil)
+	collectionRepo.EXPECT().ListItems(ctx, collection.ID()).Return(newCollectionItems(second, first), nil)
+
+	detail, err := service.ReorderCollection(ctx, userID, collection.ID(), []uuid.UUID{second.RestaurantID(), first.RestaurantID()})
+
+	require.NoError(t, err)
+	assert.Equal(t, second.ID(), detail.Items[0].Favorite.ID())
+}
+
+func TestCollectionService_ReorderCollection_InvalidOrder(t *testing.T) {
+	userID := uuid.New()
+	first := model.NewFavorite(userID, uuid.New())
+	second := model.NewFavorite(userID, uuid.New())
+
+	tests := []struct {
+		name  string
+		order []uuid.UUID
+	}{
+		{"missing item", []uuid.UUID{first.RestaurantID()}},
+		{"duplicate item", []uuid.UUID{first.RestaurantID(), first.RestaurantID()}},
+		{"unknown item", []uuid.UUID{first.RestaurantID(), uuid.New()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, collectionRepo, _ := newTestCollectionService(t)
+			ctx := context.Background()
+			collection := model.NewCollection(userID, "Osaka trip", "")
+
+			collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+			collectionRepo.EXPECT().ListItems(ctx, collection.ID()).Return(newCollectionItems(first, second), nil)
+
+			_, err := service.ReorderCollection(ctx, userID, collection.ID(), tt.order)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidCollectionOrder)
+		})
+	}
+}
+
+// Test ShareCollection
+func TestCollectionService_ShareCollection(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil).Times(2)
+	collectionRepo.EXPECT().Update(ctx, collection).Return(nil)
+
+	shared, err := service.ShareCollection(ctx, userID, collection.ID())
+	require.NoError(t, err)
+	require.True(t, shared.IsShared())
+	slug := shared.ShareSlug()
+
+	again, err := service.ShareCollection(ctx, userID, collection.ID())
+	require.NoError(t, err)
+	assert.Equal(t, slug, again.ShareSlug(), "sharing again keeps the slug")
+}
+
+func TestCollectionService_RotateShareSlug(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+	collection.Share("old-slug")
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+	collectionRepo.EXPECT().Update(ctx, collection).Return(nil)
+
+	rotated, err := service.RotateShareSlug(ctx, userID, collection.ID())
+
+	require.NoError(t, err)
+	assert.NotEqual(t, "old-slug", rotated.ShareSlug())
+	assert.True(t, rotated.IsShared())
+}
+
+func TestCollectionService_RotateShareSlug_NotShared(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+
+	collectionRepo.EXPECT().FindByID(ctx, collection.ID()).Return(collection, nil)
+
+	_, err := service.RotateShareSlug(ctx, userID, collection.ID())
+	assert.ErrorIs(t, err, domainerrors.ErrCollectionNotShared)
+}
+
+// Test GetSharedList
+func TestCollectionService_GetSharedList(t *testing.T) {
+	service, collectionRepo, _, restaurantRepo := newTestSharingService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	collection := model.NewCollection(userID, "Osaka trip", "")
+	collection.Share("slug")
+	kept := newCatalogRestaurant("Ichiran", "Dotonbori", "Ramen", "$$", 4.1)
+	deleted := newCatalogRestaurant("Closed", "Namba", "Ramen", "$", 3.0)
+	first := model.NewFavorite(userID, deleted.ID())
+	second := model.NewFavorite(userID, kept.ID())
+
+	collectionRepo.EXPECT().FindByShareSlug(ctx, "slug").Return(collection, nil)
+	collectionRepo.EXPECT().ListItems(ctx, collection.ID()).Return(newCollectionItems(first, second), nil)
+	restaurantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{deleted.ID(), kept.ID()}).Return([]*model.Restaurant{kept}, nil)
+
+	list, err := service.GetSharedList(ctx, "slug")
+
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1, "deleted restaurants are left out")
+	assert.Equal(t, kept.ID(), list.Items[0].Restaurant.ID())
+	assert.Equal(t, 1, list.Items[0].Position)
+}
+
+func TestCollectionService_GetSharedList_Revoked(t *testing.T) {
+	service, collectionRepo, _ := newTestCollectionService(t)
+	ctx := context.Background()
+
+	collectionRepo.EXPECT().FindByShareSlug(ctx, "revoked").Return(nil, domainerrors.ErrSharedListNotFound)
+
+	_, err := service.GetSharedList(ctx, "revoked")
+	assert.ErrorIs(t, err, domainerrors.ErrSharedListNotFound)
+}
@@ -2,6 +2,7 @@ package converters
 
 import (
 	"log"
+	"time"
 
 	mapv1 "github.com/Leon180/tabelogo-v2/api/gen/map/v1"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
@@ -65,9 +66,6 @@ func MapPlaceToRestaurant(place *mapv1.Place) *model.Restaurant {
 		metadata["types"] = place.Types
 	}
 
-	// Set price range from Google's price level
-	priceRange := parsePriceLevel(place.PriceLevel)
-
 	// Extract area from addressComponents (e.g., "Tokyo")
 	area := extractAreaFromAddressComponents(place.AddressComponents)
 
@@ -84,7 +82,7 @@ func MapPlaceToRestaurant(place *mapv1.Place) *model.Restaurant {
 		place.FormattedAddress,
 		location,
 		place.Rating,
-		priceRange,
+		"", // priceRange - set from Google's price level below
 		"", // cuisineType - can be derived from types later
 		place.PhoneNumber,
 		place.Website,
@@ -97,9 +95,34 @@ func MapPlaceToRestaurant(place *mapv1.Place) *model.Restaurant {
 		restaurant.UpdateArea(area)
 	}
 
+	if price := parsePriceLevel(place.PriceLevel); price != nil {
+		restaurant.UpdatePrice(price)
+	}
+	restaurant.AddCuisines(model.Cuisines.MatchGoogleTypes(place.Types)...)
+
+	// Structured hours for open-at queries; Google reports them in local time
+	if schedule := parseOpeningSchedule(place.OpeningHours); schedule != nil {
+		restaurant.UpdateOpeningSchedule(schedule)
+	}
+
 	return restaurant
 }
 
+// MapPlaceToLocalization converts a Map Service Place fetched in another
+// language to the restaurant's localized text. The Map Service has no
+// localized cuisine label, so it is left empty.
+func MapPlaceToLocalization(place *mapv1.Place) model.LocalizedContent {
+	if place == nil {
+		return model.LocalizedContent{}
+	}
+
+	return model.LocalizedContent{
+		Name:    place.Name,
+		Address: place.FormattedAddress,
+		Summary: place.EditorialSummary,
+	}
+}
+
 // extractAreaFromAddressComponents extracts the administrative area level 1 from address components
 // This is used to get the area (e.g., "Tokyo", "Osaka") for Tabelog search URLs
 func extractAreaFromAddressComponents(components []*mapv1.AddressComponent) string {
@@ -178,22 +201,28 @@ func getDayKey(index int, text string) string {
 	return "Unknown"
 }
 
-// parsePriceLevel converts Google's price level string to our price range format
-func parsePriceLevel(priceLevel string) string {
-	switch priceLevel {
-	case "PRICE_LEVEL_FREE":
-		return "$"
-	case "PRICE_LEVEL_INEXPENSIVE":
-		return "$"
-	case "PRICE_LEVEL_MODERATE":
-		return "$$"
-	case "PRICE_LEVEL_EXPENSIVE":
-		return "$$$"
-	case "PRICE_LEVEL_VERY_EXPENSIVE":
-		return "$$$$"
-	default:
-		return ""
+// googlePriceLevels maps Google's price levels to ours. Google has no free
+// level of its own on our scale, so free places count as inexpensive.
+var googlePriceLevels = map[string]int{
+	"PRICE_LEVEL_FREE":           1,
+	"PRICE_LEVEL_INEXPENSIVE":    1,
+	"PRICE_LEVEL_MODERATE":       2,
+	"PRICE_LEVEL_EXPENSIVE":      3,
+	"PRICE_LEVEL_VERY_EXPENSIVE": 4,
+}
+
+// parsePriceLevel converts Google's price level to a price without bands,
+// nil when Google gives no level
+func parsePriceLevel(priceLevel string) *model.Price {
+	level, ok := googlePriceLevels[priceLevel]
+	if !ok {
+		return nil
 	}
+	price, err := model.NewPrice(level, nil, nil, priceLevel)
+	if err != nil {
+		return nil
+	}
+	return price
 }
 
 // MapPlacesToRestaurants converts multiple Map Service Places to Restaurant domain models
@@ -211,3 +240,39 @@ func MapPlacesToRestaurants(places []*mapv1.Place) []*model.Restaurant {
 
 	return restaurants
 }
+
+// parseOpeningSchedule converts the regular weekly periods and closed dates
+// of a place. It returns nil when the place has no periods or they are invalid.
+func parseOpeningSchedule(hours *mapv1.OpeningHours) *model.OpeningSchedule {
+	if hours == nil || len(hours.Periods) == 0 {
+		return nil
+	}
+
+	periods := make([]model.OpeningPeriod, 0, len(hours.Periods))
+	for _, p := range hours.Periods {
+		if p.Open == nil {
+			continue
+		}
+		period := model.OpeningPeriod{Open: toWeekTime(p.Open)}
+		if p.Close != nil {
+			closing := toWeekTime(p.Close)
+			period.Close = &closing
+		}
+		periods = append(periods, period)
+	}
+
+	schedule, err := model.NewOpeningSchedule(periods, hours.ClosedDates)
+	if err != nil {
+		log.Printf("[MapPlaceToRestaurant] invalid opening periods: %v", err)
+		return nil
+	}
+	return schedule
+}
+
+func toWeekTime(point *mapv1.OpeningPoint) model.WeekTime {
+	return model.WeekTime{
+		Day:    time.Weekday(point.Day),
+		Hour:   int(point.Hour),
+		Minute: int(point.Minute),
+	}
+}
@@ -0,0 +1,33 @@
+package converters
+
+import (
+	"strings"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+)
+
+// TabelogBudgetToPrice converts the lunch and dinner budgets of a scraped
+// Tabelog listing, such as "￥1,000～￥1,999", to a price with yen bands. The
+// level follows the dinner budget. It returns nil when neither budget is known.
+func TabelogBudgetToPrice(lunch, dinner string) *model.Price {
+	lunchBand := model.ParseYenBand(lunch)
+	dinnerBand := model.ParseYenBand(dinner)
+	if lunchBand == nil && dinnerBand == nil {
+		return nil
+	}
+
+	// Kept in the form Tabelog shows the budgets in
+	var text []string
+	if dinnerBand != nil {
+		text = append(text, "[夜]"+strings.TrimSpace(dinner))
+	}
+	if lunchBand != nil {
+		text = append(text, "[昼]"+strings.TrimSpace(lunch))
+	}
+
+	price, err := model.NewPrice(0, lunchBand, dinnerBand, strings.Join(text, " "))
+	if err != nil {
+		return nil
+	}
+	return price
+}
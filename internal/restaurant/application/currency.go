@@ -0,0 +1,68 @@
+package application
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+)
+
+// BaseCurrency is the currency restaurant prices are stored in
+const BaseCurrency = "JPY"
+
+// CurrencyConverter converts between yen and the currencies of the configured
+// rate table, to show prices and read budgets in a user's currency. Rates are
+// indicative: converted amounts are for display and filtering only.
+type CurrencyConverter struct {
+	rates map[string]float64 // units of the currency per yen
+}
+
+// NewCurrencyConverter creates a converter from Config.CurrencyRates. Yen is
+// always supported; rates that are not positive are ignored.
+func NewCurrencyConverter(config *Config) *CurrencyConverter {
+	rates := map[string]float64{BaseCurrency: 1}
+	for code, rate := range config.CurrencyRates {
+		if rate > 0 {
+			rates[strings.ToUpper(strings.TrimSpace(code))] = rate
+		}
+	}
+	return &CurrencyConverter{rates: rates}
+}
+
+// Supports reports whether currency is in the rate table
+func (c *CurrencyConverter) Supports(currency string) bool {
+	_, ok := c.rates[strings.ToUpper(strings.TrimSpace(currency))]
+	return ok
+}
+
+// Currencies returns the supported currency codes in alphabetical order
+func (c *CurrencyConverter) Currencies() []string {
+	codes := make([]string, 0, len(c.rates))
+	for code := range c.rates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// FromYen converts a yen amount to currency, rounded to cents
+func (c *CurrencyConverter) FromYen(yen int, currency string) (model.Money, error) {
+	money := model.NewMoney(0, currency)
+	rate, ok := c.rates[money.Currency]
+	if !ok {
+		return model.Money{}, domainerrors.ErrUnsupportedCurrency
+	}
+	money.Amount = math.Round(float64(yen)*rate*100) / 100
+	return money, nil
+}
+
+// ToYen converts an amount to whole yen
+func (c *CurrencyConverter) ToYen(amount model.Money) (int, error) {
+	rate, ok := c.rates[strings.ToUpper(strings.TrimSpace(amount.Currency))]
+	if !ok {
+		return 0, domainerrors.ErrUnsupportedCurrency
+	}
+	return int(math.Round(amount.Amount / rate)), nil
+}
@@ -0,0 +1,45 @@
+package application
+
+import (
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCurrencyConverter() *CurrencyConverter {
+	return NewCurrencyConverter(&Config{CurrencyRates: map[string]float64{"usd": 0.0067, "EUR": 0.0062, "XXX": 0}})
+}
+
+func TestCurrencyConverter_FromYen(t *testing.T) {
+	converter := newTestCurrencyConverter()
+
+	money, err := converter.FromYen(3999, "usd")
+	require.NoError(t, err)
+	assert.Equal(t, model.Money{Amount: 26.79, Currency: "USD"}, money)
+
+	money, err = converter.FromYen(3999, "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, 3999.0, money.Amount)
+}
+
+func TestCurrencyConverter_ToYen(t *testing.T) {
+	converter := newTestCurrencyConverter()
+
+	yen, err := converter.ToYen(model.NewMoney(20, "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, 2985, yen)
+}
+
+func TestCurrencyConverter_Unsupported(t *testing.T) {
+	converter := newTestCurrencyConverter()
+
+	_, err := converter.FromYen(1000, "XXX")
+	assert.ErrorIs(t, err, domainerrors.ErrUnsupportedCurrency)
+	_, err = converter.ToYen(model.NewMoney(10, "GBP"))
+	assert.ErrorIs(t, err, domainerrors.ErrUnsupportedCurrency)
+	assert.False(t, converter.Supports("gbp"))
+	assert.Equal(t, []string{"EUR", "JPY", "USD"}, converter.Currencies())
+}
@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// purgeBatchSize is the number of restaurants purged per query
+const purgeBatchSize = 100
+
+// DeletedRestaurant is a soft-deleted restaurant and when it will be purged
+type DeletedRestaurant struct {
+	Restaurant *model.Restaurant
+	PurgeAt    *time.Time // nil when deleted restaurants are kept
+}
+
+// DeletedRestaurantService manages soft-deleted restaurants: they can be
+// restored until they are purged DeletedRetention after their deletion
+type DeletedRestaurantService interface {
+	// ListDeletedRestaurants lists deleted restaurants, most recently deleted
+	// first, and counts them. Merged restaurants are left out.
+	ListDeletedRestaurants(ctx context.Context, limit, offset int) ([]*DeletedRestaurant, int64, error)
+
+	// RestoreRestaurant undoes the deletion of a restaurant and its favorites
+	RestoreRestaurant(ctx context.Context, id uuid.UUID) (*model.Restaurant, error)
+
+	// PurgeExpired permanently deletes the restaurants deleted longer than
+	// DeletedRetention ago, with their photos, and returns how many were purged
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+type deletedRestaurantService struct {
+	restaurantRepo repository.RestaurantRepository
+	photoRepo      repository.PhotoRepository
+	blobs          BlobStore
+	config         *Config
+	logger         *zap.Logger
+}
+
+// NewDeletedRestaurantService creates a new deleted restaurant service
+func NewDeletedRestaurantService(
+	restaurantRepo repository.RestaurantRepository,
+	photoRepo repository.PhotoRepository,
+	blobs BlobStore,
+	config *Config,
+	logger *zap.Logger,
+) DeletedRestaurantService {
+	return &deletedRestaurantService{
+		restaurantRepo: restaurantRepo,
+		photoRepo:      photoRepo,
+		blobs:          blobs,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+func (s *deletedRestaurantService) ListDeletedRestaurants(ctx context.Context, limit, offset int) ([]*DeletedRestaurant, int64, error) {
+	restaurants, total, err := s.restaurantRepo.FindDeleted(ctx, repository.DeletedQuery{Limit: limit, Offset: offset})
+	if err != nil {
+		s.logger.Error("Failed to list deleted restaurants", zap.Error(err))
+		return nil, 0, err
+	}
+
+	deleted := make([]*DeletedRestaurant, len(restaurants))
+	for i, restaurant := range restaurants {
+		deleted[i] = &DeletedRestaurant{Restaurant: restaurant}
+		if s.config.DeletedRetention > 0 && restaurant.DeletedAt() != nil {
+			purgeAt := restaurant.DeletedAt().Add(s.config.DeletedRetention)
+			deleted[i].PurgeAt = &purgeAt
+		}
+	}
+	return deleted, total, nil
+}
+
+func (s *deletedRestaurantService) RestoreRestaurant(ctx context.Context, id uuid.UUID) (*model.Restaurant, error) {
+	restaurant, err := s.restaurantRepo.Restore(ctx, id)
+	if err != nil {
+		if !errors.Is(err, domainerrors.ErrRestaurantNotFound) &&
+			!errors.Is(err, domainerrors.ErrRestaurantNotDeleted) &&
+			!errors.Is(err, domainerrors.ErrRestaurantMerged) &&
+			!errors.Is(err, domainerrors.ErrRestaurantReplaced) {
+			s.logger.Error("Failed to restore restaurant", zap.String("id", id.String()), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Restaurant restored", zap.String("id", id.String()))
+	return restaurant, nil
+}
+
+func (s *deletedRestaurantService) PurgeExpired(ctx context.Context) (int, error) {
+	if s.config.DeletedRetention <= 0 {
+		return 0, nil
+	}
+
+	before := time.Now().Add(-s.config.DeletedRetention)
+	total := 0
+	for {
+		restaurants, _, err := s.restaurantRepo.FindDeleted(ctx, repository.DeletedQuery{
+			DeletedBefore: &before,
+			Limit:         purgeBatchSize,
+		})
+		if err != nil {
+			return total, err
+		}
+		if len(restaurants) == 0 {
+			return total, nil
+		}
+
+		ids := make([]uuid.UUID, len(restaurants))
+		blobKeys := make(map[uuid.UUID][]string)
+		for i, restaurant := range restaurants {
+			ids[i] = restaurant.ID()
+			photos, err := s.photoRepo.ListByRestaurant(ctx, restaurant.ID())
+			if err != nil {
+				return total, err
+			}
+			for _, photo := range photos {
+				blobKeys[restaurant.ID()] = append(blobKeys[restaurant.ID()], photo.BlobKey(), photo.ThumbnailKey())
+			}
+		}
+
+		purged, err := s.restaurantRepo.Purge(ctx, ids, before)
+		if err != nil {
+			return total, err
+		}
+		total += len(purged)
+
+		// The photo rows are gone, so a blob that cannot be deleted is only orphaned
+		for _, id := range purged {
+			for _, key := range blobKeys[id] {
+				if err := s.blobs.Delete(ctx, key); err != nil && !errors.Is(err, domainerrors.ErrBlobNotFound) {
+					s.logger.Warn("Failed to delete photo blob of purged restaurant", zap.String("key", key), zap.Error(err))
+				}
+			}
+		}
+
+		s.logger.Info("Purged deleted restaurants", zap.Int("restaurants", len(purged)))
+
+		// Restaurants restored or merged since they were listed are skipped;
+		// stop rather than list them again
+		if len(purged) < len(ids) || len(restaurants) < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
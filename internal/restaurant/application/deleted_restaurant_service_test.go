@@ -0,0 +1,157 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type deletedTestDeps struct {
+	restaurantRepo *MockRestaurantRepository
+	photoRepo      *MockPhotoRepository
+	blobs          *memoryBlobStore
+	service        DeletedRestaurantService
+}
+
+func newTestDeletedRestaurantService(retention time.Duration) *deletedTestDeps {
+	deps := &deletedTestDeps{
+		restaurantRepo: new(MockRestaurantRepository),
+		photoRepo:      new(MockPhotoRepository),
+		blobs:          newMemoryBlobStore(),
+	}
+	config := &Config{DeletedRetention: retention}
+	deps.service = NewDeletedRestaurantService(deps.restaurantRepo, deps.photoRepo, deps.blobs, config, zap.NewNop())
+	return deps
+}
+
+func newDeletedRestaurant(name string) *model.Restaurant {
+	r := newTestRestaurant(name, "", model.SourceGoogle, 35.6595, 139.7005)
+	r.SoftDelete()
+	return r
+}
+
+// Test ListDeletedRestaurants
+func TestDeletedRestaurantService_ListDeletedRestaurants_PurgeAt(t *testing.T) {
+	deps := newTestDeletedRestaurantService(30 * 24 * time.Hour)
+	ctx := context.Background()
+	restaurant := newDeletedRestaurant("Ichiran")
+
+	deps.restaurantRepo.On("FindDeleted", ctx, repository.DeletedQuery{Limit: 20, Offset: 40}).
+		Return([]*model.Restaurant{restaurant}, int64(41), nil)
+
+	deleted, total, err := deps.service.ListDeletedRestaurants(ctx, 20, 40)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(41), total)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, restaurant, deleted[0].Restaurant)
+	require.NotNil(t, deleted[0].PurgeAt)
+	assert.Equal(t, restaurant.DeletedAt().Add(30*24*time.Hour), *deleted[0].PurgeAt)
+}
+
+func TestDeletedRestaurantService_ListDeletedRestaurants_KeptForever(t *testing.T) {
+	deps := newTestDeletedRestaurantService(0)
+	ctx := context.Background()
+
+	deps.restaurantRepo.On("FindDeleted", ctx, mock.Anything).
+		Return([]*model.Restaurant{newDeletedRestaurant("Ichiran")}, int64(1), nil)
+
+	deleted, _, err := deps.service.ListDeletedRestaurants(ctx, 20, 0)
+
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Nil(t, deleted[0].PurgeAt)
+}
+
+// Test RestoreRestaurant
+func TestDeletedRestaurantService_RestoreRestaurant(t *testing.T) {
+	deps := newTestDeletedRestaurantService(time.Hour)
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+
+	deps.restaurantRepo.On("Restore", ctx, restaurant.ID()).Return(restaurant, nil)
+
+	restored, err := deps.service.RestoreRestaurant(ctx, restaurant.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, restaurant, restored)
+}
+
+func TestDeletedRestaurantService_RestoreRestaurant_Merged(t *testing.T) {
+	deps := newTestDeletedRestaurantService(time.Hour)
+	ctx := context.Background()
+	id := uuid.New()
+
+	deps.restaurantRepo.On("Restore", ctx, id).Return(nil, domainerrors.ErrRestaurantMerged)
+
+	restored, err := deps.service.RestoreRestaurant(ctx, id)
+
+	assert.ErrorIs(t, err, domainerrors.ErrRestaurantMerged)
+	assert.Nil(t, restored)
+}
+
+func TestDeletedRestaurantService_RestoreRestaurant_Replaced(t *testing.T) {
+	deps := newTestDeletedRestaurantService(time.Hour)
+	ctx := context.Background()
+	id := uuid.New()
+
+	deps.restaurantRepo.On("Restore", ctx, id).Return(nil, domainerrors.ErrRestaurantReplaced)
+
+	restored, err := deps.service.RestoreRestaurant(ctx, id)
+
+	assert.ErrorIs(t, err, domainerrors.ErrRestaurantReplaced)
+	assert.Nil(t, restored)
+}
+
+// Test PurgeExpired
+func TestDeletedRestaurantService_PurgeExpired_DeletesBlobsOfPurged(t *testing.T) {
+	deps := newTestDeletedRestaurantService(time.Hour)
+	ctx := context.Background()
+	purged := newDeletedRestaurant("Ichiran")
+	restored := newDeletedRestaurant("Afuri")
+
+	purgedPhoto := model.NewPhoto(purged.ID(), model.SourceGoogle, "places/p1/photos/a", "image/jpeg", 64, 32)
+	restoredPhoto := model.NewPhoto(restored.ID(), model.SourceGoogle, "places/p2/photos/b", "image/jpeg", 64, 32)
+	for _, photo := range []*model.Photo{purgedPhoto, restoredPhoto} {
+		require.NoError(t, deps.blobs.Put(ctx, photo.BlobKey(), []byte("full")))
+		require.NoError(t, deps.blobs.Put(ctx, photo.ThumbnailKey(), []byte("thumb")))
+	}
+
+	deps.restaurantRepo.On("FindDeleted", ctx, mock.MatchedBy(func(q repository.DeletedQuery) bool {
+		return q.DeletedBefore != nil && time.Since(*q.DeletedBefore) >= time.Hour && q.Limit == purgeBatchSize
+	})).Return([]*model.Restaurant{purged, restored}, int64(2), nil).Once()
+	deps.photoRepo.On("ListByRestaurant", ctx, purged.ID()).Return([]*model.Photo{purgedPhoto}, nil)
+	deps.photoRepo.On("ListByRestaurant", ctx, restored.ID()).Return([]*model.Photo{restoredPhoto}, nil)
+	// The second restaurant was restored after it was listed
+	deps.restaurantRepo.On("Purge", ctx, []uuid.UUID{purged.ID(), restored.ID()}, mock.AnythingOfType("time.Time")).
+		Return([]uuid.UUID{purged.ID()}, nil)
+
+	count, err := deps.service.PurgeExpired(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.NotContains(t, deps.blobs.blobs, purgedPhoto.BlobKey())
+	assert.NotContains(t, deps.blobs.blobs, purgedPhoto.ThumbnailKey())
+	assert.Contains(t, deps.blobs.blobs, restoredPhoto.BlobKey())
+	assert.Contains(t, deps.blobs.blobs, restoredPhoto.ThumbnailKey())
+	deps.restaurantRepo.AssertNumberOfCalls(t, "FindDeleted", 1)
+}
+
+func TestDeletedRestaurantService_PurgeExpired_Disabled(t *testing.T) {
+	deps := newTestDeletedRestaurantService(0)
+
+	count, err := deps.service.PurgeExpired(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	deps.restaurantRepo.AssertNotCalled(t, "FindDeleted", mock.Anything, mock.Anything)
+}
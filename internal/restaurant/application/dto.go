@@ -32,4 +32,21 @@ type UpdateRestaurantRequest struct {
 	Website     string  `json:"website"`
 	Latitude    float64 `json:"latitude"`
 	Longitude   float64 `json:"longitude"`
+
+	// ActorID is the user making the change, recorded in the revision log
+	ActorID string `json:"-"`
+}
+
+// TabelogListing is a Tabelog search result as scraped by the spider service
+type TabelogListing struct {
+	Link        string   `json:"link" binding:"required"`
+	Name        string   `json:"name"`
+	Rating      float64  `json:"rating"`
+	RatingCount int      `json:"rating_count"`
+	Bookmarks   int      `json:"bookmarks"`
+	Phone       string   `json:"phone"`
+	Types       []string `json:"types"`
+	// Per-person budgets as shown on Tabelog, e.g. "￥3,000～￥3,999"
+	LunchBudget  string `json:"lunch_budget,omitempty"`
+	DinnerBudget string `json:"dinner_budget,omitempty"`
 }
@@ -0,0 +1,14 @@
+package application
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+)
+
+// EventPublisher publishes domain events to the message bus. Events must be
+// published in the given order; on error any of them may or may not have been
+// published, and the whole batch is published again.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []*model.DomainEvent) error
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"golang.org/x/text/language"
+)
+
+// languageMatcher matches requested languages to model.SupportedLanguages
+var languageMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(model.SupportedLanguages))
+	for i, lang := range model.SupportedLanguages {
+		tags[i] = language.MustParse(lang)
+	}
+	return language.NewMatcher(tags)
+}()
+
+// MatchLanguage returns the supported content language for a BCP 47 tag,
+// such as "ja" for "ja-JP" and "zh-TW" for "zh-Hant" or "zh-HK". ok is false
+// when the tag is invalid or no supported language is close to it.
+func MatchLanguage(lang string) (string, bool) {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return "", false
+	}
+	return matchTag(tag)
+}
+
+func matchTag(tag language.Tag) (string, bool) {
+	_, i, confidence := languageMatcher.Match(tag)
+	if confidence < language.High {
+		return "", false
+	}
+	return model.SupportedLanguages[i], true
+}
+
+// ContentLanguages returns the fallback chain of content languages for a
+// request: the languages of an Accept-Language header, in order of preference,
+// that are supported, followed by model.DefaultLanguage. An invalid header
+// yields the default language alone.
+func ContentLanguages(acceptLanguage string) []string {
+	tags, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+
+	langs := make([]string, 0, len(tags)+1)
+	seen := make(map[string]bool)
+	for _, tag := range append(tags, language.MustParse(model.DefaultLanguage)) {
+		lang, ok := matchTag(tag)
+		if ok && !seen[lang] {
+			seen[lang] = true
+			langs = append(langs, lang)
+		}
+	}
+	return langs
+}
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchLanguage(t *testing.T) {
+	tests := []struct {
+		lang string
+		want string
+		ok   bool
+	}{
+		{"en", "en", true},
+		{"en-GB", "en", true},
+		{"ja-JP", "ja", true},
+		{"zh_tw", "zh-TW", true},
+		{"zh-Hant", "zh-TW", true},
+		{"zh-HK", "zh-TW", true},
+		{"zh-CN", "", false},
+		{"fr", "", false},
+		{"not a tag", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			lang, ok := MatchLanguage(tt.lang)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, lang)
+		})
+	}
+}
+
+func TestContentLanguages(t *testing.T) {
+	assert.Equal(t, []string{"zh-TW", "ja", "en"}, ContentLanguages("zh-TW,zh;q=0.9,ja;q=0.8,fr;q=0.5"))
+	assert.Equal(t, []string{"ja", "en"}, ContentLanguages("ja-JP"))
+	assert.Equal(t, []string{"en", "ja"}, ContentLanguages("en-US,ja;q=0.5"))
+	assert.Equal(t, []string{"en"}, ContentLanguages(""))
+	assert.Equal(t, []string{"en"}, ContentLanguages("fr-FR"))
+	assert.Equal(t, []string{"en"}, ContentLanguages(";;invalid"))
+}
@@ -6,7 +6,10 @@ import (
 	mapv1 "github.com/Leon180/tabelogo-v2/api/gen/map/v1"
 )
 
-// MapServiceClient defines the interface for Map Service gRPC client
+// MapServiceClient defines the interface for Map Service gRPC client. Place
+// text comes back in languageCode, one of model.SupportedLanguages.
 type MapServiceClient interface {
-	QuickSearch(ctx context.Context, placeID string) (*mapv1.Place, error)
+	QuickSearch(ctx context.Context, placeID, languageCode string) (*mapv1.Place, error)
+	BatchGetPlaces(ctx context.Context, placeIDs []string, languageCode string) ([]*mapv1.Place, error)
+	GetPhotoMedia(ctx context.Context, photoName string, maxWidthPx int) ([]byte, string, error)
 }
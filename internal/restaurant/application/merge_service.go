@@ -0,0 +1,161 @@
+package application
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/dedup"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// duplicateScanFactor is how many candidate pairs are scored per requested
+// result. The repository ranks pairs by an estimate of the score; scoring more
+// pairs than requested lets the exact score reorder the top of that ranking.
+const duplicateScanFactor = 10
+
+// DuplicateCandidate is a pair of restaurants that may describe the same place
+type DuplicateCandidate struct {
+	A     *model.Restaurant
+	B     *model.Restaurant
+	Match dedup.Match
+}
+
+// MergeService finds duplicate restaurants across sources and merges them
+type MergeService interface {
+	// FindDuplicateCandidates returns pairs scoring at least minScore, best
+	// first. restaurantID restricts the pairs to one restaurant when set.
+	FindDuplicateCandidates(ctx context.Context, restaurantID *uuid.UUID, minScore float64, limit int) ([]*DuplicateCandidate, error)
+
+	// MergeRestaurants merges duplicateID into canonicalID
+	MergeRestaurants(ctx context.Context, canonicalID, duplicateID uuid.UUID, mergedBy string) (*model.RestaurantMerge, error)
+
+	// UnmergeRestaurants undoes a merge
+	UnmergeRestaurants(ctx context.Context, mergeID uuid.UUID) (*model.RestaurantMerge, error)
+
+	// GetLinkedIdentities returns the active merges into a restaurant
+	GetLinkedIdentities(ctx context.Context, restaurantID uuid.UUID) ([]*model.RestaurantMerge, error)
+}
+
+type mergeService struct {
+	restaurantRepo repository.RestaurantRepository
+	mergeRepo      repository.MergeRepository
+	logger         *zap.Logger
+}
+
+// NewMergeService creates a new merge service
+func NewMergeService(
+	restaurantRepo repository.RestaurantRepository,
+	mergeRepo repository.MergeRepository,
+	logger *zap.Logger,
+) MergeService {
+	return &mergeService{
+		restaurantRepo: restaurantRepo,
+		mergeRepo:      mergeRepo,
+		logger:         logger,
+	}
+}
+
+func (s *mergeService) FindDuplicateCandidates(ctx context.Context, restaurantID *uuid.UUID, minScore float64, limit int) ([]*DuplicateCandidate, error) {
+	if minScore < 0 || minScore > 1 {
+		return nil, domainerrors.ErrInvalidMinScore
+	}
+
+	pairs, err := s.mergeRepo.FindDuplicatePairs(ctx, repository.DuplicateQuery{
+		RestaurantID:  restaurantID,
+		MaxDistanceKm: dedup.MaxDistanceKm,
+		Limit:         limit * duplicateScanFactor,
+	})
+	if err != nil {
+		s.logger.Error("Failed to find duplicate pairs", zap.Error(err))
+		return nil, err
+	}
+
+	candidates := make([]*DuplicateCandidate, 0, len(pairs))
+	for _, pair := range pairs {
+		match := dedup.Compare(pair.A, pair.B)
+		if match.Score < minScore {
+			continue
+		}
+		candidates = append(candidates, &DuplicateCandidate{A: pair.A, B: pair.B, Match: match})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Match.Score > candidates[j].Match.Score
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates, nil
+}
+
+func (s *mergeService) MergeRestaurants(ctx context.Context, canonicalID, duplicateID uuid.UUID, mergedBy string) (*model.RestaurantMerge, error) {
+	if canonicalID == duplicateID {
+		return nil, domainerrors.ErrMergeSelf
+	}
+
+	canonical, err := s.restaurantRepo.FindByID(ctx, canonicalID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := s.restaurantRepo.FindByID(ctx, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	match := dedup.Compare(canonical, duplicate)
+	merge := model.NewRestaurantMerge(canonical, duplicate, match.Score, mergedBy)
+	if err := s.mergeRepo.Merge(ctx, merge); err != nil {
+		s.logger.Error("Failed to merge restaurants",
+			zap.String("canonical_id", canonicalID.String()),
+			zap.String("duplicate_id", duplicateID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("Restaurants merged",
+		zap.String("merge_id", merge.ID().String()),
+		zap.String("canonical_id", canonicalID.String()),
+		zap.String("duplicate_id", duplicateID.String()),
+		zap.Float64("score", match.Score),
+		zap.Int("moved_favorites", len(merge.MovedFavoriteIDs())),
+		zap.Int("dropped_favorites", len(merge.DroppedFavoriteIDs())),
+	)
+
+	return merge, nil
+}
+
+func (s *mergeService) UnmergeRestaurants(ctx context.Context, mergeID uuid.UUID) (*model.RestaurantMerge, error) {
+	merge, err := s.mergeRepo.FindByID(ctx, mergeID)
+	if err != nil {
+		return nil, err
+	}
+	if !merge.IsActive() {
+		return nil, domainerrors.ErrMergeAlreadyUndone
+	}
+
+	if err := s.mergeRepo.Unmerge(ctx, merge); err != nil {
+		s.logger.Error("Failed to unmerge restaurants", zap.String("merge_id", mergeID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Restaurants unmerged",
+		zap.String("merge_id", mergeID.String()),
+		zap.String("restored_id", merge.MergedID().String()),
+	)
+
+	return merge, nil
+}
+
+func (s *mergeService) GetLinkedIdentities(ctx context.Context, restaurantID uuid.UUID) ([]*model.RestaurantMerge, error) {
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.mergeRepo.FindByCanonicalID(ctx, restaurantID)
+}
@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// Mock Merge Repository
+type MockMergeRepository struct {
+	mock.Mock
+}
+
+func (m *MockMergeRepository) FindDuplicatePairs(ctx context.Context, query repository.DuplicateQuery) ([]*repository.RestaurantPair, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.RestaurantPair), args.Error(1)
+}
+
+func (m *MockMergeRepository) Merge(ctx context.Context, merge *model.RestaurantMerge) error {
+	args := m.Called(ctx, merge)
+	return args.Error(0)
+}
+
+func (m *MockMergeRepository) Unmerge(ctx context.Context, merge *model.RestaurantMerge) error {
+	args := m.Called(ctx, merge)
+	return args.Error(0)
+}
+
+func (m *MockMergeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantMerge, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RestaurantMerge), args.Error(1)
+}
+
+func (m *MockMergeRepository) FindByCanonicalID(ctx context.Context, canonicalID uuid.UUID) ([]*model.RestaurantMerge, error) {
+	args := m.Called(ctx, canonicalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.RestaurantMerge), args.Error(1)
+}
+
+func newTestRestaurant(name, phone string, source model.RestaurantSource, lat, lng float64) *model.Restaurant {
+	location, _ := model.NewLocation(lat, lng)
+	return model.NewRestaurantWithDetails(name, "Tokyo", source, uuid.NewString(), "", location, 0, "", "", phone, "", nil, nil)
+}
+
+// Test FindDuplicateCandidates
+func TestMergeService_FindDuplicateCandidates_ScoresAndFilters(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	ctx := context.Background()
+	google := newTestRestaurant("Ichiran Shibuya", "+81 3-3463-3667", model.SourceGoogle, 35.6595, 139.7005)
+	tabelog := newTestRestaurant("ICHIRAN Shibuya", "03-3463-3667", model.SourceTabelog, 35.6596, 139.7005)
+	neighbor := newTestRestaurant("Tempura Kondo", "03-1111-2222", model.SourceTabelog, 35.6597, 139.7006)
+
+	mockMergeRepo.On("FindDuplicatePairs", ctx, mock.MatchedBy(func(q repository.DuplicateQuery) bool {
+		return q.RestaurantID == nil && q.MaxDistanceKm > 0 && q.Limit == 10*duplicateScanFactor
+	})).Return([]*repository.RestaurantPair{
+		{A: google, B: neighbor},
+		{A: google, B: tabelog},
+	}, nil)
+
+	candidates, err := service.FindDuplicateCandidates(ctx, nil, 0.6, 10)
+
+	assert.NoError(t, err)
+	assert.Len(t, candidates, 1)
+	assert.Equal(t, tabelog.ID(), candidates[0].B.ID())
+	assert.Equal(t, 1.0, *candidates[0].Match.Phone)
+	mockMergeRepo.AssertExpectations(t)
+}
+
+func TestMergeService_FindDuplicateCandidates_InvalidMinScore(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	candidates, err := service.FindDuplicateCandidates(context.Background(), nil, 1.5, 10)
+
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidMinScore)
+	assert.Nil(t, candidates)
+	mockMergeRepo.AssertNotCalled(t, "FindDuplicatePairs")
+}
+
+// Test MergeRestaurants
+func TestMergeService_MergeRestaurants_Success(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	ctx := context.Background()
+	canonical := newTestRestaurant("Ichiran Shibuya", "03-3463-3667", model.SourceGoogle, 35.6595, 139.7005)
+	duplicate := newTestRestaurant("ICHIRAN Shibuya", "03-3463-3667", model.SourceTabelog, 35.6595, 139.7005)
+
+	mockRestaurantRepo.On("FindByID", ctx, canonical.ID()).Return(canonical, nil)
+	mockRestaurantRepo.On("FindByID", ctx, duplicate.ID()).Return(duplicate, nil)
+	mockMergeRepo.On("Merge", ctx, mock.AnythingOfType("*model.RestaurantMerge")).Return(nil)
+
+	merge, err := service.MergeRestaurants(ctx, canonical.ID(), duplicate.ID(), "admin-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, canonical.ID(), merge.CanonicalID())
+	assert.Equal(t, duplicate.ID(), merge.MergedID())
+	assert.Equal(t, model.SourceTabelog, merge.Source())
+	assert.Equal(t, duplicate.ExternalID(), merge.ExternalID())
+	assert.Equal(t, "admin-1", merge.MergedBy())
+	assert.InDelta(t, 1.0, merge.Score(), 1e-9)
+	assert.True(t, merge.IsActive())
+	mockRestaurantRepo.AssertExpectations(t)
+	mockMergeRepo.AssertExpectations(t)
+}
+
+func TestMergeService_MergeRestaurants_Self(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	id := uuid.New()
+	merge, err := service.MergeRestaurants(context.Background(), id, id, "admin-1")
+
+	assert.ErrorIs(t, err, domainerrors.ErrMergeSelf)
+	assert.Nil(t, merge)
+	mockMergeRepo.AssertNotCalled(t, "Merge")
+}
+
+func TestMergeService_MergeRestaurants_NotFound(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	ctx := context.Background()
+	canonical := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	missingID := uuid.New()
+
+	mockRestaurantRepo.On("FindByID", ctx, canonical.ID()).Return(canonical, nil)
+	mockRestaurantRepo.On("FindByID", ctx, missingID).Return(nil, domainerrors.ErrRestaurantNotFound)
+
+	merge, err := service.MergeRestaurants(ctx, canonical.ID(), missingID, "admin-1")
+
+	assert.ErrorIs(t, err, domainerrors.ErrRestaurantNotFound)
+	assert.Nil(t, merge)
+	mockMergeRepo.AssertNotCalled(t, "Merge")
+}
+
+// Test UnmergeRestaurants
+func TestMergeService_UnmergeRestaurants_Success(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	ctx := context.Background()
+	canonical := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	duplicate := newTestRestaurant("Ichiran", "", model.SourceTabelog, 35.6595, 139.7005)
+	merge := model.NewRestaurantMerge(canonical, duplicate, 0.9, "admin-1")
+
+	mockMergeRepo.On("FindByID", ctx, merge.ID()).Return(merge, nil)
+	mockMergeRepo.On("Unmerge", ctx, merge).Return(nil)
+
+	result, err := service.UnmergeRestaurants(ctx, merge.ID())
+
+	assert.NoError(t, err)
+	assert.Equal(t, merge.ID(), result.ID())
+	mockMergeRepo.AssertExpectations(t)
+}
+
+func TestMergeService_UnmergeRestaurants_AlreadyUndone(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMergeRepo := new(MockMergeRepository)
+	service := NewMergeService(mockRestaurantRepo, mockMergeRepo, zap.NewNop())
+
+	ctx := context.Background()
+	canonical := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	duplicate := newTestRestaurant("Ichiran", "", model.SourceTabelog, 35.6595, 139.7005)
+	merge := model.NewRestaurantMerge(canonical, duplicate, 0.9, "admin-1")
+	merge.Unmerge()
+
+	mockMergeRepo.On("FindByID", ctx, merge.ID()).Return(merge, nil)
+
+	result, err := service.UnmergeRestaurants(ctx, merge.ID())
+
+	assert.ErrorIs(t, err, domainerrors.ErrMergeAlreadyUndone)
+	assert.Nil(t, result)
+	mockMergeRepo.AssertNotCalled(t, "Unmerge")
+}
@@ -1,15 +1,53 @@
 package application
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	pkgconfig "github.com/Leon180/tabelogo-v2/pkg/config"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
-// NewConfig creates application config from pkg config
-func NewConfig(cfg *pkgconfig.Config) *Config {
-	return &Config{
-		DataFreshnessTTL: cfg.MapService.DataFreshnessTTL,
+// NewConfig creates application config from pkg config. Photo URLs are
+// signed with their own key, so that a leaked photo URL key cannot be used to
+// forge access tokens.
+func NewConfig(cfg *pkgconfig.Config) (*Config, error) {
+	if cfg.Photos.SigningKey == "" {
+		return nil, fmt.Errorf("PHOTO_URL_SIGNING_KEY is required")
 	}
+	if cfg.Photos.SigningKey == cfg.JWT.Secret {
+		return nil, fmt.Errorf("PHOTO_URL_SIGNING_KEY must differ from JWT_SECRET")
+	}
+
+	return &Config{
+		DataFreshnessTTL:       cfg.MapService.DataFreshnessTTL,
+		TabelogAutoAttachScore: DefaultTabelogAutoAttachScore,
+		RefreshInterval:        cfg.MapService.RefreshInterval,
+		RefreshBatchSize:       cfg.MapService.RefreshBatchSize,
+		RefreshQuota:           cfg.MapService.RefreshQuota,
+		RefreshDryRun:          cfg.MapService.RefreshDryRun,
+		TrustedEditorApprovals: DefaultTrustedEditorApprovals,
+		PhotoSigningKey:        cfg.Photos.SigningKey,
+		PhotoURLTTL:            cfg.Photos.URLTTL,
+		PhotoMaxWidth:          DefaultPhotoMaxWidth,
+		PhotoThumbnailWidth:    cfg.Photos.ThumbnailWidth,
+		PhotoMaxPerSource:      DefaultPhotoMaxPerSource,
+
+		PopularityRollupInterval: cfg.Popularity.RollupInterval,
+		TrendingHalfLife:         cfg.Popularity.TrendingHalfLife,
+
+		EventRelayInterval:  cfg.Events.RelayInterval,
+		EventRelayBatchSize: cfg.Events.RelayBatchSize,
+		EventMaxAttempts:    cfg.Events.MaxAttempts,
+		OutboxRetention:     cfg.Events.OutboxRetention,
+
+		DeletedRetention: cfg.Deletion.Retention,
+		PurgeInterval:    cfg.Deletion.PurgeInterval,
+
+		CurrencyRates: cfg.Pricing.Rates(),
+	}, nil
 }
 
 // Module provides application layer dependencies
@@ -17,5 +55,154 @@ var Module = fx.Module("restaurant.application",
 	fx.Provide(
 		NewConfig,
 		NewRestaurantService,
+		NewMergeService,
+		NewTabelogService,
+		NewRevisionService,
+		NewSuggestionService,
+		NewPhotoService,
+		NewCatalogService,
+		NewPopularityService,
+		NewRecommendationService,
+		NewCollectionService,
+		NewVisitService,
+		NewReviewService,
+		NewDeletedRestaurantService,
+		NewCurrencyConverter,
+		NewRefresher,
+		NewOutboxRelay,
 	),
+	fx.Invoke(registerRefresherLifecycle),
+	fx.Invoke(registerPopularityRollupLifecycle),
+	fx.Invoke(registerOutboxRelayLifecycle),
+	fx.Invoke(registerPurgeLifecycle),
 )
+
+// registerRefresherLifecycle starts the scheduled refresh with the application
+func registerRefresherLifecycle(lc fx.Lifecycle, refresher *Refresher) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// The lifecycle context is cancelled after startup, so the
+			// scheduler runs with a background context
+			refresher.Start(context.Background())
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return refresher.Stop(ctx)
+		},
+	})
+}
+
+// registerOutboxRelayLifecycle publishes the outbox with the application
+func registerOutboxRelayLifecycle(lc fx.Lifecycle, relay *OutboxRelay) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			relay.Start(context.Background())
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return relay.Stop(ctx)
+		},
+	})
+}
+
+// registerPopularityRollupLifecycle rolls up the popularity counters every
+// PopularityRollupInterval while the application runs
+func registerPopularityRollupLifecycle(lc fx.Lifecycle, service PopularityService, config *Config, logger *zap.Logger) {
+	if config.PopularityRollupInterval <= 0 {
+		logger.Info("Popularity rollup disabled")
+		return
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				defer close(done)
+				runPopularityRollup(service, config.PopularityRollupInterval, stop, logger)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(stop)
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
+
+// runPopularityRollup runs Rollup on every tick until stop is closed
+func runPopularityRollup(service PopularityService, interval time.Duration, stop <-chan struct{}, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			saved, err := service.Rollup(context.Background())
+			if err != nil {
+				logger.Error("Popularity rollup failed", zap.Error(err))
+				continue
+			}
+			logger.Debug("Popularity counters rolled up", zap.Int("counts", saved))
+		}
+	}
+}
+
+// registerPurgeLifecycle purges expired deleted restaurants every
+// PurgeInterval while the application runs
+func registerPurgeLifecycle(lc fx.Lifecycle, service DeletedRestaurantService, config *Config, logger *zap.Logger) {
+	if config.PurgeInterval <= 0 || config.DeletedRetention <= 0 {
+		logger.Info("Deleted restaurant purge disabled")
+		return
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				defer close(done)
+				runPurge(service, config.PurgeInterval, stop, logger)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(stop)
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
+
+// runPurge runs PurgeExpired on every tick until stop is closed
+func runPurge(service DeletedRestaurantService, interval time.Duration, stop <-chan struct{}, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			purged, err := service.PurgeExpired(context.Background())
+			if err != nil {
+				logger.Error("Deleted restaurant purge failed", zap.Error(err))
+				continue
+			}
+			if purged > 0 {
+				logger.Info("Deleted restaurants purged", zap.Int("restaurants", purged))
+			}
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// DefaultEventRelayBatchSize is the number of outbox events published at once
+// when EventRelayBatchSize is not set
+const DefaultEventRelayBatchSize = 100
+
+// outboxPurgeInterval is how often published events older than
+// OutboxRetention are deleted
+const outboxPurgeInterval = time.Hour
+
+// OutboxRelay publishes the domain events written to the outbox through the
+// EventPublisher, oldest first. A failed batch stays in the outbox and is
+// retried one event at a time to find the event that fails; the rest is
+// retried on the next tick, so events are delivered at least once. An event
+// that fails EventMaxAttempts times on its own is dead-lettered so that it
+// does not hold back the events behind it.
+type OutboxRelay struct {
+	outbox    repository.OutboxRepository
+	publisher EventPublisher
+	config    *Config
+	logger    *zap.Logger
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewOutboxRelay creates a new outbox relay
+func NewOutboxRelay(
+	outbox repository.OutboxRepository,
+	publisher EventPublisher,
+	config *Config,
+	logger *zap.Logger,
+) *OutboxRelay {
+	return &OutboxRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		config:    config,
+		logger:    logger.With(zap.String("component", "outbox_relay")),
+		stopChan:  make(chan struct{}),
+	}
+}
+
+// Start starts relaying events; it does nothing when EventRelayInterval is 0
+func (r *OutboxRelay) Start(ctx context.Context) {
+	if r.config.EventRelayInterval <= 0 {
+		r.logger.Info("Outbox relay disabled")
+		return
+	}
+
+	r.wg.Add(1)
+	go r.scheduler(ctx)
+
+	r.logger.Info("Outbox relay started",
+		zap.Duration("interval", r.config.EventRelayInterval),
+		zap.Int("batch_size", r.batchSize()),
+	)
+}
+
+// Stop stops relaying and waits for a batch in progress
+func (r *OutboxRelay) Stop(ctx context.Context) error {
+	close(r.stopChan)
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		r.logger.Info("Outbox relay stopped")
+		return nil
+	case <-ctx.Done():
+		r.logger.Warn("Outbox relay stop timeout")
+		return fmt.Errorf("shutdown timeout")
+	}
+}
+
+func (r *OutboxRelay) scheduler(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.EventRelayInterval)
+	defer ticker.Stop()
+	purge := time.NewTicker(outboxPurgeInterval)
+	defer purge.Stop()
+
+	for {
+		select {
+		case <-r.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.RelayPending(ctx); err != nil {
+				r.logger.Error("Outbox relay failed", zap.Error(err))
+			}
+		case <-purge.C:
+			if err := r.Purge(ctx); err != nil {
+				r.logger.Error("Outbox purge failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RelayPending publishes pending events in batches until the outbox is
+// drained or an event fails, and returns the number of events published.
+// After a batch fails the rest of the run publishes one event at a time.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	limit := r.batchSize()
+	total := 0
+	for {
+		select {
+		case <-r.stopChan:
+			return total, nil
+		default:
+		}
+
+		published, err := r.outbox.ProcessPending(ctx, limit, r.publish)
+		total += published
+		if err != nil {
+			metrics.RestaurantEventPublishFailuresTotal.Inc()
+			if limit > 1 {
+				limit = 1
+				continue
+			}
+			deadLettered, dlErr := r.deadLetter(ctx)
+			if dlErr != nil {
+				r.logger.Error("Failed to dead-letter outbox events", zap.Error(dlErr))
+			}
+			if deadLettered == 0 {
+				return total, err
+			}
+			continue
+		}
+		if published < limit {
+			break
+		}
+	}
+
+	if pending, err := r.outbox.CountPending(ctx); err == nil {
+		metrics.RestaurantOutboxPending.Set(float64(pending))
+	}
+	if total > 0 {
+		r.logger.Debug("Outbox events published", zap.Int("events", total))
+	}
+	return total, nil
+}
+
+// Purge deletes published events older than OutboxRetention; it does nothing
+// when OutboxRetention is 0
+func (r *OutboxRelay) Purge(ctx context.Context) error {
+	if r.config.OutboxRetention <= 0 {
+		return nil
+	}
+
+	deleted, err := r.outbox.DeletePublishedBefore(ctx, time.Now().Add(-r.config.OutboxRetention))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		r.logger.Info("Purged published outbox events", zap.Int64("events", deleted))
+	}
+	return nil
+}
+
+// deadLetter gives up on the events that failed EventMaxAttempts times; it
+// does nothing when EventMaxAttempts is 0
+func (r *OutboxRelay) deadLetter(ctx context.Context) (int, error) {
+	if r.config.EventMaxAttempts <= 0 {
+		return 0, nil
+	}
+
+	events, err := r.outbox.DeadLetterPending(ctx, r.config.EventMaxAttempts)
+	if err != nil {
+		return 0, err
+	}
+	for _, event := range events {
+		metrics.RestaurantEventsDeadLetteredTotal.WithLabelValues(string(event.Type())).Inc()
+		r.logger.Error("Outbox event dead-lettered",
+			zap.String("event_id", event.ID().String()),
+			zap.String("type", string(event.Type())),
+			zap.String("aggregate_id", event.AggregateID().String()),
+			zap.Int("attempts", r.config.EventMaxAttempts),
+		)
+	}
+	return len(events), nil
+}
+
+// publish is the outbox handler of a batch
+func (r *OutboxRelay) publish(ctx context.Context, events []*model.DomainEvent) error {
+	if err := r.publisher.Publish(ctx, events); err != nil {
+		return err
+	}
+	for _, event := range events {
+		metrics.RestaurantEventsPublishedTotal.WithLabelValues(string(event.Type())).Inc()
+	}
+	return nil
+}
+
+func (r *OutboxRelay) batchSize() int {
+	if r.config.EventRelayBatchSize > 0 {
+		return r.config.EventRelayBatchSize
+	}
+	return DefaultEventRelayBatchSize
+}
@@ -0,0 +1,200 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure/events"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestOutboxRelay(t *testing.T, config *Config) (*OutboxRelay, *mocks.MockOutboxRepository, *events.MemoryPublisher) {
+	ctrl := gomock.NewController(t)
+	outbox := mocks.NewMockOutboxRepository(ctrl)
+	publisher := events.NewMemoryPublisher()
+	return NewOutboxRelay(outbox, publisher, config, zap.NewNop()), outbox, publisher
+}
+
+// pendingBatches serves the batches from ProcessPending like the outbox
+// does: a batch is handed to the handler and counts as published only when
+// the handler succeeds
+func pendingBatches(batches ...[]*model.DomainEvent) func(context.Context, int, repository.OutboxHandler) (int, error) {
+	return func(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error) {
+		if len(batches) == 0 {
+			return 0, nil
+		}
+		batch := batches[0]
+		if err := handler(ctx, batch); err != nil {
+			return 0, err
+		}
+		batches = batches[1:]
+		return len(batch), nil
+	}
+}
+
+// pendingQueue serves events like the outbox does, honouring the limit and
+// counting the failures of events handed to the handler on their own
+type pendingQueue struct {
+	events   []*model.DomainEvent
+	attempts map[uuid.UUID]int
+}
+
+func newPendingQueue(events []*model.DomainEvent) *pendingQueue {
+	return &pendingQueue{events: events, attempts: make(map[uuid.UUID]int)}
+}
+
+func (q *pendingQueue) ProcessPending(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error) {
+	batch := q.events[:min(limit, len(q.events))]
+	if len(batch) == 0 {
+		return 0, nil
+	}
+	if err := handler(ctx, batch); err != nil {
+		if len(batch) == 1 {
+			q.attempts[batch[0].ID()]++
+		}
+		return 0, err
+	}
+	q.events = q.events[len(batch):]
+	return len(batch), nil
+}
+
+func (q *pendingQueue) DeadLetterPending(ctx context.Context, maxAttempts int) ([]*model.DomainEvent, error) {
+	var dead, pending []*model.DomainEvent
+	for _, event := range q.events {
+		if q.attempts[event.ID()] >= maxAttempts {
+			dead = append(dead, event)
+		} else {
+			pending = append(pending, event)
+		}
+	}
+	q.events = pending
+	return dead, nil
+}
+
+func favoriteEvents(n int) []*model.DomainEvent {
+	events := make([]*model.DomainEvent, n)
+	for i := range events {
+		events[i] = model.NewFavoriteAddedEvent(model.NewFavorite(uuid.New(), uuid.New()))
+	}
+	return events
+}
+
+func TestOutboxRelay_RelayPending_DrainsBatches(t *testing.T) {
+	relay, outbox, publisher := newTestOutboxRelay(t, &Config{EventRelayBatchSize: 2})
+	ctx := context.Background()
+	first, second := favoriteEvents(2), favoriteEvents(1)
+
+	outbox.EXPECT().ProcessPending(ctx, 2, gomock.Any()).DoAndReturn(pendingBatches(first, second)).Times(2)
+	outbox.EXPECT().CountPending(ctx).Return(int64(0), nil)
+
+	published, err := relay.RelayPending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, published)
+	assert.Equal(t, append(first, second...), publisher.Events())
+}
+
+func TestOutboxRelay_RelayPending_PublishError(t *testing.T) {
+	relay, outbox, publisher := newTestOutboxRelay(t, &Config{EventRelayBatchSize: 2})
+	ctx := context.Background()
+	batch := favoriteEvents(2)
+	publisher.FailWith(errors.New("broker down"))
+
+	// The failed batch is retried one event at a time before giving up
+	outbox.EXPECT().ProcessPending(ctx, 2, gomock.Any()).DoAndReturn(pendingBatches(batch))
+	outbox.EXPECT().ProcessPending(ctx, 1, gomock.Any()).DoAndReturn(pendingBatches(batch[:1]))
+
+	published, err := relay.RelayPending(ctx)
+
+	assert.EqualError(t, err, "broker down")
+	assert.Zero(t, published)
+	assert.Empty(t, publisher.Events())
+
+	// The batch is still pending and goes out once the broker is back
+	publisher.FailWith(nil)
+	outbox.EXPECT().ProcessPending(ctx, 2, gomock.Any()).DoAndReturn(pendingBatches(batch))
+	outbox.EXPECT().ProcessPending(ctx, 2, gomock.Any()).Return(0, nil)
+	outbox.EXPECT().CountPending(ctx).Return(int64(0), nil)
+
+	published, err = relay.RelayPending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Equal(t, batch, publisher.Events())
+}
+
+func TestOutboxRelay_RelayPending_DeadLettersRejectedEvent(t *testing.T) {
+	relay, outbox, publisher := newTestOutboxRelay(t, &Config{EventRelayBatchSize: 2, EventMaxAttempts: 2})
+	ctx := context.Background()
+	pending := favoriteEvents(4)
+	queue := newPendingQueue(pending)
+	publisher.Reject(pending[1], errors.New("message too large"))
+
+	outbox.EXPECT().ProcessPending(ctx, gomock.Any(), gomock.Any()).DoAndReturn(queue.ProcessPending).AnyTimes()
+	outbox.EXPECT().DeadLetterPending(ctx, 2).DoAndReturn(queue.DeadLetterPending).Times(2)
+
+	// The events before the rejected one still go out
+	published, err := relay.RelayPending(ctx)
+
+	assert.EqualError(t, err, "message too large")
+	assert.Equal(t, 1, published)
+	assert.Equal(t, pending[:1], publisher.Events())
+
+	// Once it has failed EventMaxAttempts times it no longer holds back the others
+	outbox.EXPECT().CountPending(ctx).Return(int64(0), nil)
+
+	published, err = relay.RelayPending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Equal(t, []*model.DomainEvent{pending[0], pending[2], pending[3]}, publisher.Events())
+	assert.Empty(t, queue.events)
+}
+
+func TestOutboxRelay_RelayPending_DefaultBatchSize(t *testing.T) {
+	relay, outbox, _ := newTestOutboxRelay(t, &Config{})
+	ctx := context.Background()
+
+	outbox.EXPECT().ProcessPending(ctx, DefaultEventRelayBatchSize, gomock.Any()).Return(0, nil)
+	outbox.EXPECT().CountPending(ctx).Return(int64(0), nil)
+
+	published, err := relay.RelayPending(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, published)
+}
+
+func TestOutboxRelay_Purge(t *testing.T) {
+	relay, outbox, _ := newTestOutboxRelay(t, &Config{OutboxRetention: 24 * time.Hour})
+	ctx := context.Background()
+
+	outbox.EXPECT().DeletePublishedBefore(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, before time.Time) (int64, error) {
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), before, time.Minute)
+		return 12, nil
+	})
+
+	require.NoError(t, relay.Purge(ctx))
+}
+
+func TestOutboxRelay_Purge_KeepsEventsWithoutRetention(t *testing.T) {
+	relay, _, _ := newTestOutboxRelay(t, &Config{})
+
+	require.NoError(t, relay.Purge(context.Background()))
+}
+
+func TestOutboxRelay_StartDisabled(t *testing.T) {
+	relay, _, _ := newTestOutboxRelay(t, &Config{})
+
+	relay.Start(context.Background())
+
+	require.NoError(t, relay.Stop(context.Background()))
+}
@@ -0,0 +1,359 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/imaging"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultPhotoURLTTL is the minimum validity of a signed photo URL
+	DefaultPhotoURLTTL = 24 * time.Hour
+
+	// DefaultPhotoMaxWidth is the width Google photos are downloaded at
+	DefaultPhotoMaxWidth = 1600
+
+	// DefaultPhotoThumbnailWidth is the max width of generated thumbnails
+	DefaultPhotoThumbnailWidth = 320
+
+	// DefaultPhotoMaxPerSource caps the photos ingested per source and restaurant
+	DefaultPhotoMaxPerSource = 10
+)
+
+// TabelogImageHosts are the hosts Tabelog images may be downloaded from,
+// subdomains included
+var TabelogImageHosts = []string{"tabelog.com", "k-img.com"}
+
+// IngestPhotosResult reports an ingestion run for a restaurant
+type IngestPhotosResult struct {
+	Ingested []*model.Photo
+	Skipped  int // already ingested
+	Failed   int // could not be downloaded or decoded
+}
+
+// PhotoSignature authorizes access to one rendition of a photo until Expires
+type PhotoSignature struct {
+	Expires   int64 // unix seconds
+	Signature string
+}
+
+// PhotoContent is a stored rendition of a photo
+type PhotoContent struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+	ExpiresAt   time.Time // end of the signature's validity
+}
+
+// PhotoService copies restaurant photos from Google Places and Tabelog into
+// blob storage and serves them through signed URLs, so clients never see
+// the Google API key or hotlink Tabelog.
+type PhotoService interface {
+	// IngestPhotos stores the Google photos referenced in the restaurant's
+	// metadata and the given Tabelog image URLs. Images already stored are
+	// skipped; images that fail to download are counted and skipped.
+	IngestPhotos(ctx context.Context, restaurantID uuid.UUID, tabelogURLs []string) (*IngestPhotosResult, error)
+
+	// ListPhotos lists the stored photos of a restaurant
+	ListPhotos(ctx context.Context, restaurantID uuid.UUID) ([]*model.Photo, error)
+
+	// SignPhoto signs access to a rendition of a photo. Signatures are stable
+	// within a TTL window so signed URLs can be cached.
+	SignPhoto(photoID uuid.UUID, size model.PhotoSize) PhotoSignature
+
+	// OpenPhoto verifies the signature and loads the rendition
+	OpenPhoto(ctx context.Context, photoID uuid.UUID, size model.PhotoSize, sig PhotoSignature) (*PhotoContent, error)
+}
+
+type photoService struct {
+	restaurantRepo repository.RestaurantRepository
+	photoRepo      repository.PhotoRepository
+	blobs          BlobStore
+	downloader     PhotoDownloader
+	mapClient      MapServiceClient
+	config         *Config
+	logger         *zap.Logger
+}
+
+// NewPhotoService creates a new photo service
+func NewPhotoService(
+	restaurantRepo repository.RestaurantRepository,
+	photoRepo repository.PhotoRepository,
+	blobs BlobStore,
+	downloader PhotoDownloader,
+	mapClient MapServiceClient,
+	config *Config,
+	logger *zap.Logger,
+) PhotoService {
+	return &photoService{
+		restaurantRepo: restaurantRepo,
+		photoRepo:      photoRepo,
+		blobs:          blobs,
+		downloader:     downloader,
+		mapClient:      mapClient,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+// photoRef is an image to ingest
+type photoRef struct {
+	source model.RestaurantSource
+	ref    string
+}
+
+func (s *photoService) IngestPhotos(ctx context.Context, restaurantID uuid.UUID, tabelogURLs []string) (*IngestPhotosResult, error) {
+	for _, u := range tabelogURLs {
+		if !isTabelogImageURL(u) {
+			return nil, fmt.Errorf("%w: %s", domainerrors.ErrInvalidPhotoURL, u)
+		}
+	}
+
+	restaurant, err := s.restaurantRepo.FindByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.photoRepo.ListByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		stored[p.SourceRef()] = true
+	}
+
+	var refs []photoRef
+	for _, name := range limitRefs(googlePhotoNames(restaurant), s.maxPerSource()) {
+		refs = append(refs, photoRef{source: model.SourceGoogle, ref: name})
+	}
+	for _, u := range limitRefs(tabelogURLs, s.maxPerSource()) {
+		refs = append(refs, photoRef{source: model.SourceTabelog, ref: u})
+	}
+
+	result := &IngestPhotosResult{Ingested: []*model.Photo{}}
+	for _, ref := range refs {
+		if stored[ref.ref] {
+			result.Skipped++
+			continue
+		}
+		stored[ref.ref] = true
+
+		photo, err := s.ingest(ctx, restaurantID, ref)
+		switch {
+		case errors.Is(err, domainerrors.ErrPhotoAlreadyExists):
+			result.Skipped++
+		case err != nil:
+			s.logger.Warn("Failed to ingest photo",
+				zap.String("restaurant_id", restaurantID.String()),
+				zap.String("source", string(ref.source)),
+				zap.String("ref", ref.ref),
+				zap.Error(err),
+			)
+			result.Failed++
+		default:
+			result.Ingested = append(result.Ingested, photo)
+		}
+	}
+
+	s.logger.Info("Photos ingested",
+		zap.String("restaurant_id", restaurantID.String()),
+		zap.Int("ingested", len(result.Ingested)),
+		zap.Int("skipped", result.Skipped),
+		zap.Int("failed", result.Failed),
+	)
+
+	return result, nil
+}
+
+// ingest downloads one image and stores it with its thumbnail
+func (s *photoService) ingest(ctx context.Context, restaurantID uuid.UUID, ref photoRef) (*model.Photo, error) {
+	var data []byte
+	var err error
+	if ref.source == model.SourceGoogle {
+		data, _, err = s.mapClient.GetPhotoMedia(ctx, ref.ref, s.maxWidth())
+	} else {
+		data, _, err = s.downloader.Download(ctx, ref.ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The content type is taken from the image itself rather than the
+	// response headers, which also rejects anything that is not an image
+	info, err := imaging.Inspect(data)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := imaging.Thumbnail(data, s.thumbnailWidth())
+	if err != nil {
+		return nil, err
+	}
+
+	photo := model.NewPhoto(restaurantID, ref.source, ref.ref, info.ContentType, info.Width, info.Height)
+	if err := s.blobs.Put(ctx, photo.BlobKey(), data); err != nil {
+		return nil, err
+	}
+	if err := s.blobs.Put(ctx, photo.ThumbnailKey(), thumbnail); err != nil {
+		s.deleteBlobs(ctx, photo)
+		return nil, err
+	}
+	if err := s.photoRepo.Create(ctx, photo); err != nil {
+		s.deleteBlobs(ctx, photo)
+		return nil, err
+	}
+	return photo, nil
+}
+
+// deleteBlobs removes the images of a photo that could not be saved
+func (s *photoService) deleteBlobs(ctx context.Context, photo *model.Photo) {
+	for _, key := range []string{photo.BlobKey(), photo.ThumbnailKey()} {
+		if err := s.blobs.Delete(ctx, key); err != nil && !errors.Is(err, domainerrors.ErrBlobNotFound) {
+			s.logger.Warn("Failed to delete orphaned photo blob", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+func (s *photoService) ListPhotos(ctx context.Context, restaurantID uuid.UUID) ([]*model.Photo, error) {
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+	return s.photoRepo.ListByRestaurant(ctx, restaurantID)
+}
+
+func (s *photoService) SignPhoto(photoID uuid.UUID, size model.PhotoSize) PhotoSignature {
+	// Expiry is rounded to the TTL window so that every request in a window
+	// gets the same URL, which keeps browser and CDN caches effective
+	ttl := s.urlTTL()
+	expires := time.Now().Truncate(ttl).Add(2 * ttl).Unix()
+
+	return PhotoSignature{
+		Expires:   expires,
+		Signature: s.sign(photoID, size, expires),
+	}
+}
+
+func (s *photoService) OpenPhoto(ctx context.Context, photoID uuid.UUID, size model.PhotoSize, sig PhotoSignature) (*PhotoContent, error) {
+	expected := s.sign(photoID, size, sig.Expires)
+	if !size.IsValid() || time.Now().Unix() > sig.Expires || !hmac.Equal([]byte(expected), []byte(sig.Signature)) {
+		return nil, domainerrors.ErrInvalidPhotoSignature
+	}
+
+	photo, err := s.photoRepo.FindByID(ctx, photoID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, contentType := photo.Rendition(size)
+	data, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrBlobNotFound) {
+			s.logger.Error("Photo blob missing", zap.String("photo_id", photoID.String()), zap.String("key", key))
+			return nil, domainerrors.ErrPhotoNotFound
+		}
+		return nil, err
+	}
+
+	return &PhotoContent{
+		Data:        data,
+		ContentType: contentType,
+		// Stored renditions never change, so the key identifies the content
+		ETag:      fmt.Sprintf("%q", photoID.String()+"-"+string(size)),
+		ExpiresAt: time.Unix(sig.Expires, 0),
+	}, nil
+}
+
+func (s *photoService) sign(photoID uuid.UUID, size model.PhotoSize, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(s.config.PhotoSigningKey))
+	fmt.Fprintf(mac, "%s|%s|%d", photoID, size, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *photoService) urlTTL() time.Duration {
+	if s.config.PhotoURLTTL > 0 {
+		return s.config.PhotoURLTTL
+	}
+	return DefaultPhotoURLTTL
+}
+
+func (s *photoService) maxWidth() int {
+	if s.config.PhotoMaxWidth > 0 {
+		return s.config.PhotoMaxWidth
+	}
+	return DefaultPhotoMaxWidth
+}
+
+func (s *photoService) thumbnailWidth() int {
+	if s.config.PhotoThumbnailWidth > 0 {
+		return s.config.PhotoThumbnailWidth
+	}
+	return DefaultPhotoThumbnailWidth
+}
+
+func (s *photoService) maxPerSource() int {
+	if s.config.PhotoMaxPerSource > 0 {
+		return s.config.PhotoMaxPerSource
+	}
+	return DefaultPhotoMaxPerSource
+}
+
+// googlePhotoNames returns the Google photo references stored in the
+// restaurant's metadata by the Map Service converter. Metadata read back
+// from the database holds generic JSON values.
+func googlePhotoNames(r *model.Restaurant) []string {
+	var names []string
+	switch photos := r.Metadata()["photos"].(type) {
+	case []map[string]interface{}:
+		for _, p := range photos {
+			if name, ok := p["name"].(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	case []interface{}:
+		for _, p := range photos {
+			if photo, ok := p.(map[string]interface{}); ok {
+				if name, ok := photo["name"].(string); ok && name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+func limitRefs(refs []string, limit int) []string {
+	if len(refs) > limit {
+		return refs[:limit]
+	}
+	return refs
+}
+
+// isTabelogImageURL checks that an image URL points to Tabelog, so ingestion
+// cannot be used to make the service fetch arbitrary URLs
+func isTabelogImageURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range TabelogImageHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
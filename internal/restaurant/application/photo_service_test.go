@@ -0,0 +1,249 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"sync"
+	"testing"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// MockPhotoRepository is a mock implementation of PhotoRepository
+type MockPhotoRepository struct {
+	mock.Mock
+}
+
+func (m *MockPhotoRepository) Create(ctx context.Context, photo *model.Photo) error {
+	args := m.Called(ctx, photo)
+	return args.Error(0)
+}
+
+func (m *MockPhotoRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Photo, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Photo), args.Error(1)
+}
+
+func (m *MockPhotoRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*model.Photo, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Photo), args.Error(1)
+}
+
+// MockPhotoDownloader is a mock implementation of PhotoDownloader
+type MockPhotoDownloader struct {
+	mock.Mock
+}
+
+func (m *MockPhotoDownloader) Download(ctx context.Context, url string) ([]byte, string, error) {
+	args := m.Called(ctx, url)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).([]byte), args.String(1), args.Error(2)
+}
+
+// memoryBlobStore is an in-memory BlobStore
+type memoryBlobStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func newMemoryBlobStore() *memoryBlobStore {
+	return &memoryBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *memoryBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = data
+	return nil
+}
+
+func (s *memoryBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, domainerrors.ErrBlobNotFound
+	}
+	return data, nil
+}
+
+func (s *memoryBlobStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.blobs[key]; !ok {
+		return domainerrors.ErrBlobNotFound
+	}
+	delete(s.blobs, key)
+	return nil
+}
+
+type photoTestDeps struct {
+	restaurantRepo *MockRestaurantRepository
+	photoRepo      *MockPhotoRepository
+	blobs          *memoryBlobStore
+	downloader     *MockPhotoDownloader
+	mapClient      *MockMapServiceClient
+	service        PhotoService
+}
+
+func newTestPhotoService() *photoTestDeps {
+	deps := &photoTestDeps{
+		restaurantRepo: new(MockRestaurantRepository),
+		photoRepo:      new(MockPhotoRepository),
+		blobs:          newMemoryBlobStore(),
+		downloader:     new(MockPhotoDownloader),
+		mapClient:      new(MockMapServiceClient),
+	}
+	config := &Config{
+		PhotoSigningKey:     "test-key",
+		PhotoURLTTL:         time.Hour,
+		PhotoThumbnailWidth: 16,
+		PhotoMaxPerSource:   2,
+	}
+	deps.service = NewPhotoService(deps.restaurantRepo, deps.photoRepo, deps.blobs, deps.downloader, deps.mapClient, config, zap.NewNop())
+	return deps
+}
+
+func testJPEG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	return buf.Bytes()
+}
+
+// Test IngestPhotos
+func TestPhotoService_IngestPhotos(t *testing.T) {
+	deps := newTestPhotoService()
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	restaurant.SetMetadata("photos", []interface{}{
+		map[string]interface{}{"name": "places/p1/photos/a"},
+		map[string]interface{}{"name": "places/p1/photos/b"},
+		map[string]interface{}{"name": "places/p1/photos/c"},
+	})
+	stored := model.NewPhoto(restaurant.ID(), model.SourceGoogle, "places/p1/photos/a", "image/jpeg", 64, 32)
+	tabelogURL := "https://tblg.k-img.com/restaurant/images/Rvw/1/640x640_rect_1.jpg"
+
+	deps.restaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	deps.photoRepo.On("ListByRestaurant", ctx, restaurant.ID()).Return([]*model.Photo{stored}, nil)
+	deps.mapClient.On("GetPhotoMedia", ctx, "places/p1/photos/b", DefaultPhotoMaxWidth).Return(testJPEG(t, 64, 32), "image/jpeg", nil)
+	deps.downloader.On("Download", ctx, tabelogURL).Return(testJPEG(t, 40, 40), "image/jpeg", nil)
+	deps.photoRepo.On("Create", ctx, mock.AnythingOfType("*model.Photo")).Return(nil)
+
+	result, err := deps.service.IngestPhotos(ctx, restaurant.ID(), []string{tabelogURL})
+
+	require.NoError(t, err)
+	// The third Google photo is over the per-source limit
+	require.Len(t, result.Ingested, 2)
+	assert.Equal(t, 1, result.Skipped)
+	assert.Equal(t, 0, result.Failed)
+
+	google := result.Ingested[0]
+	assert.Equal(t, model.SourceGoogle, google.Source())
+	assert.Equal(t, "places/p1/photos/b", google.SourceRef())
+	assert.Equal(t, 64, google.Width())
+	assert.Equal(t, "image/jpeg", google.ContentType())
+	assert.Equal(t, model.SourceTabelog, result.Ingested[1].Source())
+
+	thumbnail, err := deps.blobs.Get(ctx, google.ThumbnailKey())
+	require.NoError(t, err)
+	config, err := jpeg.DecodeConfig(bytes.NewReader(thumbnail))
+	require.NoError(t, err)
+	assert.Equal(t, 16, config.Width)
+	assert.Equal(t, 8, config.Height)
+	deps.mapClient.AssertNotCalled(t, "GetPhotoMedia", ctx, "places/p1/photos/a", mock.Anything)
+}
+
+func TestPhotoService_IngestPhotos_CountsFailures(t *testing.T) {
+	deps := newTestPhotoService()
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	tabelogURL := "https://tabelog.com/images/not-an-image.jpg"
+
+	deps.restaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	deps.photoRepo.On("ListByRestaurant", ctx, restaurant.ID()).Return([]*model.Photo{}, nil)
+	deps.downloader.On("Download", ctx, tabelogURL).Return([]byte("<html></html>"), "image/jpeg", nil)
+
+	result, err := deps.service.IngestPhotos(ctx, restaurant.ID(), []string{tabelogURL})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Ingested)
+	assert.Equal(t, 1, result.Failed)
+	assert.Empty(t, deps.blobs.blobs)
+	deps.photoRepo.AssertNotCalled(t, "Create")
+}
+
+func TestPhotoService_IngestPhotos_RejectsOtherHosts(t *testing.T) {
+	deps := newTestPhotoService()
+
+	for _, u := range []string{"https://example.com/a.jpg", "file:///etc/passwd", "https://tabelog.com.evil.io/a.jpg"} {
+		_, err := deps.service.IngestPhotos(context.Background(), uuid.New(), []string{u})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPhotoURL, u)
+	}
+	deps.restaurantRepo.AssertNotCalled(t, "FindByID")
+}
+
+// Test SignPhoto and OpenPhoto
+func TestPhotoService_OpenPhoto(t *testing.T) {
+	deps := newTestPhotoService()
+	ctx := context.Background()
+	photo := model.NewPhoto(uuid.New(), model.SourceTabelog, "https://tabelog.com/a.jpg", "image/png", 10, 10)
+	require.NoError(t, deps.blobs.Put(ctx, photo.ThumbnailKey(), []byte("thumb")))
+
+	deps.photoRepo.On("FindByID", ctx, photo.ID()).Return(photo, nil)
+
+	sig := deps.service.SignPhoto(photo.ID(), model.PhotoSizeThumbnail)
+	assert.Equal(t, sig, deps.service.SignPhoto(photo.ID(), model.PhotoSizeThumbnail), "signatures are stable within the TTL window")
+	assert.Greater(t, time.Until(time.Unix(sig.Expires, 0)), time.Hour-time.Second)
+
+	content, err := deps.service.OpenPhoto(ctx, photo.ID(), model.PhotoSizeThumbnail, sig)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("thumb"), content.Data)
+	assert.Equal(t, "image/jpeg", content.ContentType)
+	assert.NotEmpty(t, content.ETag)
+}
+
+func TestPhotoService_OpenPhoto_InvalidSignature(t *testing.T) {
+	deps := newTestPhotoService()
+	ctx := context.Background()
+	id := uuid.New()
+	sig := deps.service.SignPhoto(id, model.PhotoSizeThumbnail)
+	expired := time.Now().Add(-time.Minute).Unix()
+
+	tests := []struct {
+		name string
+		size model.PhotoSize
+		sig  PhotoSignature
+	}{
+		{"other size", model.PhotoSizeFull, sig},
+		{"tampered expiry", model.PhotoSizeThumbnail, PhotoSignature{Expires: sig.Expires + 3600, Signature: sig.Signature}},
+		{"expired", model.PhotoSizeThumbnail, PhotoSignature{
+			Expires:   expired,
+			Signature: deps.service.(*photoService).sign(id, model.PhotoSizeThumbnail, expired),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := deps.service.OpenPhoto(ctx, id, tt.size, tt.sig)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidPhotoSignature)
+		})
+	}
+	deps.photoRepo.AssertNotCalled(t, "FindByID")
+}
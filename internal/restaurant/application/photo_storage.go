@@ -0,0 +1,17 @@
+package application
+
+import "context"
+
+// BlobStore stores photo images by key. Implementations must be safe for
+// concurrent use and return domainerrors.ErrBlobNotFound for missing keys.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// PhotoDownloader downloads images hosted by third parties, e.g. Tabelog,
+// and returns the image bytes and their content type
+type PhotoDownloader interface {
+	Download(ctx context.Context, url string) ([]byte, string, error)
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultTrendingHalfLife is used when Config.TrendingHalfLife is not set
+	DefaultTrendingHalfLife = 48 * time.Hour
+
+	// DefaultTrendingLimit and MaxTrendingLimit bound the trending list
+	DefaultTrendingLimit = 20
+	MaxTrendingLimit     = 100
+)
+
+// trendingWindowHalfLives is how many half-lives of buckets the trending score
+// reads; older events would count for less than 4%
+const trendingWindowHalfLives = 5
+
+// popularityRollupGrace is how long a bucket is kept in Redis after it closes,
+// so events recorded by instances with a late clock are not lost
+const popularityRollupGrace = time.Minute
+
+// TrendingFilter selects the trending restaurants to return
+type TrendingFilter struct {
+	Area        string
+	CuisineType string
+	Limit       int // DefaultTrendingLimit when 0, at most MaxTrendingLimit
+}
+
+// PopularityService ranks restaurants by recent views, favorites and visits.
+// Events are counted in Redis (see recordPopularity) and rolled up into
+// Postgres by Rollup, which the trending score is computed from.
+type PopularityService interface {
+	// GetTrending returns the top trending restaurants, highest score first
+	GetTrending(ctx context.Context, filter TrendingFilter) ([]*repository.TrendingRestaurant, error)
+
+	// Rollup saves the Redis counters into Postgres and drops the closed
+	// buckets. It returns the number of restaurant counts saved.
+	Rollup(ctx context.Context) (int, error)
+}
+
+type popularityService struct {
+	counter        repository.PopularityCounter
+	popularityRepo repository.PopularityRepository
+	config         *Config
+	logger         *zap.Logger
+}
+
+// NewPopularityService creates a new popularity service
+func NewPopularityService(
+	counter repository.PopularityCounter,
+	popularityRepo repository.PopularityRepository,
+	config *Config,
+	logger *zap.Logger,
+) PopularityService {
+	return &popularityService{
+		counter:        counter,
+		popularityRepo: popularityRepo,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+func (s *popularityService) GetTrending(ctx context.Context, filter TrendingFilter) ([]*repository.TrendingRestaurant, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultTrendingLimit
+	}
+	if limit > MaxTrendingLimit {
+		limit = MaxTrendingLimit
+	}
+
+	halfLife := s.halfLife()
+	now := time.Now()
+	restaurants, err := s.popularityRepo.FindTrending(ctx, repository.TrendingQuery{
+		Area:        filter.Area,
+		CuisineType: filter.CuisineType,
+		Now:         now,
+		Since:       model.PopularityBucket(now.Add(-trendingWindowHalfLives * halfLife)),
+		HalfLife:    halfLife,
+		Limit:       limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to find trending restaurants", zap.Error(err))
+		return nil, err
+	}
+
+	return restaurants, nil
+}
+
+func (s *popularityService) halfLife() time.Duration {
+	if s.config.TrendingHalfLife > 0 {
+		return s.config.TrendingHalfLife
+	}
+	return DefaultTrendingHalfLife
+}
+
+// Rollup saves every bucket, including the open one so trending stays close to
+// real time. Redis holds each bucket's running total, so saving a bucket again
+// on the next run only applies what was counted in between.
+func (s *popularityService) Rollup(ctx context.Context) (int, error) {
+	buckets, err := s.counter.Buckets(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	saved := 0
+	for _, bucket := range buckets {
+		counts, err := s.counter.Counts(ctx, bucket)
+		if err != nil {
+			return saved, err
+		}
+		if err := s.popularityRepo.SaveCounts(ctx, counts); err != nil {
+			return saved, err
+		}
+		saved += len(counts)
+
+		if bucket.Add(model.PopularityBucketSize + popularityRollupGrace).After(now) {
+			continue
+		}
+		if err := s.counter.DeleteBucket(ctx, bucket); err != nil {
+			return saved, err
+		}
+	}
+
+	return saved, nil
+}
+
+// recordPopularity counts a popularity event. Counting is best effort: a
+// Redis failure is logged and never fails the request that caused the event.
+func recordPopularity(ctx context.Context, counter repository.PopularityCounter, logger *zap.Logger, restaurantID uuid.UUID, event model.PopularityEvent) {
+	if err := counter.Incr(ctx, restaurantID, event, time.Now()); err != nil {
+		logger.Warn("Failed to count popularity event",
+			zap.String("restaurant_id", restaurantID.String()),
+			zap.String("event", string(event)),
+			zap.Error(err),
+		)
+	}
+}
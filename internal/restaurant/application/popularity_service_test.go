@@ -0,0 +1,162 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// MockPopularityCounter is a mock implementation of PopularityCounter
+type MockPopularityCounter struct {
+	mock.Mock
+}
+
+func (m *MockPopularityCounter) Incr(ctx context.Context, restaurantID uuid.UUID, event model.PopularityEvent, at time.Time) error {
+	args := m.Called(ctx, restaurantID, event, at)
+	return args.Error(0)
+}
+
+func (m *MockPopularityCounter) Buckets(ctx context.Context) ([]time.Time, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]time.Time), args.Error(1)
+}
+
+func (m *MockPopularityCounter) Counts(ctx context.Context, bucket time.Time) ([]repository.PopularityCount, error) {
+	args := m.Called(ctx, bucket)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.PopularityCount), args.Error(1)
+}
+
+func (m *MockPopularityCounter) DeleteBucket(ctx context.Context, bucket time.Time) error {
+	args := m.Called(ctx, bucket)
+	return args.Error(0)
+}
+
+// MockPopularityRepository is a mock implementation of PopularityRepository
+type MockPopularityRepository struct {
+	mock.Mock
+}
+
+func (m *MockPopularityRepository) SaveCounts(ctx context.Context, counts []repository.PopularityCount) error {
+	args := m.Called(ctx, counts)
+	return args.Error(0)
+}
+
+func (m *MockPopularityRepository) FindTrending(ctx context.Context, query repository.TrendingQuery) ([]*repository.TrendingRestaurant, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.TrendingRestaurant), args.Error(1)
+}
+
+func newTestPopularityService(config *Config) (PopularityService, *MockPopularityCounter, *MockPopularityRepository) {
+	counter := new(MockPopularityCounter)
+	popularityRepo := new(MockPopularityRepository)
+	return NewPopularityService(counter, popularityRepo, config, zap.NewNop()), counter, popularityRepo
+}
+
+// Test GetTrending
+func TestPopularityService_GetTrending(t *testing.T) {
+	service, _, popularityRepo := newTestPopularityService(&Config{TrendingHalfLife: 24 * time.Hour})
+	ctx := context.Background()
+	trending := []*repository.TrendingRestaurant{
+		{Restaurant: newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7101), Score: 12.5, Views: 10},
+	}
+
+	popularityRepo.On("FindTrending", ctx, mock.MatchedBy(func(q repository.TrendingQuery) bool {
+		window := q.Now.Sub(q.Since)
+		return q.Area == "Tokyo" && q.CuisineType == "Ramen" && q.Limit == 5 &&
+			q.HalfLife == 24*time.Hour &&
+			window >= 5*24*time.Hour && window < 5*24*time.Hour+model.PopularityBucketSize
+	})).Return(trending, nil)
+
+	result, err := service.GetTrending(ctx, TrendingFilter{Area: "Tokyo", CuisineType: "Ramen", Limit: 5})
+
+	require.NoError(t, err)
+	assert.Equal(t, trending, result)
+	popularityRepo.AssertExpectations(t)
+}
+
+func TestPopularityService_GetTrending_Defaults(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+	}{
+		{"default limit", 0, DefaultTrendingLimit},
+		{"capped limit", 500, MaxTrendingLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, popularityRepo := newTestPopularityService(&Config{})
+			popularityRepo.On("FindTrending", mock.Anything, mock.MatchedBy(func(q repository.TrendingQuery) bool {
+				return q.Limit == tt.wantLimit && q.HalfLife == DefaultTrendingHalfLife
+			})).Return([]*repository.TrendingRestaurant{}, nil)
+
+			_, err := service.GetTrending(context.Background(), TrendingFilter{Limit: tt.limit})
+
+			require.NoError(t, err)
+			popularityRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// Test Rollup
+func TestPopularityService_Rollup(t *testing.T) {
+	service, counter, popularityRepo := newTestPopularityService(&Config{})
+	ctx := context.Background()
+	open := model.PopularityBucket(time.Now())
+	closed := open.Add(-2 * model.PopularityBucketSize)
+	closedCounts := []repository.PopularityCount{{RestaurantID: uuid.New(), BucketStart: closed, Views: 3}}
+	openCounts := []repository.PopularityCount{
+		{RestaurantID: uuid.New(), BucketStart: open, Favorites: 1},
+		{RestaurantID: uuid.New(), BucketStart: open, Visits: 2},
+	}
+
+	counter.On("Buckets", ctx).Return([]time.Time{closed, open}, nil)
+	counter.On("Counts", ctx, closed).Return(closedCounts, nil)
+	counter.On("Counts", ctx, open).Return(openCounts, nil)
+	counter.On("DeleteBucket", ctx, closed).Return(nil)
+	popularityRepo.On("SaveCounts", ctx, closedCounts).Return(nil)
+	popularityRepo.On("SaveCounts", ctx, openCounts).Return(nil)
+
+	saved, err := service.Rollup(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, saved)
+	// The open bucket keeps counting until it closes
+	counter.AssertNotCalled(t, "DeleteBucket", ctx, open)
+	counter.AssertExpectations(t)
+	popularityRepo.AssertExpectations(t)
+}
+
+func TestPopularityService_Rollup_SaveError(t *testing.T) {
+	service, counter, popularityRepo := newTestPopularityService(&Config{})
+	ctx := context.Background()
+	closed := model.PopularityBucket(time.Now()).Add(-2 * model.PopularityBucketSize)
+	counts := []repository.PopularityCount{{RestaurantID: uuid.New(), BucketStart: closed, Views: 3}}
+
+	counter.On("Buckets", ctx).Return([]time.Time{closed}, nil)
+	counter.On("Counts", ctx, closed).Return(counts, nil)
+	popularityRepo.On("SaveCounts", ctx, counts).Return(assert.AnError)
+
+	_, err := service.Rollup(ctx)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	// Counts that were not saved stay in Redis for the next run
+	counter.AssertNotCalled(t, "DeleteBucket", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,386 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/textnorm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultRecommendationRadiusKm and DefaultRecommendationLimit apply when
+	// the request leaves them unset
+	DefaultRecommendationRadiusKm = 2.0
+	DefaultRecommendationLimit    = 10
+	MaxRecommendationLimit        = 50
+
+	// recommendationCandidateFactor is how many restaurants of each candidate
+	// pool are scored per requested recommendation
+	recommendationCandidateFactor = 10
+	// recommendationTopCuisines is how many of the user's favorite cuisine
+	// categories get a candidate pool of their own
+	recommendationTopCuisines = 3
+
+	// favoriteTagWeight is the share of a favorite's weight a tag adds to the
+	// cuisine it names, e.g. a "ramen" tag on an izakaya
+	favoriteTagWeight = 0.5
+)
+
+// Score weights of the recommendation signals; each signal is between 0 and 1
+const (
+	cuisineAffinityWeight = 3.0
+	areaAffinityWeight    = 2.0
+	priceAffinityWeight   = 1.0
+	ratingWeight          = 1.0
+	proximityWeight       = 1.0
+)
+
+// RecommendationRequest asks for restaurants to recommend to a user around a location
+type RecommendationRequest struct {
+	UserID    uuid.UUID
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64 // DefaultRecommendationRadiusKm when 0
+	Limit     int     // DefaultRecommendationLimit when 0, at most MaxRecommendationLimit
+}
+
+// Recommendation is a recommended restaurant with the reason it was picked
+type Recommendation struct {
+	Restaurant *model.Restaurant
+	DistanceKm float64
+	Score      float64
+	Reason     string
+}
+
+// TasteProfile is a user's preferences learned from their favorites. Each map
+// holds the normalized value's share of the user's favorite weight, so the
+// affinities of one dimension add up to at most 1. Cuisines are keyed by
+// cuisine category ID (see model.Cuisines), or by the normalized cuisine type
+// of restaurants without a category.
+type TasteProfile struct {
+	Cuisines    map[string]float64
+	Areas       map[string]float64
+	PriceRanges map[string]float64
+	Favorites   int
+}
+
+// IsEmpty reports whether the user has no favorites to learn from
+func (p *TasteProfile) IsEmpty() bool {
+	return p.Favorites == 0
+}
+
+// RecommendationService recommends restaurants a user has not saved yet,
+// based on the cuisines, areas and price ranges of their favorites
+type RecommendationService interface {
+	// GetTasteProfile builds the user's taste profile from their favorites
+	GetTasteProfile(ctx context.Context, userID uuid.UUID) (*TasteProfile, error)
+
+	// Recommend returns restaurants within the radius of the location that
+	// are not among the user's favorites, best match first. Candidates are
+	// the nearest restaurants, the best rated ones and the best rated ones of
+	// the user's favorite cuisines, so a good match is not crowded out by
+	// closer restaurants. Users without favorites get the best rated
+	// restaurants nearby.
+	Recommend(ctx context.Context, req RecommendationRequest) ([]*Recommendation, error)
+}
+
+type recommendationService struct {
+	restaurantRepo repository.RestaurantRepository
+	favoriteRepo   repository.FavoriteRepository
+	logger         *zap.Logger
+}
+
+// NewRecommendationService creates a new recommendation service
+func NewRecommendationService(
+	restaurantRepo repository.RestaurantRepository,
+	favoriteRepo repository.FavoriteRepository,
+	logger *zap.Logger,
+) RecommendationService {
+	return &recommendationService{
+		restaurantRepo: restaurantRepo,
+		favoriteRepo:   favoriteRepo,
+		logger:         logger,
+	}
+}
+
+func (s *recommendationService) GetTasteProfile(ctx context.Context, userID uuid.UUID) (*TasteProfile, error) {
+	profile, _, err := s.tasteProfile(ctx, userID)
+	return profile, err
+}
+
+// tasteProfile also returns the IDs of the user's favorite restaurants, which
+// are never recommended
+func (s *recommendationService) tasteProfile(ctx context.Context, userID uuid.UUID) (*TasteProfile, map[uuid.UUID]bool, error) {
+	favorites, err := s.favoriteRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to find favorites", zap.String("user_id", userID.String()), zap.Error(err))
+		return nil, nil, err
+	}
+
+	saved := make(map[uuid.UUID]bool, len(favorites))
+	ids := make([]uuid.UUID, 0, len(favorites))
+	for _, f := range favorites {
+		if !saved[f.RestaurantID()] {
+			saved[f.RestaurantID()] = true
+			ids = append(ids, f.RestaurantID())
+		}
+	}
+
+	var restaurants []*model.Restaurant
+	if len(ids) > 0 {
+		restaurants, err = s.restaurantRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			s.logger.Error("Failed to find favorite restaurants", zap.String("user_id", userID.String()), zap.Error(err))
+			return nil, nil, err
+		}
+	}
+
+	return buildTasteProfile(favorites, restaurants), saved, nil
+}
+
+// buildTasteProfile weighs each favorite by how often the user went there: a
+// restaurant visited many times says more about the user's taste than one
+// saved once. Favorites of restaurants that no longer exist are skipped.
+func buildTasteProfile(favorites []*model.Favorite, restaurants []*model.Restaurant) *TasteProfile {
+	profile := &TasteProfile{
+		Cuisines:    make(map[string]float64),
+		Areas:       make(map[string]float64),
+		PriceRanges: make(map[string]float64),
+	}
+
+	byID := make(map[uuid.UUID]*model.Restaurant, len(restaurants))
+	for _, r := range restaurants {
+		byID[r.ID()] = r
+	}
+
+	total := 0.0
+	for _, f := range favorites {
+		r, ok := byID[f.RestaurantID()]
+		if !ok {
+			continue
+		}
+
+		weight := 1 + math.Log1p(float64(f.VisitCount()))
+		total += weight
+		profile.Favorites++
+
+		keys := cuisineKeys(r)
+		for _, key := range keys {
+			profile.Cuisines[key] += weight
+		}
+		addAffinity(profile.Areas, r.Area(), weight)
+		addAffinity(profile.PriceRanges, r.PriceRange(), weight)
+		for _, tag := range f.Tags() {
+			if key := tagCuisineKey(tag); key != "" && !slices.Contains(keys, key) {
+				profile.Cuisines[key] += weight * favoriteTagWeight
+			}
+		}
+	}
+
+	if total > 0 {
+		for _, m := range []map[string]float64{profile.Cuisines, profile.Areas, profile.PriceRanges} {
+			for k, v := range m {
+				m[k] = math.Min(v/total, 1)
+			}
+		}
+	}
+	return profile
+}
+
+// cuisineKeys are the taste profile keys of a restaurant's cuisine: its
+// cuisine categories, or its normalized cuisine type when it has none
+func cuisineKeys(r *model.Restaurant) []string {
+	if ids := r.Cuisines(); len(ids) > 0 {
+		return ids
+	}
+	if key := textnorm.Normalize(r.CuisineType()); key != "" {
+		return []string{key}
+	}
+	return nil
+}
+
+// tagCuisineKey is the taste profile key of a favorite's tag: the cuisine
+// category it names, or the normalized tag
+func tagCuisineKey(tag string) string {
+	if category, ok := model.Cuisines.Match(tag); ok {
+		return category.ID
+	}
+	return textnorm.Normalize(tag)
+}
+
+func addAffinity(m map[string]float64, value string, weight float64) {
+	if key := textnorm.Normalize(value); key != "" {
+		m[key] += weight
+	}
+}
+
+func (s *recommendationService) Recommend(ctx context.Context, req RecommendationRequest) ([]*Recommendation, error) {
+	if _, err := model.NewLocation(req.Latitude, req.Longitude); err != nil {
+		return nil, domainerrors.ErrInvalidLocation
+	}
+	radiusKm := req.RadiusKm
+	if radiusKm < 0 {
+		return nil, domainerrors.ErrInvalidRadius
+	}
+	if radiusKm == 0 {
+		radiusKm = DefaultRecommendationRadiusKm
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultRecommendationLimit
+	}
+	if limit > MaxRecommendationLimit {
+		limit = MaxRecommendationLimit
+	}
+
+	profile, saved, err := s.tasteProfile(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.candidates(ctx, req, radiusKm, profile, limit*recommendationCandidateFactor+len(saved))
+	if err != nil {
+		s.logger.Error("Failed to find recommendation candidates", zap.Error(err))
+		return nil, err
+	}
+
+	recommendations := make([]*Recommendation, 0, len(candidates))
+	for _, candidate := range candidates {
+		if saved[candidate.Restaurant.ID()] {
+			continue
+		}
+		recommendations = append(recommendations, scoreCandidate(profile, candidate, radiusKm))
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+	return recommendations, nil
+}
+
+// candidates gathers the restaurants to score from pools of size restaurants
+// each: the nearest ones, the best rated ones within the radius and the best
+// rated ones of each of the user's top cuisines within the radius. A
+// restaurant in several pools is scored once.
+func (s *recommendationService) candidates(
+	ctx context.Context,
+	req RecommendationRequest,
+	radiusKm float64,
+	profile *TasteProfile,
+	size int,
+) ([]*repository.NearbyRestaurant, error) {
+	nearby, err := s.restaurantRepo.FindByLocation(ctx, req.Latitude, req.Longitude, radiusKm, size)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(nearby))
+	for _, candidate := range nearby {
+		seen[candidate.Restaurant.ID()] = true
+	}
+
+	near := &repository.NearPoint{Latitude: req.Latitude, Longitude: req.Longitude, RadiusKm: radiusKm}
+	filters := []repository.RestaurantFilter{{Near: near, Sort: repository.SortByRating}}
+	for _, id := range topCuisines(profile, recommendationTopCuisines) {
+		filters = append(filters, repository.RestaurantFilter{Cuisine: id, Near: near, Sort: repository.SortByRating})
+	}
+	for _, filter := range filters {
+		page, _, err := s.restaurantRepo.FindPageByFilter(ctx, filter, nil, size)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			if seen[item.Restaurant.ID()] || item.DistanceKm == nil {
+				continue
+			}
+			seen[item.Restaurant.ID()] = true
+			nearby = append(nearby, &repository.NearbyRestaurant{Restaurant: item.Restaurant, DistanceKm: *item.DistanceKm})
+		}
+	}
+	return nearby, nil
+}
+
+// topCuisines returns up to n cuisine categories of the profile, highest
+// affinity first. Cuisine types without a category are left out, as
+// restaurants can only be filtered by category.
+func topCuisines(profile *TasteProfile, n int) []string {
+	ids := make([]string, 0, len(profile.Cuisines))
+	for key := range profile.Cuisines {
+		if _, ok := model.Cuisines.Category(key); ok {
+			ids = append(ids, key)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if profile.Cuisines[ids[i]] != profile.Cuisines[ids[j]] {
+			return profile.Cuisines[ids[i]] > profile.Cuisines[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > n {
+		ids = ids[:n]
+	}
+	return ids
+}
+
+// scoreCandidate scores a nearby restaurant against the profile and explains
+// the match with its strongest signal
+func scoreCandidate(profile *TasteProfile, candidate *repository.NearbyRestaurant, radiusKm float64) *Recommendation {
+	r := candidate.Restaurant
+	// The restaurant's best matching cuisine
+	cuisine, cuisineKey := 0.0, ""
+	for _, key := range cuisineKeys(r) {
+		if profile.Cuisines[key] > cuisine {
+			cuisine, cuisineKey = profile.Cuisines[key], key
+		}
+	}
+	area := profile.Areas[textnorm.Normalize(r.Area())]
+	price := profile.PriceRanges[textnorm.Normalize(r.PriceRange())]
+	proximity := math.Max(0, 1-candidate.DistanceKm/radiusKm)
+
+	score := cuisineAffinityWeight*cuisine +
+		areaAffinityWeight*area +
+		priceAffinityWeight*price +
+		ratingWeight*r.Rating()/5 +
+		proximityWeight*proximity
+
+	return &Recommendation{
+		Restaurant: r,
+		DistanceKm: candidate.DistanceKm,
+		Score:      score,
+		Reason:     recommendationReason(r, cuisineKey, area > 0, price > 0),
+	}
+}
+
+// recommendationReason explains a recommendation; cuisineKey is the taste
+// profile key of the matched cuisine, "" when none matched
+func recommendationReason(r *model.Restaurant, cuisineKey string, area, price bool) string {
+	cuisineName := cuisineKey
+	if category, ok := model.Cuisines.Category(cuisineKey); ok {
+		cuisineName = textnorm.Normalize(category.Label())
+	}
+	switch {
+	case cuisineKey != "" && area:
+		return fmt.Sprintf("because you like %s in %s", cuisineName, r.Area())
+	case cuisineKey != "":
+		return fmt.Sprintf("because you like %s", cuisineName)
+	case area:
+		return fmt.Sprintf("because you often save places in %s", r.Area())
+	case price:
+		return "in your usual price range"
+	case r.Rating() >= 4:
+		return "highly rated near you"
+	default:
+		return "near you"
+	}
+}
@@ -0,0 +1,175 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestRecommendationService(t *testing.T) (RecommendationService, *mocks.MockRestaurantRepository, *mocks.MockFavoriteRepository) {
+	ctrl := gomock.NewController(t)
+	restaurantRepo := mocks.NewMockRestaurantRepository(ctrl)
+	favoriteRepo := mocks.NewMockFavoriteRepository(ctrl)
+	return NewRecommendationService(restaurantRepo, favoriteRepo, zap.NewNop()), restaurantRepo, favoriteRepo
+}
+
+func newCatalogRestaurant(name, area, cuisineType, priceRange string, rating float64) *model.Restaurant {
+	location, _ := model.NewLocation(35.6595, 139.7005)
+	r := model.NewRestaurant(name, area, model.SourceGoogle, uuid.NewString(), "", location)
+	r.UpdateDetails("", "", priceRange, cuisineType, "", "")
+	r.UpdateRating(rating)
+	return r
+}
+
+func newVisitedFavorite(userID uuid.UUID, r *model.Restaurant, visits int, tags ...string) *model.Favorite {
+	f := model.NewFavorite(userID, r.ID())
+	for i := 0; i < visits; i++ {
+		f.AddVisit()
+	}
+	for _, tag := range tags {
+		f.AddTag(tag)
+	}
+	return f
+}
+
+func distanceKm(km float64) *float64 {
+	return &km
+}
+
+// Test GetTasteProfile
+func TestRecommendationService_GetTasteProfile(t *testing.T) {
+	service, restaurantRepo, favoriteRepo := newTestRecommendationService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	ichiran := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+	afuri := newCatalogRestaurant("Afuri", "Ebisu", "ラーメン", "$$", 4.3)
+	sushi := newCatalogRestaurant("Kyubey", "Ginza", "Sushi", "$$$$", 4.6)
+	favorites := []*model.Favorite{
+		newVisitedFavorite(userID, ichiran, 3),
+		newVisitedFavorite(userID, afuri, 0),
+		newVisitedFavorite(userID, sushi, 0, "Tempura"),
+	}
+
+	favoriteRepo.EXPECT().FindByUserID(ctx, userID).Return(favorites, nil)
+	restaurantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{ichiran.ID(), afuri.ID(), sushi.ID()}).
+		Return([]*model.Restaurant{ichiran, afuri, sushi}, nil)
+
+	profile, err := service.GetTasteProfile(ctx, userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, profile.Favorites)
+	assert.Greater(t, profile.Areas["shibuya"], profile.Areas["ebisu"], "visits weigh more")
+	assert.Greater(t, profile.Cuisines["ramen"], profile.Cuisines["sushi"])
+	assert.NotContains(t, profile.Cuisines, "らーめん", "cuisine types map to their category")
+	assert.Greater(t, profile.Cuisines["tempura"], 0.0, "tags count as cuisines")
+	assert.InDelta(t, 1.0, profile.PriceRanges["$$"]+profile.PriceRanges["$$$$"], 1e-9)
+}
+
+// Test Recommend
+func TestRecommendationService_Recommend(t *testing.T) {
+	service, restaurantRepo, favoriteRepo := newTestRecommendationService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	saved := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+	ramenShibuya := newCatalogRestaurant("Hayashi", "Shibuya", "Ramen", "$$", 3.8)
+	ramenElsewhere := newCatalogRestaurant("Fuunji", "Shinjuku", "Ramen", "$", 4.0)
+	cafe := newCatalogRestaurant("Fuglen", "Shibuya", "Cafe", "$", 4.5)
+	burger := newCatalogRestaurant("Shake Shack", "Gaienmae", "Burgers", "$$", 3.5)
+
+	near := &repository.NearPoint{Latitude: 35.6595, Longitude: 139.7005, RadiusKm: DefaultRecommendationRadiusKm}
+	size := 3*recommendationCandidateFactor + 1
+
+	favoriteRepo.EXPECT().FindByUserID(ctx, userID).Return([]*model.Favorite{newVisitedFavorite(userID, saved, 2)}, nil)
+	restaurantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{saved.ID()}).Return([]*model.Restaurant{saved}, nil)
+	restaurantRepo.EXPECT().FindByLocation(ctx, 35.6595, 139.7005, DefaultRecommendationRadiusKm, size).
+		Return([]*repository.NearbyRestaurant{
+			{Restaurant: saved, DistanceKm: 0.1},
+			{Restaurant: cafe, DistanceKm: 0.2},
+			{Restaurant: burger, DistanceKm: 0.3},
+		}, nil)
+	restaurantRepo.EXPECT().FindPageByFilter(ctx, repository.RestaurantFilter{Near: near, Sort: repository.SortByRating}, (*repository.Cursor)(nil), size).
+		Return([]*repository.FilteredRestaurant{
+			{Restaurant: cafe, DistanceKm: distanceKm(0.2)},
+		}, nil, nil)
+	// The ramen shops are beyond the nearest restaurants and only found as
+	// candidates of the user's favorite cuisine
+	restaurantRepo.EXPECT().FindPageByFilter(ctx, repository.RestaurantFilter{Cuisine: "ramen", Near: near, Sort: repository.SortByRating}, (*repository.Cursor)(nil), size).
+		Return([]*repository.FilteredRestaurant{
+			{Restaurant: saved, DistanceKm: distanceKm(0.1)},
+			{Restaurant: ramenElsewhere, DistanceKm: distanceKm(1.5)},
+			{Restaurant: ramenShibuya, DistanceKm: distanceKm(0.8)},
+		}, nil, nil)
+
+	recommendations, err := service.Recommend(ctx, RecommendationRequest{
+		UserID:    userID,
+		Latitude:  35.6595,
+		Longitude: 139.7005,
+		Limit:     3,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, recommendations, 3)
+	assert.Equal(t, ramenShibuya.ID(), recommendations[0].Restaurant.ID())
+	assert.Equal(t, "because you like ramen in Shibuya", recommendations[0].Reason)
+	assert.Equal(t, ramenElsewhere.ID(), recommendations[1].Restaurant.ID())
+	assert.Equal(t, "because you like ramen", recommendations[1].Reason)
+	assert.Equal(t, cafe.ID(), recommendations[2].Restaurant.ID())
+	assert.Equal(t, "because you often save places in Shibuya", recommendations[2].Reason)
+	for _, r := range recommendations {
+		assert.NotEqual(t, saved.ID(), r.Restaurant.ID(), "favorites are not recommended")
+	}
+}
+
+func TestRecommendationService_Recommend_NoFavorites(t *testing.T) {
+	service, restaurantRepo, favoriteRepo := newTestRecommendationService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	good := newCatalogRestaurant("Fuglen", "Shibuya", "Cafe", "$", 4.5)
+	average := newCatalogRestaurant("Shake Shack", "Gaienmae", "Burgers", "$$", 3.0)
+
+	favoriteRepo.EXPECT().FindByUserID(ctx, userID).Return([]*model.Favorite{}, nil)
+	restaurantRepo.EXPECT().FindByLocation(ctx, 35.6595, 139.7005, 1.0, DefaultRecommendationLimit*recommendationCandidateFactor).
+		Return([]*repository.NearbyRestaurant{
+			{Restaurant: average, DistanceKm: 0.2},
+		}, nil)
+	restaurantRepo.EXPECT().FindPageByFilter(ctx, repository.RestaurantFilter{
+		Near: &repository.NearPoint{Latitude: 35.6595, Longitude: 139.7005, RadiusKm: 1},
+		Sort: repository.SortByRating,
+	}, (*repository.Cursor)(nil), DefaultRecommendationLimit*recommendationCandidateFactor).
+		Return([]*repository.FilteredRestaurant{
+			{Restaurant: good, DistanceKm: distanceKm(0.3)},
+			{Restaurant: average, DistanceKm: distanceKm(0.2)},
+		}, nil, nil)
+
+	recommendations, err := service.Recommend(ctx, RecommendationRequest{
+		UserID:    userID,
+		Latitude:  35.6595,
+		Longitude: 139.7005,
+		RadiusKm:  1,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, recommendations, 2)
+	assert.Equal(t, good.ID(), recommendations[0].Restaurant.ID())
+	assert.Equal(t, "highly rated near you", recommendations[0].Reason)
+	assert.Equal(t, "near you", recommendations[1].Reason)
+}
+
+func TestRecommendationService_Recommend_InvalidRequest(t *testing.T) {
+	service, _, _ := newTestRecommendationService(t)
+
+	_, err := service.Recommend(context.Background(), RecommendationRequest{UserID: uuid.New(), Latitude: 95, Longitude: 139.7})
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidLocation)
+
+	_, err = service.Recommend(context.Background(), RecommendationRequest{UserID: uuid.New(), Latitude: 35.6, Longitude: 139.7, RadiusKm: -1})
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidRadius)
+}
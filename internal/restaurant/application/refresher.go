@@ -0,0 +1,466 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	mapv1 "github.com/Leon180/tabelogo-v2/api/gen/map/v1"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application/converters"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/pkg/metrics"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// refreshRetryBackoff is how long a restaurant that could not be refreshed
+// (e.g. the place no longer exists) is skipped, so it does not hold the top
+// of the queue on every run
+const refreshRetryBackoff = 24 * time.Hour
+
+// RefreshOptions controls a single refresh run
+type RefreshOptions struct {
+	DryRun bool
+	Limit  int // at most the configured quota; 0 uses the quota
+}
+
+// RefreshRun reports a refresh run of stale restaurants
+type RefreshRun struct {
+	ID         uuid.UUID
+	DryRun     bool
+	Trigger    string // "schedule" or "manual"
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Stale      int64    // restaurants past the TTL when the run started
+	Selected   []string // place IDs selected for refresh, in priority order
+	Refreshed  int
+	Missing    int // not returned by the Map Service
+	Failed     int
+	Error      string
+}
+
+// RefreshStatus is the state of the refresher
+type RefreshStatus struct {
+	Running   *RefreshRun
+	Last      *RefreshRun
+	Interval  time.Duration
+	BatchSize int
+	Quota     int
+	DryRun    bool
+}
+
+// Refresher periodically refreshes restaurants whose data is older than
+// DataFreshnessTTL from the Map Service, most viewed and most favorited first
+type Refresher struct {
+	restaurantRepo repository.RestaurantRepository
+	revisionRepo   repository.RevisionRepository
+	mapClient      MapServiceClient
+	config         *Config
+	logger         *zap.Logger
+
+	mu      sync.Mutex
+	running *RefreshRun
+	last    *RefreshRun
+	backoff map[uuid.UUID]time.Time // restaurant ID -> skipped until
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewRefresher creates a new stale restaurant refresher
+func NewRefresher(
+	restaurantRepo repository.RestaurantRepository,
+	revisionRepo repository.RevisionRepository,
+	mapClient MapServiceClient,
+	config *Config,
+	logger *zap.Logger,
+) *Refresher {
+	return &Refresher{
+		restaurantRepo: restaurantRepo,
+		revisionRepo:   revisionRepo,
+		mapClient:      mapClient,
+		config:         config,
+		logger:         logger.With(zap.String("component", "refresher")),
+		backoff:        make(map[uuid.UUID]time.Time),
+		stopChan:       make(chan struct{}),
+	}
+}
+
+// Start starts the scheduled refresh; it does nothing when RefreshInterval is 0
+func (r *Refresher) Start(ctx context.Context) {
+	if r.config.RefreshInterval <= 0 {
+		r.logger.Info("Scheduled refresh disabled")
+		return
+	}
+
+	r.wg.Add(1)
+	go r.scheduler(ctx)
+
+	r.logger.Info("Refresher started",
+		zap.Duration("interval", r.config.RefreshInterval),
+		zap.Int("quota", r.config.RefreshQuota),
+		zap.Bool("dry_run", r.config.RefreshDryRun),
+	)
+}
+
+// Stop stops the scheduled refresh and waits for a scheduled run in progress
+func (r *Refresher) Stop(ctx context.Context) error {
+	close(r.stopChan)
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		r.logger.Info("Refresher stopped")
+		return nil
+	case <-ctx.Done():
+		r.logger.Warn("Refresher stop timeout")
+		return fmt.Errorf("shutdown timeout")
+	}
+}
+
+func (r *Refresher) scheduler(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := r.Run(ctx, RefreshOptions{DryRun: r.config.RefreshDryRun}, "schedule")
+			if err != nil && err != domainerrors.ErrRefreshInProgress {
+				r.logger.Error("Scheduled refresh failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Run refreshes stale restaurants and returns the finished run
+func (r *Refresher) Run(ctx context.Context, opts RefreshOptions, trigger string) (*RefreshRun, error) {
+	run, err := r.begin(opts, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.execute(ctx, run, opts)
+	return r.snapshot(run), err
+}
+
+// Trigger starts a run in the background and returns it as started
+func (r *Refresher) Trigger(opts RefreshOptions) (*RefreshRun, error) {
+	run, err := r.begin(opts, "manual")
+	if err != nil {
+		return nil, err
+	}
+
+	started := r.snapshot(run)
+	go func() {
+		if err := r.execute(context.Background(), run, opts); err != nil {
+			r.logger.Error("Manual refresh failed", zap.String("run_id", run.ID.String()), zap.Error(err))
+		}
+	}()
+
+	return started, nil
+}
+
+// Status returns the run in progress, the last finished run and the settings
+func (r *Refresher) Status() *RefreshStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &RefreshStatus{
+		Running:   r.copyRun(r.running),
+		Last:      r.copyRun(r.last),
+		Interval:  r.config.RefreshInterval,
+		BatchSize: r.batchSize(),
+		Quota:     r.config.RefreshQuota,
+		DryRun:    r.config.RefreshDryRun,
+	}
+}
+
+func (r *Refresher) begin(opts RefreshOptions, trigger string) (*RefreshRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.running != nil {
+		return nil, domainerrors.ErrRefreshInProgress
+	}
+
+	r.running = &RefreshRun{
+		ID:        uuid.New(),
+		DryRun:    opts.DryRun,
+		Trigger:   trigger,
+		StartedAt: time.Now(),
+	}
+	return r.running, nil
+}
+
+func (r *Refresher) execute(ctx context.Context, run *RefreshRun, opts RefreshOptions) (err error) {
+	mode := "live"
+	if opts.DryRun {
+		mode = "dry_run"
+	}
+
+	defer func() {
+		finished := time.Now()
+
+		r.mu.Lock()
+		run.FinishedAt = &finished
+		if err != nil {
+			run.Error = err.Error()
+		}
+		r.last = run
+		r.running = nil
+		r.mu.Unlock()
+
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.RestaurantRefreshRunsTotal.WithLabelValues(mode, status).Inc()
+		metrics.RestaurantRefreshDuration.Observe(finished.Sub(run.StartedAt).Seconds())
+
+		r.logger.Info("Refresh run finished",
+			zap.String("run_id", run.ID.String()),
+			zap.String("mode", mode),
+			zap.Int64("stale", run.Stale),
+			zap.Int("selected", len(run.Selected)),
+			zap.Int("refreshed", run.Refreshed),
+			zap.Int("missing", run.Missing),
+			zap.Int("failed", run.Failed),
+			zap.Error(err),
+		)
+	}()
+
+	query := repository.StaleQuery{
+		Source:        model.SourceGoogle,
+		UpdatedBefore: run.StartedAt.Add(-r.config.DataFreshnessTTL),
+		ExcludeIDs:    r.backedOff(run.StartedAt),
+		Limit:         r.limit(opts.Limit),
+	}
+
+	stale, err := r.restaurantRepo.CountStale(ctx, query)
+	if err != nil {
+		return fmt.Errorf("count stale restaurants: %w", err)
+	}
+	metrics.RestaurantStaleCount.Set(float64(stale))
+
+	restaurants, err := r.restaurantRepo.FindStale(ctx, query)
+	if err != nil {
+		return fmt.Errorf("find stale restaurants: %w", err)
+	}
+
+	selected := make([]string, len(restaurants))
+	for i, restaurant := range restaurants {
+		selected[i] = restaurant.ExternalID()
+	}
+	r.mu.Lock()
+	run.Stale = stale
+	run.Selected = selected
+	r.mu.Unlock()
+
+	if opts.DryRun {
+		return nil
+	}
+
+	batchSize := r.batchSize()
+	for start := 0; start < len(restaurants); start += batchSize {
+		select {
+		case <-r.stopChan:
+			return fmt.Errorf("refresher stopped")
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		end := start + batchSize
+		if end > len(restaurants) {
+			end = len(restaurants)
+		}
+		if err := r.refreshBatch(ctx, run, restaurants[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refreshBatch fetches one batch from the Map Service and stores the fresh data
+func (r *Refresher) refreshBatch(ctx context.Context, run *RefreshRun, batch []*model.Restaurant) error {
+	placeIDs := make([]string, len(batch))
+	for i, restaurant := range batch {
+		placeIDs[i] = restaurant.ExternalID()
+	}
+
+	start := time.Now()
+	places, err := r.mapClient.BatchGetPlaces(ctx, placeIDs, model.DefaultLanguage)
+	if err != nil {
+		metrics.RestaurantMapServiceCallsTotal.WithLabelValues("error").Inc()
+		metrics.RestaurantRefreshedTotal.WithLabelValues("failed").Add(float64(len(batch)))
+		r.mu.Lock()
+		run.Failed += len(batch)
+		r.mu.Unlock()
+		return fmt.Errorf("batch get places: %w", err)
+	}
+	metrics.RestaurantMapServiceCallsTotal.WithLabelValues("success").Inc()
+	metrics.RestaurantSyncDuration.Observe(time.Since(start).Seconds())
+
+	byID := make(map[string]*mapv1.Place, len(places))
+	for _, place := range places {
+		if place != nil {
+			byID[place.Id] = place
+		}
+	}
+
+	localized := r.fetchLocalizedPlaces(ctx, placeIDs)
+
+	for _, restaurant := range batch {
+		result := r.refreshOne(ctx, restaurant, byID[restaurant.ExternalID()], localized)
+		metrics.RestaurantRefreshedTotal.WithLabelValues(result).Inc()
+
+		r.mu.Lock()
+		switch result {
+		case "refreshed":
+			run.Refreshed++
+		case "missing":
+			run.Missing++
+		default:
+			run.Failed++
+		}
+		if result != "refreshed" {
+			r.backoff[restaurant.ID()] = time.Now().Add(refreshRetryBackoff)
+		}
+		r.mu.Unlock()
+	}
+
+	return nil
+}
+
+// fetchLocalizedPlaces fetches a batch in every localized language, keyed by
+// language and place ID. A failed language is left out and keeps its stored
+// text.
+func (r *Refresher) fetchLocalizedPlaces(ctx context.Context, placeIDs []string) map[string]map[string]*mapv1.Place {
+	localized := make(map[string]map[string]*mapv1.Place, len(model.LocalizedLanguages))
+	for _, lang := range model.LocalizedLanguages {
+		places, err := r.mapClient.BatchGetPlaces(ctx, placeIDs, lang)
+		if err != nil {
+			metrics.RestaurantMapServiceCallsTotal.WithLabelValues("error").Inc()
+			r.logger.Warn("Failed to fetch localized places",
+				zap.String("language", lang),
+				zap.Int("count", len(placeIDs)),
+				zap.Error(err),
+			)
+			continue
+		}
+		metrics.RestaurantMapServiceCallsTotal.WithLabelValues("success").Inc()
+
+		byID := make(map[string]*mapv1.Place, len(places))
+		for _, place := range places {
+			if place != nil {
+				byID[place.Id] = place
+			}
+		}
+		localized[lang] = byID
+	}
+	return localized
+}
+
+func (r *Refresher) refreshOne(ctx context.Context, restaurant *model.Restaurant, place *mapv1.Place, localized map[string]map[string]*mapv1.Place) string {
+	fresh := converters.MapPlaceToRestaurant(place)
+	if fresh == nil {
+		return "missing"
+	}
+	for lang, places := range localized {
+		if p := places[restaurant.ExternalID()]; p != nil {
+			fresh.MergeLocalization(lang, converters.MapPlaceToLocalization(p))
+		}
+	}
+
+	before := restaurant.TrackedFields()
+	applyFreshData(restaurant, fresh)
+	if err := saveWithRevision(ctx, r.restaurantRepo, r.revisionRepo, restaurant, before, model.RevisionSourceMapRefresh, ""); err != nil {
+		r.logger.Error("Failed to update refreshed restaurant",
+			zap.String("id", restaurant.ID().String()),
+			zap.String("place_id", restaurant.ExternalID()),
+			zap.Error(err),
+		)
+		return "failed"
+	}
+
+	return "refreshed"
+}
+
+// backedOff returns the restaurants still in their retry backoff, dropping expired entries
+func (r *Refresher) backedOff(now time.Time) []uuid.UUID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]uuid.UUID, 0, len(r.backoff))
+	for id, until := range r.backoff {
+		if now.After(until) {
+			delete(r.backoff, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// placeLookups is the number of Map Service place lookups refreshing one
+// place costs: one in the default language and one per localized language
+func placeLookups() int {
+	return 1 + len(model.LocalizedLanguages)
+}
+
+// limit is the number of places a run refreshes. The quota counts place
+// lookups, so it allows quota / placeLookups places, and at least one.
+func (r *Refresher) limit(requested int) int {
+	quota := r.config.RefreshQuota
+	if quota > 0 {
+		quota = max(quota/placeLookups(), 1)
+	}
+	if requested > 0 && (quota <= 0 || requested < quota) {
+		return requested
+	}
+	return quota
+}
+
+func (r *Refresher) batchSize() int {
+	if r.config.RefreshBatchSize <= 0 {
+		return 1
+	}
+	return r.config.RefreshBatchSize
+}
+
+func (r *Refresher) snapshot(run *RefreshRun) *RefreshRun {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.copyRun(run)
+}
+
+// copyRun copies a run; the caller must hold r.mu
+func (r *Refresher) copyRun(run *RefreshRun) *RefreshRun {
+	if run == nil {
+		return nil
+	}
+
+	c := *run
+	c.Selected = append([]string(nil), run.Selected...)
+	if run.FinishedAt != nil {
+		finished := *run.FinishedAt
+		c.FinishedAt = &finished
+	}
+	return &c
+}
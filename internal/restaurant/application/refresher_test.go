@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mapv1 "github.com/Leon180/tabelogo-v2/api/gen/map/v1"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func newTestRefresher(repo *MockRestaurantRepository, revisionRepo *MockRevisionRepository, mapClient *MockMapServiceClient) *Refresher {
+	return NewRefresher(repo, revisionRepo, mapClient, &Config{
+		DataFreshnessTTL: 3 * 24 * time.Hour,
+		RefreshBatchSize: 2,
+		RefreshQuota:     30,
+	}, zap.NewNop())
+}
+
+func TestRefresher_Run_DryRun(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockMapClient := new(MockMapServiceClient)
+	refresher := newTestRefresher(mockRepo, mockRevisionRepo, mockMapClient)
+
+	ctx := context.Background()
+	a := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	b := newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7100)
+
+	mockRepo.On("CountStale", ctx, mock.MatchedBy(func(q repository.StaleQuery) bool {
+		return q.Source == model.SourceGoogle && q.Limit == 5 && time.Since(q.UpdatedBefore) >= 3*24*time.Hour
+	})).Return(int64(42), nil)
+	mockRepo.On("FindStale", ctx, mock.AnythingOfType("repository.StaleQuery")).Return([]*model.Restaurant{a, b}, nil)
+
+	run, err := refresher.Run(ctx, RefreshOptions{DryRun: true, Limit: 5}, "manual")
+
+	assert.NoError(t, err)
+	assert.True(t, run.DryRun)
+	assert.Equal(t, int64(42), run.Stale)
+	assert.Equal(t, []string{a.ExternalID(), b.ExternalID()}, run.Selected)
+	assert.Zero(t, run.Refreshed)
+	assert.NotNil(t, run.FinishedAt)
+	mockMapClient.AssertNotCalled(t, "BatchGetPlaces")
+	mockRepo.AssertNotCalled(t, "Update")
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+	assert.Equal(t, run.ID, refresher.Status().Last.ID)
+}
+
+func TestRefresher_Run_RefreshesInBatches(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockMapClient := new(MockMapServiceClient)
+	refresher := newTestRefresher(mockRepo, mockRevisionRepo, mockMapClient)
+
+	ctx := context.Background()
+	a := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	b := newTestRestaurant("Afuri", "", model.SourceGoogle, 35.6467, 139.7100)
+	gone := newTestRestaurant("Closed Shop", "", model.SourceGoogle, 35.6500, 139.7000)
+
+	mockRepo.On("CountStale", ctx, mock.AnythingOfType("repository.StaleQuery")).Return(int64(3), nil)
+	mockRepo.On("FindStale", ctx, mock.AnythingOfType("repository.StaleQuery")).Return([]*model.Restaurant{a, b, gone}, nil).Once()
+	mockMapClient.On("BatchGetPlaces", ctx, []string{a.ExternalID(), b.ExternalID()}, model.LanguageEnglish).Return([]*mapv1.Place{
+		{Id: a.ExternalID(), Name: "Ichiran Shibuya", Rating: 4.1},
+		{Id: b.ExternalID(), Name: "AFURI Ebisu", Rating: 4.3},
+	}, nil)
+	mockMapClient.On("BatchGetPlaces", ctx, []string{a.ExternalID(), b.ExternalID()}, model.LanguageJapanese).Return([]*mapv1.Place{
+		{Id: a.ExternalID(), Name: "一蘭 渋谷店", FormattedAddress: "東京都渋谷区神南1-22-7"},
+	}, nil)
+	// A failed language does not fail the refresh
+	mockMapClient.On("BatchGetPlaces", ctx, []string{a.ExternalID(), b.ExternalID()}, model.LanguageTraditionalChinese).Return(nil, errors.New("unavailable"))
+	mockMapClient.On("BatchGetPlaces", ctx, []string{gone.ExternalID()}, mock.Anything).Return([]*mapv1.Place{}, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, mock.AnythingOfType("*model.Restaurant"), mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.Source() == model.RevisionSourceMapRefresh
+	})).Return(nil)
+
+	run, err := refresher.Run(ctx, RefreshOptions{}, "schedule")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, run.Refreshed)
+	assert.Equal(t, 1, run.Missing)
+	assert.Equal(t, "Ichiran Shibuya", a.Name())
+	assert.Equal(t, 4.3, b.Rating())
+	assert.Equal(t, "一蘭 渋谷店", a.Localization(model.LanguageJapanese).Name)
+	assert.Empty(t, b.Localizations())
+	mockRevisionRepo.AssertNumberOfCalls(t, "UpdateWithRevision", 2)
+	mockMapClient.AssertExpectations(t)
+
+	// The missing place is skipped on the next run
+	mockRepo.On("FindStale", ctx, mock.MatchedBy(func(q repository.StaleQuery) bool {
+		return len(q.ExcludeIDs) == 1 && q.ExcludeIDs[0] == gone.ID()
+	})).Return([]*model.Restaurant{}, nil).Once()
+
+	run, err = refresher.Run(ctx, RefreshOptions{}, "schedule")
+
+	assert.NoError(t, err)
+	assert.Empty(t, run.Selected)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRefresher_Run_MapServiceError(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockMapClient := new(MockMapServiceClient)
+	refresher := newTestRefresher(mockRepo, mockRevisionRepo, mockMapClient)
+
+	ctx := context.Background()
+	a := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRepo.On("CountStale", ctx, mock.AnythingOfType("repository.StaleQuery")).Return(int64(1), nil)
+	mockRepo.On("FindStale", ctx, mock.AnythingOfType("repository.StaleQuery")).Return([]*model.Restaurant{a}, nil)
+	mockMapClient.On("BatchGetPlaces", ctx, []string{a.ExternalID()}, model.LanguageEnglish).Return(nil, errors.New("quota exceeded"))
+
+	run, err := refresher.Run(ctx, RefreshOptions{}, "manual")
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, run.Failed)
+	assert.Contains(t, run.Error, "quota exceeded")
+	mockRepo.AssertNotCalled(t, "Update")
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestRefresher_Limit(t *testing.T) {
+	// Each place costs a lookup in the default language and in ja and zh-TW
+	refresher := NewRefresher(nil, nil, nil, &Config{RefreshQuota: 100}, zap.NewNop())
+
+	assert.Equal(t, 33, refresher.limit(0))
+	assert.Equal(t, 20, refresher.limit(20))
+	assert.Equal(t, 33, refresher.limit(500))
+
+	refresher = NewRefresher(nil, nil, nil, &Config{RefreshQuota: 2}, zap.NewNop())
+	assert.Equal(t, 1, refresher.limit(0))
+}
@@ -0,0 +1,356 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/text/language"
+)
+
+// ReviewInput is what a user writes in a review. Language is a BCP 47 tag and
+// may be empty when unknown.
+type ReviewInput struct {
+	Score    int
+	Text     string
+	Language string
+}
+
+// ListReviewsRequest selects a page of reviews in the given order
+type ListReviewsRequest struct {
+	Sort   repository.ReviewSort
+	Limit  int
+	Offset int
+}
+
+// ReviewPage is a page of reviews with the number of all matches
+type ReviewPage struct {
+	Reviews []*model.Review
+	Total   int64
+}
+
+// ReviewActor is the user reading or changing reviews. Anonymous readers have
+// a nil UserID; admins see hidden reviews and may delete any review.
+type ReviewActor struct {
+	UserID  uuid.UUID
+	IsAdmin bool
+}
+
+// ReviewService manages our users' reviews of restaurants and the review
+// rating kept on each restaurant. Hidden reviews are only shown to their
+// author and to admins; to everyone else they do not exist.
+type ReviewService interface {
+	// CreateReview writes the user's review of a restaurant; a user reviews
+	// a restaurant at most once and edits the review afterwards
+	CreateReview(ctx context.Context, userID, restaurantID uuid.UUID, input ReviewInput) (*model.Review, error)
+	GetReview(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) (*model.Review, error)
+
+	// ListRestaurantReviews lists the visible reviews of a restaurant
+	ListRestaurantReviews(ctx context.Context, restaurantID uuid.UUID, req ListReviewsRequest) (*ReviewPage, error)
+
+	// ListUserReviews lists a user's reviews, including hidden ones when the
+	// actor is the user or an admin
+	ListUserReviews(ctx context.Context, userID uuid.UUID, req ListReviewsRequest, actor ReviewActor) (*ReviewPage, error)
+
+	// UpdateReview edits the actor's own review, keeping the previous
+	// version in its history
+	UpdateReview(ctx context.Context, reviewID uuid.UUID, input ReviewInput, actor ReviewActor) (*model.Review, error)
+
+	// DeleteReview deletes the actor's own review; admins may delete any
+	DeleteReview(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) error
+
+	// GetReviewHistory lists the previous versions of a review, newest first
+	GetReviewHistory(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) ([]*model.ReviewEdit, error)
+
+	// VoteHelpful records that the user found a visible review helpful
+	VoteHelpful(ctx context.Context, userID, reviewID uuid.UUID) (*model.Review, error)
+	RemoveHelpfulVote(ctx context.Context, userID, reviewID uuid.UUID) (*model.Review, error)
+
+	// ListReviewsForModeration lists reviews of any restaurant with the
+	// status, or all reviews when status is empty
+	ListReviewsForModeration(ctx context.Context, status model.ReviewStatus, req ListReviewsRequest) (*ReviewPage, error)
+
+	// HideReview takes a review out of listings and the restaurant's rating
+	HideReview(ctx context.Context, reviewID uuid.UUID, moderatorID, note string) (*model.Review, error)
+
+	// RestoreReview makes a hidden review visible again
+	RestoreReview(ctx context.Context, reviewID uuid.UUID, moderatorID, note string) (*model.Review, error)
+}
+
+type reviewService struct {
+	reviewRepo     repository.ReviewRepository
+	restaurantRepo repository.RestaurantRepository
+	logger         *zap.Logger
+}
+
+// NewReviewService creates a new review service
+func NewReviewService(
+	reviewRepo repository.ReviewRepository,
+	restaurantRepo repository.RestaurantRepository,
+	logger *zap.Logger,
+) ReviewService {
+	return &reviewService{
+		reviewRepo:     reviewRepo,
+		restaurantRepo: restaurantRepo,
+		logger:         logger,
+	}
+}
+
+func (s *reviewService) CreateReview(ctx context.Context, userID, restaurantID uuid.UUID, input ReviewInput) (*model.Review, error) {
+	input, err := normalizeReviewInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	review := model.NewReview(userID, restaurantID, input.Score, input.Text, input.Language)
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		if !errors.Is(err, domainerrors.ErrReviewAlreadyExists) {
+			s.logger.Error("Failed to create review", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Review created",
+		zap.String("review_id", review.ID().String()),
+		zap.String("restaurant_id", restaurantID.String()),
+		zap.String("user_id", userID.String()),
+	)
+	return review, nil
+}
+
+func (s *reviewService) GetReview(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) (*model.Review, error) {
+	return s.readableReview(ctx, reviewID, actor)
+}
+
+func (s *reviewService) ListRestaurantReviews(ctx context.Context, restaurantID uuid.UUID, req ListReviewsRequest) (*ReviewPage, error) {
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, repository.ReviewQuery{
+		RestaurantID: &restaurantID,
+		Status:       model.ReviewVisible,
+	}, req)
+}
+
+func (s *reviewService) ListUserReviews(ctx context.Context, userID uuid.UUID, req ListReviewsRequest, actor ReviewActor) (*ReviewPage, error) {
+	query := repository.ReviewQuery{UserID: &userID}
+	if actor.UserID != userID && !actor.IsAdmin {
+		query.Status = model.ReviewVisible
+	}
+
+	return s.list(ctx, query, req)
+}
+
+func (s *reviewService) UpdateReview(ctx context.Context, reviewID uuid.UUID, input ReviewInput, actor ReviewActor) (*model.Review, error) {
+	input, err := normalizeReviewInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID() != actor.UserID {
+		return nil, domainerrors.ErrReviewNotFound
+	}
+
+	edit := review.Edit(input.Score, input.Text, input.Language)
+	if edit == nil {
+		return review, nil
+	}
+
+	if err := s.reviewRepo.Update(ctx, review, edit); err != nil {
+		s.logger.Error("Failed to update review", zap.Error(err))
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *reviewService) DeleteReview(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) error {
+	review, err := s.readableReview(ctx, reviewID, actor)
+	if err != nil {
+		return err
+	}
+	if review.UserID() != actor.UserID && !actor.IsAdmin {
+		return domainerrors.ErrReviewNotFound
+	}
+
+	if err := s.reviewRepo.Delete(ctx, reviewID); err != nil {
+		s.logger.Error("Failed to delete review", zap.Error(err))
+		return err
+	}
+
+	s.logger.Info("Review deleted",
+		zap.String("review_id", reviewID.String()),
+		zap.String("deleted_by", actor.UserID.String()),
+	)
+	return nil
+}
+
+func (s *reviewService) GetReviewHistory(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) ([]*model.ReviewEdit, error) {
+	if _, err := s.readableReview(ctx, reviewID, actor); err != nil {
+		return nil, err
+	}
+
+	edits, err := s.reviewRepo.ListEdits(ctx, reviewID)
+	if err != nil {
+		s.logger.Error("Failed to list review edits", zap.Error(err))
+		return nil, err
+	}
+
+	return edits, nil
+}
+
+func (s *reviewService) VoteHelpful(ctx context.Context, userID, reviewID uuid.UUID) (*model.Review, error) {
+	review, err := s.readableReview(ctx, reviewID, ReviewActor{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID() == userID {
+		return nil, domainerrors.ErrCannotVoteOwnReview
+	}
+
+	if err := s.reviewRepo.AddHelpfulVote(ctx, reviewID, userID); err != nil {
+		s.logger.Error("Failed to add helpful vote", zap.Error(err))
+		return nil, err
+	}
+
+	return s.reviewRepo.FindByID(ctx, reviewID)
+}
+
+func (s *reviewService) RemoveHelpfulVote(ctx context.Context, userID, reviewID uuid.UUID) (*model.Review, error) {
+	if _, err := s.readableReview(ctx, reviewID, ReviewActor{UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.RemoveHelpfulVote(ctx, reviewID, userID); err != nil {
+		if !errors.Is(err, domainerrors.ErrVoteNotFound) {
+			s.logger.Error("Failed to remove helpful vote", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return s.reviewRepo.FindByID(ctx, reviewID)
+}
+
+func (s *reviewService) ListReviewsForModeration(ctx context.Context, status model.ReviewStatus, req ListReviewsRequest) (*ReviewPage, error) {
+	if status != "" && !status.IsValid() {
+		return nil, domainerrors.ErrInvalidReviewStatus
+	}
+
+	return s.list(ctx, repository.ReviewQuery{Status: status}, req)
+}
+
+func (s *reviewService) HideReview(ctx context.Context, reviewID uuid.UUID, moderatorID, note string) (*model.Review, error) {
+	return s.moderate(ctx, reviewID, model.ReviewHidden, moderatorID, note)
+}
+
+func (s *reviewService) RestoreReview(ctx context.Context, reviewID uuid.UUID, moderatorID, note string) (*model.Review, error) {
+	return s.moderate(ctx, reviewID, model.ReviewVisible, moderatorID, note)
+}
+
+// moderate moves a review to the status; a review already in it is returned
+// unchanged
+func (s *reviewService) moderate(ctx context.Context, reviewID uuid.UUID, status model.ReviewStatus, moderatorID, note string) (*model.Review, error) {
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status() == status {
+		return review, nil
+	}
+
+	if status == model.ReviewHidden {
+		review.Hide(moderatorID, strings.TrimSpace(note))
+	} else {
+		review.Restore(moderatorID, strings.TrimSpace(note))
+	}
+
+	if err := s.reviewRepo.Update(ctx, review, nil); err != nil {
+		s.logger.Error("Failed to moderate review", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Review moderated",
+		zap.String("review_id", reviewID.String()),
+		zap.String("status", string(status)),
+		zap.String("moderator_id", moderatorID),
+	)
+	return review, nil
+}
+
+func (s *reviewService) list(ctx context.Context, query repository.ReviewQuery, req ListReviewsRequest) (*ReviewPage, error) {
+	if req.Sort == "" {
+		req.Sort = repository.SortReviewsNewest
+	}
+	if !req.Sort.IsValid() {
+		return nil, domainerrors.ErrInvalidReviewSort
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	query.Sort = req.Sort
+	query.Limit = req.Limit
+	query.Offset = req.Offset
+
+	reviews, total, err := s.reviewRepo.List(ctx, query)
+	if err != nil {
+		s.logger.Error("Failed to list reviews", zap.Error(err))
+		return nil, err
+	}
+
+	return &ReviewPage{Reviews: reviews, Total: total}, nil
+}
+
+// readableReview finds a review the actor may read. Hidden reviews are
+// reported as not found to anyone but their author and admins.
+func (s *reviewService) readableReview(ctx context.Context, reviewID uuid.UUID, actor ReviewActor) (*model.Review, error) {
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !review.IsVisible() && review.UserID() != actor.UserID && !actor.IsAdmin {
+		return nil, domainerrors.ErrReviewNotFound
+	}
+	return review, nil
+}
+
+// normalizeReviewInput checks the input and returns it with the text trimmed
+// and the language in canonical form, such as "en-US" for "en_us"
+func normalizeReviewInput(input ReviewInput) (ReviewInput, error) {
+	if input.Score < model.MinReviewScore || input.Score > model.MaxReviewScore {
+		return input, domainerrors.ErrInvalidReviewScore
+	}
+
+	input.Text = strings.TrimSpace(input.Text)
+	if utf8.RuneCountInString(input.Text) > model.MaxReviewTextLength {
+		return input, domainerrors.ErrReviewTooLong
+	}
+
+	input.Language = strings.ReplaceAll(strings.TrimSpace(input.Language), "_", "-")
+	if input.Language != "" {
+		tag, err := language.Parse(input.Language)
+		if err != nil {
+			return input, domainerrors.ErrInvalidReviewLanguage
+		}
+		input.Language = tag.String()
+	}
+	return input, nil
+}
@@ -0,0 +1,298 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestReviewService(t *testing.T) (ReviewService, *mocks.MockReviewRepository, *mocks.MockRestaurantRepository) {
+	ctrl := gomock.NewController(t)
+	reviewRepo := mocks.NewMockReviewRepository(ctrl)
+	restaurantRepo := mocks.NewMockRestaurantRepository(ctrl)
+	service := NewReviewService(reviewRepo, restaurantRepo, zap.NewNop())
+	return service, reviewRepo, restaurantRepo
+}
+
+// Test CreateReview
+func TestReviewService_CreateReview(t *testing.T) {
+	service, reviewRepo, restaurantRepo := newTestReviewService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurant := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+
+	restaurantRepo.EXPECT().FindByID(ctx, restaurant.ID()).Return(restaurant, nil)
+	reviewRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	review, err := service.CreateReview(ctx, userID, restaurant.ID(), ReviewInput{
+		Score:    5,
+		Text:     " Best tonkotsu in town ",
+		Language: "en_us",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, review.Score())
+	assert.Equal(t, "Best tonkotsu in town", review.Text())
+	assert.Equal(t, "en-US", review.Language())
+}
+
+func TestReviewService_CreateReview_AlreadyReviewed(t *testing.T) {
+	service, reviewRepo, restaurantRepo := newTestReviewService(t)
+	ctx := context.Background()
+	restaurant := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+
+	restaurantRepo.EXPECT().FindByID(ctx, restaurant.ID()).Return(restaurant, nil)
+	reviewRepo.EXPECT().Create(ctx, gomock.Any()).Return(domainerrors.ErrReviewAlreadyExists)
+
+	_, err := service.CreateReview(ctx, uuid.New(), restaurant.ID(), ReviewInput{Score: 4})
+	assert.ErrorIs(t, err, domainerrors.ErrReviewAlreadyExists)
+}
+
+func TestReviewService_CreateReview_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input ReviewInput
+		want  error
+	}{
+		{"score too low", ReviewInput{Score: 0}, domainerrors.ErrInvalidReviewScore},
+		{"score too high", ReviewInput{Score: 6}, domainerrors.ErrInvalidReviewScore},
+		{"text too long", ReviewInput{Score: 3, Text: strings.Repeat("う", model.MaxReviewTextLength+1)}, domainerrors.ErrReviewTooLong},
+		{"bad language", ReviewInput{Score: 3, Language: "not a language"}, domainerrors.ErrInvalidReviewLanguage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _ := newTestReviewService(t)
+
+			_, err := service.CreateReview(context.Background(), uuid.New(), uuid.New(), tt.input)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+// Test GetReview
+func TestReviewService_GetReview_HiddenReview(t *testing.T) {
+	authorID := uuid.New()
+	review := model.NewReview(authorID, uuid.New(), 1, "spam", "en")
+	review.Hide("admin-1", "advertising")
+
+	tests := []struct {
+		name    string
+		actor   ReviewActor
+		wantErr error
+	}{
+		{"anonymous", ReviewActor{}, domainerrors.ErrReviewNotFound},
+		{"other user", ReviewActor{UserID: uuid.New()}, domainerrors.ErrReviewNotFound},
+		{"author", ReviewActor{UserID: authorID}, nil},
+		{"admin", ReviewActor{UserID: uuid.New(), IsAdmin: true}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, reviewRepo, _ := newTestReviewService(t)
+			ctx := context.Background()
+
+			reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+
+			_, err := service.GetReview(ctx, review.ID(), tt.actor)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// Test ListRestaurantReviews
+func TestReviewService_ListRestaurantReviews(t *testing.T) {
+	service, reviewRepo, restaurantRepo := newTestReviewService(t)
+	ctx := context.Background()
+	restaurant := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+	restaurantID := restaurant.ID()
+
+	restaurantRepo.EXPECT().FindByID(ctx, restaurantID).Return(restaurant, nil)
+	reviewRepo.EXPECT().List(ctx, repository.ReviewQuery{
+		RestaurantID: &restaurantID,
+		Status:       model.ReviewVisible,
+		Sort:         repository.SortReviewsHelpful,
+		Limit:        20,
+	}).Return([]*model.Review{}, int64(0), nil)
+
+	page, err := service.ListRestaurantReviews(ctx, restaurantID, ListReviewsRequest{Sort: repository.SortReviewsHelpful})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Reviews)
+}
+
+func TestReviewService_ListRestaurantReviews_InvalidSort(t *testing.T) {
+	service, _, restaurantRepo := newTestReviewService(t)
+	ctx := context.Background()
+	restaurant := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+
+	restaurantRepo.EXPECT().FindByID(ctx, restaurant.ID()).Return(restaurant, nil)
+
+	_, err := service.ListRestaurantReviews(ctx, restaurant.ID(), ListReviewsRequest{Sort: "random"})
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidReviewSort)
+}
+
+// Test ListUserReviews
+func TestReviewService_ListUserReviews_OtherUserSeesVisibleOnly(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	reviewRepo.EXPECT().List(ctx, repository.ReviewQuery{
+		UserID: &userID,
+		Status: model.ReviewVisible,
+		Sort:   repository.SortReviewsNewest,
+		Limit:  20,
+	}).Return([]*model.Review{}, int64(0), nil)
+
+	_, err := service.ListUserReviews(ctx, userID, ListReviewsRequest{}, ReviewActor{UserID: uuid.New()})
+	require.NoError(t, err)
+}
+
+// Test UpdateReview
+func TestReviewService_UpdateReview_KeepsHistory(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	authorID := uuid.New()
+	review := model.NewReview(authorID, uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+	reviewRepo.EXPECT().Update(ctx, review, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *model.Review, edit *model.ReviewEdit) error {
+			require.NotNil(t, edit)
+			assert.Equal(t, 4, edit.Score)
+			assert.Equal(t, "Rich broth", edit.Text)
+			return nil
+		})
+
+	updated, err := service.UpdateReview(ctx, review.ID(), ReviewInput{Score: 2, Text: "Gone downhill", Language: "en"}, ReviewActor{UserID: authorID})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Score())
+	assert.True(t, updated.IsEdited())
+}
+
+func TestReviewService_UpdateReview_Unchanged(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	authorID := uuid.New()
+	review := model.NewReview(authorID, uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+
+	updated, err := service.UpdateReview(ctx, review.ID(), ReviewInput{Score: 4, Text: "Rich broth", Language: "en"}, ReviewActor{UserID: authorID})
+
+	require.NoError(t, err)
+	assert.False(t, updated.IsEdited())
+}
+
+func TestReviewService_UpdateReview_NotAuthor(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	review := model.NewReview(uuid.New(), uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+
+	_, err := service.UpdateReview(ctx, review.ID(), ReviewInput{Score: 1}, ReviewActor{UserID: uuid.New(), IsAdmin: true})
+	assert.ErrorIs(t, err, domainerrors.ErrReviewNotFound)
+}
+
+// Test DeleteReview
+func TestReviewService_DeleteReview_Admin(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	review := model.NewReview(uuid.New(), uuid.New(), 1, "spam", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+	reviewRepo.EXPECT().Delete(ctx, review.ID()).Return(nil)
+
+	err := service.DeleteReview(ctx, review.ID(), ReviewActor{UserID: uuid.New(), IsAdmin: true})
+	assert.NoError(t, err)
+}
+
+func TestReviewService_DeleteReview_OtherUser(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	review := model.NewReview(uuid.New(), uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+
+	err := service.DeleteReview(ctx, review.ID(), ReviewActor{UserID: uuid.New()})
+	assert.ErrorIs(t, err, domainerrors.ErrReviewNotFound)
+}
+
+// Test VoteHelpful
+func TestReviewService_VoteHelpful(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	voterID := uuid.New()
+	review := model.NewReview(uuid.New(), uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil).Times(2)
+	reviewRepo.EXPECT().AddHelpfulVote(ctx, review.ID(), voterID).Return(nil)
+
+	_, err := service.VoteHelpful(ctx, voterID, review.ID())
+	assert.NoError(t, err)
+}
+
+func TestReviewService_VoteHelpful_OwnReview(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	authorID := uuid.New()
+	review := model.NewReview(authorID, uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+
+	_, err := service.VoteHelpful(ctx, authorID, review.ID())
+	assert.ErrorIs(t, err, domainerrors.ErrCannotVoteOwnReview)
+}
+
+// Test HideReview
+func TestReviewService_HideReview(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	review := model.NewReview(uuid.New(), uuid.New(), 1, "spam", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+	reviewRepo.EXPECT().Update(ctx, review, nil).Return(nil)
+
+	hidden, err := service.HideReview(ctx, review.ID(), "admin-1", " advertising ")
+
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewHidden, hidden.Status())
+	assert.Equal(t, "advertising", hidden.ModerationNote())
+}
+
+func TestReviewService_RestoreReview_AlreadyVisible(t *testing.T) {
+	service, reviewRepo, _ := newTestReviewService(t)
+	ctx := context.Background()
+	review := model.NewReview(uuid.New(), uuid.New(), 4, "Rich broth", "en")
+
+	reviewRepo.EXPECT().FindByID(ctx, review.ID()).Return(review, nil)
+
+	restored, err := service.RestoreReview(ctx, review.ID(), "admin-1", "")
+
+	require.NoError(t, err)
+	assert.Nil(t, restored.ModeratedAt())
+}
+
+func TestReviewService_ListReviewsForModeration_InvalidStatus(t *testing.T) {
+	service, _, _ := newTestReviewService(t)
+
+	_, err := service.ListReviewsForModeration(context.Background(), "deleted", ListReviewsRequest{})
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidReviewStatus)
+}
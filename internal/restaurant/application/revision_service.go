@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RevisionService reads the change history of restaurants and reverts changes
+type RevisionService interface {
+	// ListRevisions lists the revisions of a restaurant, newest first
+	ListRevisions(ctx context.Context, restaurantID uuid.UUID, limit, offset int) ([]*model.RestaurantRevision, int64, error)
+
+	// RevertToRevision restores the fields changed after the given revision to
+	// their value at that revision. The revert is recorded as a new revision.
+	RevertToRevision(ctx context.Context, restaurantID, revisionID uuid.UUID, actorID string) (*model.Restaurant, error)
+}
+
+type revisionService struct {
+	restaurantRepo repository.RestaurantRepository
+	revisionRepo   repository.RevisionRepository
+	logger         *zap.Logger
+}
+
+// NewRevisionService creates a new revision service
+func NewRevisionService(
+	restaurantRepo repository.RestaurantRepository,
+	revisionRepo repository.RevisionRepository,
+	logger *zap.Logger,
+) RevisionService {
+	return &revisionService{
+		restaurantRepo: restaurantRepo,
+		revisionRepo:   revisionRepo,
+		logger:         logger,
+	}
+}
+
+func (s *revisionService) ListRevisions(ctx context.Context, restaurantID uuid.UUID, limit, offset int) ([]*model.RestaurantRevision, int64, error) {
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.revisionRepo.ListByRestaurant(ctx, restaurantID, limit, offset)
+}
+
+func (s *revisionService) RevertToRevision(ctx context.Context, restaurantID, revisionID uuid.UUID, actorID string) (*model.Restaurant, error) {
+	revision, err := s.revisionRepo.FindByID(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if revision.RestaurantID() != restaurantID {
+		return nil, domainerrors.ErrRevisionNotFound
+	}
+
+	restaurant, err := s.restaurantRepo.FindByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	newer, err := s.revisionRepo.ListNewerThan(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	// The value of a field at the target revision is the value before its
+	// first change after it; fields not changed since keep their current value
+	restored := make(map[string]bool)
+	before := restaurant.TrackedFields()
+	for _, rev := range newer {
+		for _, change := range rev.Changes() {
+			if restored[change.Field] {
+				continue
+			}
+			restored[change.Field] = true
+
+			if err := restaurant.RestoreField(change.Field, change.Before); err != nil {
+				if errors.Is(err, model.ErrFieldNotRestorable) {
+					continue
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, model.RevisionSourceRevert, actorID); err != nil {
+		s.logger.Error("Failed to revert restaurant",
+			zap.String("id", restaurantID.String()),
+			zap.String("revision_id", revisionID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("Restaurant reverted",
+		zap.String("id", restaurantID.String()),
+		zap.String("revision_id", revisionID.String()),
+		zap.String("actor_id", actorID),
+	)
+
+	return restaurant, nil
+}
+
+// saveWithRevision updates a restaurant and records the tracked fields that
+// changed since before as a revision. Without changes it is a plain update.
+func saveWithRevision(
+	ctx context.Context,
+	restaurantRepo repository.RestaurantRepository,
+	revisionRepo repository.RevisionRepository,
+	restaurant *model.Restaurant,
+	before map[string]string,
+	source model.RevisionSource,
+	actorID string,
+) error {
+	changes := model.DiffFields(before, restaurant.TrackedFields())
+	if len(changes) == 0 {
+		return restaurantRepo.Update(ctx, restaurant)
+	}
+
+	revision := model.NewRestaurantRevision(restaurant.ID(), source, actorID, changes)
+	return revisionRepo.UpdateWithRevision(ctx, restaurant, revision)
+}
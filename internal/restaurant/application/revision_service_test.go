@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockRevisionRepository is a mock implementation of RevisionRepository
+type MockRevisionRepository struct {
+	mock.Mock
+}
+
+func (m *MockRevisionRepository) UpdateWithRevision(ctx context.Context, restaurant *model.Restaurant, revision *model.RestaurantRevision) error {
+	args := m.Called(ctx, restaurant, revision)
+	return args.Error(0)
+}
+
+func (m *MockRevisionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantRevision, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RestaurantRevision), args.Error(1)
+}
+
+func (m *MockRevisionRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, limit, offset int) ([]*model.RestaurantRevision, int64, error) {
+	args := m.Called(ctx, restaurantID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*model.RestaurantRevision), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRevisionRepository) ListNewerThan(ctx context.Context, revision *model.RestaurantRevision) ([]*model.RestaurantRevision, error) {
+	args := m.Called(ctx, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.RestaurantRevision), args.Error(1)
+}
+
+// Test ListRevisions
+func TestRevisionService_ListRevisions(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	service := NewRevisionService(mockRepo, mockRevisionRepo, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	revisions := []*model.RestaurantRevision{
+		model.NewRestaurantRevision(restaurant.ID(), model.RevisionSourceUserEdit, "user-1", []model.FieldChange{
+			{Field: model.FieldName, Before: "Ichiran", After: "Ichiran Shibuya"},
+		}),
+	}
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockRevisionRepo.On("ListByRestaurant", ctx, restaurant.ID(), 20, 0).Return(revisions, int64(1), nil)
+
+	result, total, err := service.ListRevisions(ctx, restaurant.ID(), 20, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, revisions, result)
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestRevisionService_ListRevisions_RestaurantNotFound(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	service := NewRevisionService(mockRepo, mockRevisionRepo, zap.NewNop())
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	mockRepo.On("FindByID", ctx, id).Return(nil, domainerrors.ErrRestaurantNotFound)
+
+	result, _, err := service.ListRevisions(ctx, id, 20, 0)
+
+	assert.ErrorIs(t, err, domainerrors.ErrRestaurantNotFound)
+	assert.Nil(t, result)
+	mockRevisionRepo.AssertNotCalled(t, "ListByRestaurant")
+}
+
+// Test RevertToRevision
+func TestRevisionService_RevertToRevision(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	service := NewRevisionService(mockRepo, mockRevisionRepo, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Ebisu", "03-0000-0000", model.SourceGoogle, 35.6595, 139.7005)
+
+	target := model.NewRestaurantRevision(restaurant.ID(), model.RevisionSourceUserEdit, "user-1", []model.FieldChange{
+		{Field: model.FieldName, Before: "Ichiran", After: "Ichiran Shibuya"},
+	})
+	newer := []*model.RestaurantRevision{
+		model.NewRestaurantRevision(restaurant.ID(), model.RevisionSourceUserEdit, "user-2", []model.FieldChange{
+			{Field: model.FieldName, Before: "Ichiran Shibuya", After: "Ichiran Harajuku"},
+			{Field: model.FieldPhone, Before: "03-3463-3667", After: "03-0000-0000"},
+		}),
+		model.NewRestaurantRevision(restaurant.ID(), model.RevisionSourceUserEdit, "user-3", []model.FieldChange{
+			{Field: model.FieldName, Before: "Ichiran Harajuku", After: "Ichiran Ebisu"},
+			{Field: model.FieldTabelogURL, Before: "", After: "https://tabelog.com/tokyo/A1303/A130301/13001234/"},
+		}),
+	}
+
+	mockRevisionRepo.On("FindByID", ctx, target.ID()).Return(target, nil)
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockRevisionRepo.On("ListNewerThan", ctx, target).Return(newer, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.Source() == model.RevisionSourceRevert && r.ActorID() == "admin-1" && len(r.Changes()) == 2
+	})).Return(nil)
+
+	result, err := service.RevertToRevision(ctx, restaurant.ID(), target.ID(), "admin-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ichiran Shibuya", result.Name())
+	assert.Equal(t, "03-3463-3667", result.Phone())
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestRevisionService_RevertToRevision_OtherRestaurant(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	service := NewRevisionService(mockRepo, mockRevisionRepo, zap.NewNop())
+
+	ctx := context.Background()
+	revision := model.NewRestaurantRevision(uuid.New(), model.RevisionSourceUserEdit, "user-1", nil)
+
+	mockRevisionRepo.On("FindByID", ctx, revision.ID()).Return(revision, nil)
+
+	result, err := service.RevertToRevision(ctx, uuid.New(), revision.ID(), "admin-1")
+
+	assert.ErrorIs(t, err, domainerrors.ErrRevisionNotFound)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "FindByID")
+}
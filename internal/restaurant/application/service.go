@@ -22,11 +22,18 @@ type RestaurantService interface {
 	GetRestaurantByExternalID(ctx context.Context, source model.RestaurantSource, externalID string) (*model.Restaurant, error)
 	UpdateRestaurant(ctx context.Context, id uuid.UUID, req UpdateRestaurantRequest) (*model.Restaurant, error)
 	DeleteRestaurant(ctx context.Context, id uuid.UUID) error
-	SearchRestaurants(ctx context.Context, query string, limit, offset int) ([]*model.Restaurant, error)
+	SearchRestaurants(ctx context.Context, query string, limit, offset int) ([]*repository.RestaurantSearchHit, int64, error)
+	SearchRestaurantsAfter(ctx context.Context, query string, after *repository.Cursor, limit int) ([]*repository.RestaurantSearchHit, int64, *repository.Cursor, error)
 	ListRestaurants(ctx context.Context, limit, offset int) ([]*model.Restaurant, error)
-	FindRestaurantsByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*model.Restaurant, error)
+	ListRestaurantsAfter(ctx context.Context, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error)
+	FilterRestaurants(ctx context.Context, filter repository.RestaurantFilter, limit, offset int) ([]*repository.FilteredRestaurant, int64, error)
+	FilterRestaurantsAfter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, int64, *repository.Cursor, error)
+	FindRestaurantsByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*repository.NearbyRestaurant, error)
 	FindRestaurantsByCuisineType(ctx context.Context, cuisineType string, limit, offset int) ([]*model.Restaurant, error)
+	FindRestaurantsByCuisineTypeAfter(ctx context.Context, cuisineType string, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error)
 	IncrementRestaurantViewCount(ctx context.Context, id uuid.UUID) error
+	SetRestaurantLocalization(ctx context.Context, id uuid.UUID, lang string, content model.LocalizedContent) (*model.Restaurant, error)
+	SetRestaurantCuisines(ctx context.Context, id uuid.UUID, cuisines []string, actorID string) (*model.Restaurant, error)
 
 	// Map Service integration - Quick search by Google Place ID
 	QuickSearchByPlaceID(ctx context.Context, placeID string) (*model.Restaurant, error)
@@ -35,17 +42,20 @@ type RestaurantService interface {
 	AddToFavorites(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error)
 	RemoveFromFavorites(ctx context.Context, userID, restaurantID uuid.UUID) error
 	GetUserFavorites(ctx context.Context, userID uuid.UUID) ([]*model.Favorite, error)
+	GetUserFavoritesAfter(ctx context.Context, userID uuid.UUID, after *repository.Cursor, limit int) ([]*model.Favorite, *repository.Cursor, error)
+	GetUserFavoritesByTag(ctx context.Context, userID uuid.UUID, tag string) ([]*model.Favorite, error)
 	GetFavoriteByUserAndRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error)
 	UpdateFavoriteNotes(ctx context.Context, userID, restaurantID uuid.UUID, notes string) (*model.Favorite, error)
 	AddFavoriteTag(ctx context.Context, userID, restaurantID uuid.UUID, tag string) (*model.Favorite, error)
 	RemoveFavoriteTag(ctx context.Context, userID, restaurantID uuid.UUID, tag string) (*model.Favorite, error)
-	AddFavoriteVisit(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error)
 	IsFavorite(ctx context.Context, userID, restaurantID uuid.UUID) (bool, error)
 }
 
 type restaurantService struct {
 	restaurantRepo repository.RestaurantRepository
 	favoriteRepo   repository.FavoriteRepository
+	revisionRepo   repository.RevisionRepository
+	popularity     repository.PopularityCounter
 	mapClient      MapServiceClient // Map Service gRPC client
 	config         *Config          // Configuration for TTL, etc.
 	logger         *zap.Logger
@@ -55,12 +65,53 @@ type restaurantService struct {
 // Config holds service configuration
 type Config struct {
 	DataFreshnessTTL time.Duration
+
+	// TabelogAutoAttachScore is the match score above which scraped Tabelog
+	// listings are attached automatically, DefaultTabelogAutoAttachScore when 0
+	TabelogAutoAttachScore float64
+
+	// Background refresh of stale restaurants, see Refresher
+	RefreshInterval  time.Duration // 0 disables the scheduled refresh
+	RefreshBatchSize int           // places per Map Service BatchGetPlaces call
+	RefreshQuota     int           // max place lookups per run, one per place and language
+	RefreshDryRun    bool          // scheduled runs only report what they would refresh
+
+	// TrustedEditorApprovals is the number of approved edit suggestions after
+	// which a user's edits are applied directly and the user can moderate;
+	// 0 leaves moderation to admins
+	TrustedEditorApprovals int
+
+	// Photo storage, see PhotoService
+	PhotoSigningKey     string        // HMAC key of photo proxy URLs
+	PhotoURLTTL         time.Duration // minimum validity of a signed photo URL
+	PhotoMaxWidth       int           // width Google photos are downloaded at
+	PhotoThumbnailWidth int           // max width of thumbnails
+	PhotoMaxPerSource   int           // max photos ingested per source and restaurant
+
+	// Popularity counters and trending, see PopularityService
+	PopularityRollupInterval time.Duration // 0 disables the scheduled rollup
+	TrendingHalfLife         time.Duration // age at which events count for half
+
+	// Domain event outbox, see OutboxRelay
+	EventRelayInterval  time.Duration // 0 disables the relay; events stay in the outbox
+	EventRelayBatchSize int           // events published at once
+	EventMaxAttempts    int           // failures after which an event is dead-lettered, 0 retries forever
+	OutboxRetention     time.Duration // age after which published events are deleted, 0 keeps them
+
+	// Deleted restaurants, see DeletedRestaurantService
+	DeletedRetention time.Duration // age after which deleted restaurants are purged, 0 keeps them
+	PurgeInterval    time.Duration // 0 disables the scheduled purge
+
+	// CurrencyRates are the units of each currency per yen, see CurrencyConverter
+	CurrencyRates map[string]float64
 }
 
 // NewRestaurantService creates a new restaurant service
 func NewRestaurantService(
 	restaurantRepo repository.RestaurantRepository,
 	favoriteRepo repository.FavoriteRepository,
+	revisionRepo repository.RevisionRepository,
+	popularity repository.PopularityCounter,
 	mapClient MapServiceClient,
 	config *Config,
 	logger *zap.Logger,
@@ -68,6 +119,8 @@ func NewRestaurantService(
 	return &restaurantService{
 		restaurantRepo: restaurantRepo,
 		favoriteRepo:   favoriteRepo,
+		revisionRepo:   revisionRepo,
+		popularity:     popularity,
 		mapClient:      mapClient,
 		config:         config,
 		logger:         logger,
@@ -161,6 +214,7 @@ func (s *restaurantService) UpdateRestaurant(ctx context.Context, id uuid.UUID,
 		s.logger.Error("Failed to find restaurant for update", zap.String("id", id.String()), zap.Error(err))
 		return nil, err
 	}
+	before := restaurant.TrackedFields()
 
 	// Update area if provided
 	if req.Area != "" {
@@ -226,7 +280,7 @@ func (s *restaurantService) UpdateRestaurant(ctx context.Context, id uuid.UUID,
 	}
 
 	// Save updated restaurant
-	if err := s.restaurantRepo.Update(ctx, restaurant); err != nil {
+	if err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, model.RevisionSourceUserEdit, req.ActorID); err != nil {
 		s.logger.Error("Failed to update restaurant", zap.String("id", id.String()), zap.Error(err))
 		return nil, err
 	}
@@ -245,14 +299,27 @@ func (s *restaurantService) DeleteRestaurant(ctx context.Context, id uuid.UUID)
 	return nil
 }
 
-func (s *restaurantService) SearchRestaurants(ctx context.Context, query string, limit, offset int) ([]*model.Restaurant, error) {
-	restaurants, err := s.restaurantRepo.Search(ctx, query, limit, offset)
+// SearchRestaurants returns one page of ranked search hits and the total number of matches
+func (s *restaurantService) SearchRestaurants(ctx context.Context, query string, limit, offset int) ([]*repository.RestaurantSearchHit, int64, error) {
+	hits, total, err := s.restaurantRepo.Search(ctx, query, limit, offset)
 	if err != nil {
 		s.logger.Error("Failed to search restaurants", zap.String("query", query), zap.Error(err))
-		return nil, err
+		return nil, 0, err
 	}
 
-	return restaurants, nil
+	return hits, total, nil
+}
+
+// SearchRestaurantsAfter returns the page of ranked search hits after the cursor,
+// the total number of matches and the cursor of the next page
+func (s *restaurantService) SearchRestaurantsAfter(ctx context.Context, query string, after *repository.Cursor, limit int) ([]*repository.RestaurantSearchHit, int64, *repository.Cursor, error) {
+	hits, total, next, err := s.restaurantRepo.SearchAfter(ctx, query, after, limit)
+	if err != nil {
+		s.logger.Error("Failed to search restaurants", zap.String("query", query), zap.Error(err))
+		return nil, 0, nil, err
+	}
+
+	return hits, total, next, nil
 }
 
 func (s *restaurantService) ListRestaurants(ctx context.Context, limit, offset int) ([]*model.Restaurant, error) {
@@ -265,7 +332,111 @@ func (s *restaurantService) ListRestaurants(ctx context.Context, limit, offset i
 	return restaurants, nil
 }
 
-func (s *restaurantService) FindRestaurantsByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*model.Restaurant, error) {
+func (s *restaurantService) ListRestaurantsAfter(ctx context.Context, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	restaurants, next, err := s.restaurantRepo.ListAfter(ctx, after, limit)
+	if err != nil {
+		s.logger.Error("Failed to list restaurants", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return restaurants, next, nil
+}
+
+// FilterRestaurants validates the filter, picks a default sort when none is
+// given and returns one page of matches with the total number of matches
+func (s *restaurantService) FilterRestaurants(ctx context.Context, filter repository.RestaurantFilter, limit, offset int) ([]*repository.FilteredRestaurant, int64, error) {
+	if err := validateRestaurantFilter(&filter); err != nil {
+		return nil, 0, err
+	}
+
+	results, total, err := s.restaurantRepo.FindByFilter(ctx, filter, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to filter restaurants", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
+
+// FilterRestaurantsAfter is the keyset-paginated form of FilterRestaurants
+func (s *restaurantService) FilterRestaurantsAfter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, int64, *repository.Cursor, error) {
+	if err := validateRestaurantFilter(&filter); err != nil {
+		return nil, 0, nil, err
+	}
+
+	results, total, next, err := s.restaurantRepo.FindByFilterAfter(ctx, filter, after, limit)
+	if err != nil {
+		s.logger.Error("Failed to filter restaurants", zap.Error(err))
+		return nil, 0, nil, err
+	}
+
+	return results, total, next, nil
+}
+
+// validateRestaurantFilter checks the filter criteria and fills in the default
+// sort: relevance for text queries, then distance for point queries, then rating
+func validateRestaurantFilter(filter *repository.RestaurantFilter) error {
+	if filter.Near != nil {
+		if _, err := model.NewLocation(filter.Near.Latitude, filter.Near.Longitude); err != nil {
+			return domainerrors.ErrInvalidLocation
+		}
+		if filter.Near.RadiusKm <= 0 {
+			return domainerrors.ErrInvalidRadius
+		}
+	}
+	if filter.MinRating < 0 || filter.MinRating > 5 {
+		return domainerrors.ErrInvalidRating
+	}
+	if filter.MinPriceLevel < 0 || filter.MinPriceLevel > 4 ||
+		filter.MaxPriceLevel < 0 || filter.MaxPriceLevel > 4 ||
+		(filter.MaxPriceLevel > 0 && filter.MinPriceLevel > filter.MaxPriceLevel) {
+		return domainerrors.ErrInvalidPriceLevel
+	}
+	if _, ok := model.Cuisines.Category(filter.Cuisine); filter.Cuisine != "" && !ok {
+		return domainerrors.ErrUnknownCuisine
+	}
+	if budget := filter.Budget; budget != nil {
+		if budget.Min < 0 || budget.Max < 0 ||
+			(budget.Max > 0 && budget.Min > budget.Max) ||
+			(budget.Meal != "" && !budget.Meal.IsValid()) {
+			return domainerrors.ErrInvalidBudget
+		}
+	}
+
+	switch filter.Sort {
+	case "":
+		switch {
+		case filter.Text != "":
+			filter.Sort = repository.SortByRelevance
+		case filter.Near != nil:
+			filter.Sort = repository.SortByDistance
+		default:
+			filter.Sort = repository.SortByRating
+		}
+	case repository.SortByRelevance:
+		if filter.Text == "" {
+			return domainerrors.ErrInvalidSort
+		}
+	case repository.SortByDistance:
+		if filter.Near == nil {
+			return domainerrors.ErrInvalidSort
+		}
+	case repository.SortByRating, repository.SortByViewCount, repository.SortByNewest, repository.SortByName:
+	default:
+		return domainerrors.ErrInvalidSort
+	}
+
+	return nil
+}
+
+func (s *restaurantService) FindRestaurantsByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*repository.NearbyRestaurant, error) {
+	if _, err := model.NewLocation(lat, lng); err != nil {
+		return nil, domainerrors.ErrInvalidLocation
+	}
+	if radiusKm <= 0 {
+		return nil, domainerrors.ErrInvalidRadius
+	}
+
 	restaurants, err := s.restaurantRepo.FindByLocation(ctx, lat, lng, radiusKm, limit)
 	if err != nil {
 		s.logger.Error("Failed to find restaurants by location", zap.Error(err))
@@ -285,15 +456,21 @@ func (s *restaurantService) FindRestaurantsByCuisineType(ctx context.Context, cu
 	return restaurants, nil
 }
 
-func (s *restaurantService) IncrementRestaurantViewCount(ctx context.Context, id uuid.UUID) error {
-	restaurant, err := s.restaurantRepo.FindByID(ctx, id)
+func (s *restaurantService) FindRestaurantsByCuisineTypeAfter(ctx context.Context, cuisineType string, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	restaurants, next, err := s.restaurantRepo.FindByCuisineTypeAfter(ctx, cuisineType, after, limit)
 	if err != nil {
-		return err
+		s.logger.Error("Failed to find restaurants by cuisine type", zap.String("cuisineType", cuisineType), zap.Error(err))
+		return nil, nil, err
 	}
 
-	restaurant.IncrementViewCount()
+	return restaurants, next, nil
+}
 
-	if err := s.restaurantRepo.Update(ctx, restaurant); err != nil {
+// IncrementRestaurantViewCount counts a view in the popularity counters. The
+// lifetime view_count is updated when the counters are rolled up, so a view
+// does not write the restaurant row.
+func (s *restaurantService) IncrementRestaurantViewCount(ctx context.Context, id uuid.UUID) error {
+	if err := s.popularity.Incr(ctx, id, model.PopularityEventView, time.Now()); err != nil {
 		s.logger.Error("Failed to increment view count", zap.String("id", id.String()), zap.Error(err))
 		return err
 	}
@@ -301,6 +478,67 @@ func (s *restaurantService) IncrementRestaurantViewCount(ctx context.Context, id
 	return nil
 }
 
+// SetRestaurantLocalization replaces the restaurant's text in one of the
+// model.LocalizedLanguages; empty content removes it. The Map Service
+// overwrites the name, address and summary on the next refresh but keeps the
+// cuisine label.
+func (s *restaurantService) SetRestaurantLocalization(ctx context.Context, id uuid.UUID, lang string, content model.LocalizedContent) (*model.Restaurant, error) {
+	lang, ok := MatchLanguage(lang)
+	if !ok || !model.IsLocalizedLanguage(lang) {
+		return nil, domainerrors.ErrUnsupportedLanguage
+	}
+
+	restaurant, err := s.restaurantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	restaurant.SetLocalization(lang, content)
+	if err := s.restaurantRepo.Update(ctx, restaurant); err != nil {
+		s.logger.Error("Failed to update restaurant localization",
+			zap.String("id", id.String()),
+			zap.String("language", lang),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return restaurant, nil
+}
+
+// SetRestaurantCuisines replaces the restaurant's cuisine categories and
+// records the change. Map Service refreshes and Tabelog matches add back the
+// categories their types and genres map onto.
+func (s *restaurantService) SetRestaurantCuisines(ctx context.Context, id uuid.UUID, cuisines []string, actorID string) (*model.Restaurant, error) {
+	if err := validateCuisines(cuisines); err != nil {
+		return nil, err
+	}
+
+	restaurant, err := s.restaurantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := restaurant.TrackedFields()
+	restaurant.SetCuisines(cuisines)
+	if err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, model.RevisionSourceUserEdit, actorID); err != nil {
+		s.logger.Error("Failed to update restaurant cuisines", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	return restaurant, nil
+}
+
+// validateCuisines checks that every ID is a model.Cuisines category
+func validateCuisines(ids []string) error {
+	for _, id := range ids {
+		if _, ok := model.Cuisines.Category(id); !ok {
+			return fmt.Errorf("%w: %q", domainerrors.ErrUnknownCuisine, id)
+		}
+	}
+	return nil
+}
+
 // QuickSearchByPlaceID implements cache-first search by Google Place ID
 // 1. Check local DB first (cache hit)
 // 2. If not found or stale, call Map Service
@@ -357,7 +595,8 @@ func (s *restaurantService) QuickSearchByPlaceID(ctx context.Context, placeID st
 		zap.String("place_id", placeID),
 	)
 	start := time.Now()
-	place, err := s.mapClient.QuickSearch(ctx, placeID)
+	// English for area extraction
+	place, err := s.mapClient.QuickSearch(ctx, placeID, model.DefaultLanguage)
 	s.logger.Info("[QuickSearch] Map Service response",
 		zap.String("place_id", placeID),
 		zap.Bool("success", err == nil),
@@ -402,6 +641,7 @@ func (s *restaurantService) QuickSearchByPlaceID(ctx context.Context, placeID st
 		)
 		return nil, domainerrors.ErrRestaurantNotFound
 	}
+	s.fetchLocalizations(ctx, placeID, newRestaurant)
 
 	// Step 4: Save or update in local DB
 	if restaurant == nil {
@@ -420,20 +660,10 @@ func (s *restaurantService) QuickSearchByPlaceID(ctx context.Context, placeID st
 		)
 	} else {
 		// Update existing restaurant with fresh data
-		restaurant.UpdateDetails(
-			newRestaurant.Name(),
-			newRestaurant.Address(),
-			newRestaurant.PriceRange(),
-			newRestaurant.CuisineType(),
-			newRestaurant.Phone(),
-			newRestaurant.Website(),
-		)
-		restaurant.UpdateRating(newRestaurant.Rating())
-		if newRestaurant.Location() != nil {
-			restaurant.UpdateLocation(newRestaurant.Location())
-		}
+		before := restaurant.TrackedFields()
+		applyFreshData(restaurant, newRestaurant)
 
-		if err := s.restaurantRepo.Update(ctx, restaurant); err != nil {
+		if err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, model.RevisionSourceMapRefresh, ""); err != nil {
 			s.logger.Error("Failed to update restaurant from Map Service",
 				zap.String("place_id", placeID),
 				zap.Error(err),
@@ -450,6 +680,54 @@ func (s *restaurantService) QuickSearchByPlaceID(ctx context.Context, placeID st
 	return newRestaurant, nil
 }
 
+// fetchLocalizations fills the restaurant's localized text from the Map
+// Service, one call per language. A failed call leaves its language out.
+func (s *restaurantService) fetchLocalizations(ctx context.Context, placeID string, restaurant *model.Restaurant) {
+	for _, lang := range model.LocalizedLanguages {
+		place, err := s.mapClient.QuickSearch(ctx, placeID, lang)
+		if err != nil {
+			metrics.RestaurantMapServiceCallsTotal.WithLabelValues("error").Inc()
+			s.logger.Warn("[QuickSearch] Failed to fetch localized place",
+				zap.String("place_id", placeID),
+				zap.String("language", lang),
+				zap.Error(err),
+			)
+			continue
+		}
+		metrics.RestaurantMapServiceCallsTotal.WithLabelValues("success").Inc()
+		restaurant.MergeLocalization(lang, converters.MapPlaceToLocalization(place))
+	}
+}
+
+// applyFreshData copies the data fetched from the Map Service onto a stored restaurant
+func applyFreshData(restaurant, fresh *model.Restaurant) {
+	restaurant.UpdateDetails(
+		fresh.Name(),
+		fresh.Address(),
+		"", // price is merged below
+		fresh.CuisineType(),
+		fresh.Phone(),
+		fresh.Website(),
+	)
+	// The Map Service only reports a level; keep the yen bands of a Tabelog budget
+	if price, current := fresh.Price(), restaurant.Price(); price != nil && (current == nil || !current.HasBands()) {
+		restaurant.UpdatePrice(price)
+	}
+	// Categories set by hand or from Tabelog genres are kept
+	restaurant.AddCuisines(fresh.Cuisines()...)
+	restaurant.UpdateRating(fresh.Rating())
+	if fresh.Location() != nil {
+		restaurant.UpdateLocation(fresh.Location())
+	}
+	if len(fresh.OpeningHours()) > 0 {
+		restaurant.UpdateOpeningHours(fresh.OpeningHours())
+	}
+	restaurant.UpdateOpeningSchedule(fresh.OpeningSchedule())
+	for lang, content := range fresh.Localizations() {
+		restaurant.MergeLocalization(lang, content)
+	}
+}
+
 // Favorite operations
 
 func (s *restaurantService) AddToFavorites(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error) {
@@ -476,6 +754,8 @@ func (s *restaurantService) AddToFavorites(ctx context.Context, userID, restaura
 		return nil, err
 	}
 
+	recordPopularity(ctx, s.popularity, s.logger, restaurantID, model.PopularityEventFavorite)
+
 	s.logger.Info("Added to favorites", zap.String("favoriteID", favorite.ID().String()))
 	return favorite, nil
 }
@@ -505,6 +785,26 @@ func (s *restaurantService) GetUserFavorites(ctx context.Context, userID uuid.UU
 	return favorites, nil
 }
 
+func (s *restaurantService) GetUserFavoritesAfter(ctx context.Context, userID uuid.UUID, after *repository.Cursor, limit int) ([]*model.Favorite, *repository.Cursor, error) {
+	favorites, next, err := s.favoriteRepo.FindByUserIDAfter(ctx, userID, after, limit)
+	if err != nil {
+		s.logger.Error("Failed to get user favorites", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return favorites, next, nil
+}
+
+func (s *restaurantService) GetUserFavoritesByTag(ctx context.Context, userID uuid.UUID, tag string) ([]*model.Favorite, error) {
+	favorites, err := s.favoriteRepo.FindByTag(ctx, userID, tag)
+	if err != nil {
+		s.logger.Error("Failed to get user favorites by tag", zap.Error(err))
+		return nil, err
+	}
+
+	return favorites, nil
+}
+
 func (s *restaurantService) GetFavoriteByUserAndRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error) {
 	favorite, err := s.favoriteRepo.FindByUserAndRestaurant(ctx, userID, restaurantID)
 	if err != nil {
@@ -562,22 +862,6 @@ func (s *restaurantService) RemoveFavoriteTag(ctx context.Context, userID, resta
 	return favorite, nil
 }
 
-func (s *restaurantService) AddFavoriteVisit(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error) {
-	favorite, err := s.favoriteRepo.FindByUserAndRestaurant(ctx, userID, restaurantID)
-	if err != nil {
-		return nil, err
-	}
-
-	favorite.AddVisit()
-
-	if err := s.favoriteRepo.Update(ctx, favorite); err != nil {
-		s.logger.Error("Failed to add favorite visit", zap.Error(err))
-		return nil, err
-	}
-
-	return favorite, nil
-}
-
 func (s *restaurantService) IsFavorite(ctx context.Context, userID, restaurantID uuid.UUID) (bool, error) {
 	exists, err := s.favoriteRepo.Exists(ctx, userID, restaurantID)
 	if err != nil {
@@ -122,8 +122,8 @@ type MockMapServiceClient struct {
 	mock.Mock
 }
 
-func (m *MockMapServiceClient) QuickSearch(ctx context.Context, placeID string) (*mapv1.Place, error) {
-	args := m.Called(ctx, placeID)
+func (m *MockMapServiceClient) QuickSearch(ctx context.Context, placeID, languageCode string) (*mapv1.Place, error) {
+	args := m.Called(ctx, placeID, languageCode)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -213,7 +213,7 @@ func TestQuickSearchByPlaceID_CacheMiss(t *testing.T) {
 		Rating:     4.5,
 		PriceLevel: "PRICE_LEVEL_MODERATE",
 	}
-	mockMapClient.On("QuickSearch", ctx, placeID).Return(place, nil)
+	mockMapClient.On("QuickSearch", ctx, placeID, mock.Anything).Return(place, nil)
 
 	// Mock: Create saves new restaurant
 	mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Restaurant")).
@@ -301,7 +301,7 @@ func TestQuickSearchByPlaceID_MapServiceFailure(t *testing.T) {
 		Return(staleRestaurant, nil)
 
 	// Mock: Map Service fails
-	mockMapClient.On("QuickSearch", ctx, placeID).
+	mockMapClient.On("QuickSearch", ctx, placeID, mock.Anything).
 		Return(nil, assert.AnError)
 
 	// Create service
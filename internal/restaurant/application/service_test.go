@@ -8,6 +8,7 @@ import (
 	mapv1 "github.com/Leon180/tabelogo-v2/api/gen/map/v1"
 	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -40,6 +41,22 @@ func (m *MockRestaurantRepository) FindByExternalID(ctx context.Context, source
 	return args.Get(0).(*model.Restaurant), args.Error(1)
 }
 
+func (m *MockRestaurantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Restaurant, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantRepository) FindByExternalIDs(ctx context.Context, refs []repository.ExternalRef) (map[repository.ExternalRef]*model.Restaurant, error) {
+	args := m.Called(ctx, refs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[repository.ExternalRef]*model.Restaurant), args.Error(1)
+}
+
 func (m *MockRestaurantRepository) Update(ctx context.Context, restaurant *model.Restaurant) error {
 	args := m.Called(ctx, restaurant)
 	return args.Error(0)
@@ -50,20 +67,55 @@ func (m *MockRestaurantRepository) Delete(ctx context.Context, id uuid.UUID) err
 	return args.Error(0)
 }
 
-func (m *MockRestaurantRepository) Search(ctx context.Context, query string, limit, offset int) ([]*model.Restaurant, error) {
+func (m *MockRestaurantRepository) Search(ctx context.Context, query string, limit, offset int) ([]*repository.RestaurantSearchHit, int64, error) {
 	args := m.Called(ctx, query, limit, offset)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return nil, 0, args.Error(2)
 	}
-	return args.Get(0).([]*model.Restaurant), args.Error(1)
+	return args.Get(0).([]*repository.RestaurantSearchHit), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRestaurantRepository) SearchAfter(ctx context.Context, query string, after *repository.Cursor, limit int) ([]*repository.RestaurantSearchHit, int64, *repository.Cursor, error) {
+	args := m.Called(ctx, query, after, limit)
+	if args.Get(0) == nil {
+		return nil, 0, nil, args.Error(3)
+	}
+	next, _ := args.Get(2).(*repository.Cursor)
+	return args.Get(0).([]*repository.RestaurantSearchHit), args.Get(1).(int64), next, args.Error(3)
 }
 
-func (m *MockRestaurantRepository) FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*model.Restaurant, error) {
+func (m *MockRestaurantRepository) FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*repository.NearbyRestaurant, error) {
 	args := m.Called(ctx, lat, lng, radiusKm, limit)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*model.Restaurant), args.Error(1)
+	return args.Get(0).([]*repository.NearbyRestaurant), args.Error(1)
+}
+
+func (m *MockRestaurantRepository) FindByFilter(ctx context.Context, filter repository.RestaurantFilter, limit, offset int) ([]*repository.FilteredRestaurant, int64, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*repository.FilteredRestaurant), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRestaurantRepository) FindByFilterAfter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, int64, *repository.Cursor, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, 0, nil, args.Error(3)
+	}
+	next, _ := args.Get(2).(*repository.Cursor)
+	return args.Get(0).([]*repository.FilteredRestaurant), args.Get(1).(int64), next, args.Error(3)
+}
+
+func (m *MockRestaurantRepository) FindPageByFilter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, *repository.Cursor, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	next, _ := args.Get(1).(*repository.Cursor)
+	return args.Get(0).([]*repository.FilteredRestaurant), next, args.Error(2)
 }
 
 func (m *MockRestaurantRepository) List(ctx context.Context, limit, offset int) ([]*model.Restaurant, error) {
@@ -74,6 +126,15 @@ func (m *MockRestaurantRepository) List(ctx context.Context, limit, offset int)
 	return args.Get(0).([]*model.Restaurant), args.Error(1)
 }
 
+func (m *MockRestaurantRepository) ListAfter(ctx context.Context, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	args := m.Called(ctx, after, limit)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	next, _ := args.Get(1).(*repository.Cursor)
+	return args.Get(0).([]*model.Restaurant), next, args.Error(2)
+}
+
 func (m *MockRestaurantRepository) Count(ctx context.Context) (int64, error) {
 	args := m.Called(ctx)
 	return args.Get(0).(int64), args.Error(1)
@@ -87,6 +148,15 @@ func (m *MockRestaurantRepository) FindByCuisineType(ctx context.Context, cuisin
 	return args.Get(0).([]*model.Restaurant), args.Error(1)
 }
 
+func (m *MockRestaurantRepository) FindByCuisineTypeAfter(ctx context.Context, cuisineType string, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	args := m.Called(ctx, cuisineType, after, limit)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	next, _ := args.Get(1).(*repository.Cursor)
+	return args.Get(0).([]*model.Restaurant), next, args.Error(2)
+}
+
 func (m *MockRestaurantRepository) FindBySource(ctx context.Context, source model.RestaurantSource, limit, offset int) ([]*model.Restaurant, error) {
 	args := m.Called(ctx, source, limit, offset)
 	if args.Get(0) == nil {
@@ -95,6 +165,57 @@ func (m *MockRestaurantRepository) FindBySource(ctx context.Context, source mode
 	return args.Get(0).([]*model.Restaurant), args.Error(1)
 }
 
+func (m *MockRestaurantRepository) FindBySourceAfter(ctx context.Context, source model.RestaurantSource, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	args := m.Called(ctx, source, after, limit)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	next, _ := args.Get(1).(*repository.Cursor)
+	return args.Get(0).([]*model.Restaurant), next, args.Error(2)
+}
+
+func (m *MockRestaurantRepository) FindStale(ctx context.Context, query repository.StaleQuery) ([]*model.Restaurant, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantRepository) CountStale(ctx context.Context, query repository.StaleQuery) (int64, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRestaurantRepository) FindDeleted(ctx context.Context, query repository.DeletedQuery) ([]*model.Restaurant, int64, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*model.Restaurant), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRestaurantRepository) Restore(ctx context.Context, id uuid.UUID) (*model.Restaurant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Restaurant), args.Error(1)
+}
+
+func (m *MockRestaurantRepository) Purge(ctx context.Context, ids []uuid.UUID, deletedBefore time.Time) ([]uuid.UUID, error) {
+	args := m.Called(ctx, ids, deletedBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRestaurantRepository) Reindex(ctx context.Context, restaurants []*model.Restaurant) error {
+	args := m.Called(ctx, restaurants)
+	return args.Error(0)
+}
+
 // Mock Favorite Repository
 type MockFavoriteRepository struct {
 	mock.Mock
@@ -129,6 +250,15 @@ func (m *MockFavoriteRepository) FindByUserID(ctx context.Context, userID uuid.U
 	return args.Get(0).([]*model.Favorite), args.Error(1)
 }
 
+func (m *MockFavoriteRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, after *repository.Cursor, limit int) ([]*model.Favorite, *repository.Cursor, error) {
+	args := m.Called(ctx, userID, after, limit)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	next, _ := args.Get(1).(*repository.Cursor)
+	return args.Get(0).([]*model.Favorite), next, args.Error(2)
+}
+
 func (m *MockFavoriteRepository) FindByRestaurantID(ctx context.Context, restaurantID uuid.UUID) ([]*model.Favorite, error) {
 	args := m.Called(ctx, restaurantID)
 	if args.Get(0) == nil {
@@ -170,22 +300,39 @@ type MockMapServiceClient struct {
 	mock.Mock
 }
 
-func (m *MockMapServiceClient) QuickSearch(ctx context.Context, placeID string) (*mapv1.Place, error) {
-	args := m.Called(ctx, placeID)
+func (m *MockMapServiceClient) QuickSearch(ctx context.Context, placeID, languageCode string) (*mapv1.Place, error) {
+	args := m.Called(ctx, placeID, languageCode)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*mapv1.Place), args.Error(1)
 }
 
+func (m *MockMapServiceClient) BatchGetPlaces(ctx context.Context, placeIDs []string, languageCode string) ([]*mapv1.Place, error) {
+	args := m.Called(ctx, placeIDs, languageCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mapv1.Place), args.Error(1)
+}
+
+func (m *MockMapServiceClient) GetPhotoMedia(ctx context.Context, photoName string, maxWidthPx int) ([]byte, string, error) {
+	args := m.Called(ctx, photoName, maxWidthPx)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).([]byte), args.String(1), args.Error(2)
+}
+
 // Test CreateRestaurant
 func TestRestaurantService_CreateRestaurant_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	req := CreateRestaurantRequest{
@@ -219,10 +366,11 @@ func TestRestaurantService_CreateRestaurant_Success(t *testing.T) {
 func TestRestaurantService_CreateRestaurant_DuplicateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	req := CreateRestaurantRequest{
@@ -251,10 +399,11 @@ func TestRestaurantService_CreateRestaurant_DuplicateError(t *testing.T) {
 func TestRestaurantService_CreateRestaurant_InvalidLocation(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	req := CreateRestaurantRequest{
@@ -280,10 +429,11 @@ func TestRestaurantService_CreateRestaurant_InvalidLocation(t *testing.T) {
 func TestRestaurantService_GetRestaurant_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -304,10 +454,11 @@ func TestRestaurantService_GetRestaurant_Success(t *testing.T) {
 func TestRestaurantService_GetRestaurant_NotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -327,10 +478,12 @@ func TestRestaurantService_GetRestaurant_NotFound(t *testing.T) {
 func TestRestaurantService_AddToFavorites_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
+	mockPopularity := new(MockPopularityCounter)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, mockPopularity, mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -341,6 +494,7 @@ func TestRestaurantService_AddToFavorites_Success(t *testing.T) {
 	mockRestaurantRepo.On("FindByID", ctx, restaurantID).Return(restaurant, nil)
 	mockFavoriteRepo.On("Exists", ctx, userID, restaurantID).Return(false, nil)
 	mockFavoriteRepo.On("Create", ctx, mock.AnythingOfType("*model.Favorite")).Return(nil)
+	mockPopularity.On("Incr", ctx, restaurantID, model.PopularityEventFavorite, mock.AnythingOfType("time.Time")).Return(nil)
 
 	favorite, err := service.AddToFavorites(ctx, userID, restaurantID)
 
@@ -350,15 +504,17 @@ func TestRestaurantService_AddToFavorites_Success(t *testing.T) {
 	assert.Equal(t, restaurantID, favorite.RestaurantID())
 	mockRestaurantRepo.AssertExpectations(t)
 	mockFavoriteRepo.AssertExpectations(t)
+	mockPopularity.AssertExpectations(t)
 }
 
 func TestRestaurantService_AddToFavorites_AlreadyExists(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -381,10 +537,11 @@ func TestRestaurantService_AddToFavorites_AlreadyExists(t *testing.T) {
 func TestRestaurantService_AddToFavorites_RestaurantNotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -405,26 +562,32 @@ func TestRestaurantService_AddToFavorites_RestaurantNotFound(t *testing.T) {
 func TestRestaurantService_SearchRestaurants_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	location, _ := model.NewLocation(35.6762, 139.6503)
 	restaurant1 := model.NewRestaurant("Sushi Dai", "Tokyo", model.SourceGoogle, "test1", "Tokyo", location)
 	restaurant2 := model.NewRestaurant("Sushi Saito", "Tokyo", model.SourceTabelog, "test2", "Tokyo", location)
-	expectedRestaurants := []*model.Restaurant{restaurant1, restaurant2}
+	expectedHits := []*repository.RestaurantSearchHit{
+		{Restaurant: restaurant1, Score: 1.2},
+		{Restaurant: restaurant2, Score: 0.8},
+	}
 
 	mockRestaurantRepo.On("Search", ctx, "sushi", 20, 0).
-		Return(expectedRestaurants, nil)
+		Return(expectedHits, int64(42), nil)
 
-	restaurants, err := service.SearchRestaurants(ctx, "sushi", 20, 0)
+	hits, total, err := service.SearchRestaurants(ctx, "sushi", 20, 0)
 
 	assert.NoError(t, err)
-	assert.Len(t, restaurants, 2)
-	assert.Equal(t, "Sushi Dai", restaurants[0].Name())
-	assert.Equal(t, "Sushi Saito", restaurants[1].Name())
+	assert.Equal(t, int64(42), total)
+	assert.Len(t, hits, 2)
+	assert.Equal(t, "Sushi Dai", hits[0].Restaurant.Name())
+	assert.Equal(t, 1.2, hits[0].Score)
+	assert.Equal(t, "Sushi Saito", hits[1].Restaurant.Name())
 	mockRestaurantRepo.AssertExpectations(t)
 }
 
@@ -432,10 +595,11 @@ func TestRestaurantService_SearchRestaurants_Success(t *testing.T) {
 func TestRestaurantService_IsFavorite(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -454,10 +618,11 @@ func TestRestaurantService_IsFavorite(t *testing.T) {
 func TestRestaurantService_GetRestaurantByExternalID_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	location, _ := model.NewLocation(35.6762, 139.6503)
@@ -478,10 +643,11 @@ func TestRestaurantService_GetRestaurantByExternalID_Success(t *testing.T) {
 func TestRestaurantService_GetRestaurantByExternalID_NotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 
@@ -500,10 +666,11 @@ func TestRestaurantService_GetRestaurantByExternalID_NotFound(t *testing.T) {
 func TestRestaurantService_UpdateRestaurant_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -523,7 +690,9 @@ func TestRestaurantService_UpdateRestaurant_Success(t *testing.T) {
 	}
 
 	mockRestaurantRepo.On("FindByID", ctx, restaurantID).Return(existingRestaurant, nil)
-	mockRestaurantRepo.On("Update", ctx, mock.AnythingOfType("*model.Restaurant")).Return(nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, mock.AnythingOfType("*model.Restaurant"), mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.Source() == model.RevisionSourceUserEdit
+	})).Return(nil)
 
 	restaurant, err := service.UpdateRestaurant(ctx, restaurantID, req)
 
@@ -538,10 +707,11 @@ func TestRestaurantService_UpdateRestaurant_Success(t *testing.T) {
 func TestRestaurantService_UpdateRestaurant_NotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -561,10 +731,11 @@ func TestRestaurantService_UpdateRestaurant_NotFound(t *testing.T) {
 func TestRestaurantService_UpdateRestaurant_InvalidLocation(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -590,10 +761,11 @@ func TestRestaurantService_UpdateRestaurant_InvalidLocation(t *testing.T) {
 func TestRestaurantService_DeleteRestaurant_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -609,10 +781,11 @@ func TestRestaurantService_DeleteRestaurant_Success(t *testing.T) {
 func TestRestaurantService_DeleteRestaurant_NotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -631,10 +804,11 @@ func TestRestaurantService_DeleteRestaurant_NotFound(t *testing.T) {
 func TestRestaurantService_ListRestaurants_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	location, _ := model.NewLocation(35.6762, 139.6503)
@@ -653,19 +827,194 @@ func TestRestaurantService_ListRestaurants_Success(t *testing.T) {
 	mockRestaurantRepo.AssertExpectations(t)
 }
 
+// Test FilterRestaurants
+func TestRestaurantService_FilterRestaurants_Success(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	logger := zap.NewNop()
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
+
+	ctx := context.Background()
+	location, _ := model.NewLocation(35.6762, 139.6503)
+	restaurant := model.NewRestaurant("Ramen Shop", "Tokyo", model.SourceGoogle, "test1", "Tokyo", location)
+	distance := 0.8
+	expected := []*repository.FilteredRestaurant{
+		{Restaurant: restaurant, DistanceKm: &distance},
+	}
+
+	filter := repository.RestaurantFilter{
+		Area:      "Tokyo",
+		MinRating: 3.5,
+		Near:      &repository.NearPoint{Latitude: 35.6762, Longitude: 139.6503, RadiusKm: 2},
+	}
+	expectedFilter := filter
+	expectedFilter.Sort = repository.SortByDistance
+
+	mockRestaurantRepo.On("FindByFilter", ctx, expectedFilter, 20, 0).Return(expected, int64(1), nil)
+
+	results, total, err := service.FilterRestaurants(ctx, filter, 20, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "Ramen Shop", results[0].Restaurant.Name())
+	assert.Equal(t, 0.8, *results[0].DistanceKm)
+	mockRestaurantRepo.AssertExpectations(t)
+}
+
+func TestRestaurantService_FilterRestaurantsAfter_Success(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	logger := zap.NewNop()
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
+
+	ctx := context.Background()
+	location, _ := model.NewLocation(35.6762, 139.6503)
+	restaurant := model.NewRestaurant("Ramen Shop", "Tokyo", model.SourceGoogle, "test1", "Tokyo", location)
+	expected := []*repository.FilteredRestaurant{{Restaurant: restaurant}}
+
+	after := &repository.Cursor{Order: "rating", Key: "4.5", ID: uuid.New()}
+	next := &repository.Cursor{Order: "rating", Key: "4.2", ID: restaurant.ID()}
+
+	filter := repository.RestaurantFilter{Area: "Tokyo"}
+	expectedFilter := filter
+	expectedFilter.Sort = repository.SortByRating
+
+	mockRestaurantRepo.On("FindByFilterAfter", ctx, expectedFilter, after, 20).Return(expected, int64(3), next, nil)
+
+	results, total, nextCursor, err := service.FilterRestaurantsAfter(ctx, filter, after, 20)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Len(t, results, 1)
+	assert.Equal(t, next, nextCursor)
+	mockRestaurantRepo.AssertExpectations(t)
+}
+
+func TestRestaurantService_FilterRestaurantsAfter_InvalidFilter(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	logger := zap.NewNop()
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
+
+	results, _, next, err := service.FilterRestaurantsAfter(context.Background(), repository.RestaurantFilter{Sort: repository.SortByDistance}, nil, 20)
+
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidSort)
+	assert.Nil(t, results)
+	assert.Nil(t, next)
+	mockRestaurantRepo.AssertNotCalled(t, "FindByFilterAfter")
+}
+
+func TestRestaurantService_ListRestaurantsAfter_LastPage(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	logger := zap.NewNop()
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
+
+	ctx := context.Background()
+	location, _ := model.NewLocation(35.6762, 139.6503)
+	restaurant := model.NewRestaurant("Restaurant 1", "Tokyo", model.SourceGoogle, "test1", "Tokyo", location)
+	after := &repository.Cursor{Order: "newest", Key: "2024-01-01T00:00:00Z", ID: uuid.New()}
+
+	mockRestaurantRepo.On("ListAfter", ctx, after, 20).Return([]*model.Restaurant{restaurant}, nil, nil)
+
+	restaurants, next, err := service.ListRestaurantsAfter(ctx, after, 20)
+
+	assert.NoError(t, err)
+	assert.Len(t, restaurants, 1)
+	assert.Nil(t, next)
+	mockRestaurantRepo.AssertExpectations(t)
+}
+
+func TestRestaurantService_FilterRestaurants_DefaultSort(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter repository.RestaurantFilter
+		want   repository.RestaurantSort
+	}{
+		{"text", repository.RestaurantFilter{Text: "ramen"}, repository.SortByRelevance},
+		{"text and near", repository.RestaurantFilter{Text: "ramen", Near: &repository.NearPoint{Latitude: 35, Longitude: 139, RadiusKm: 1}}, repository.SortByRelevance},
+		{"near", repository.RestaurantFilter{Near: &repository.NearPoint{Latitude: 35, Longitude: 139, RadiusKm: 1}}, repository.SortByDistance},
+		{"none", repository.RestaurantFilter{CuisineType: "Japanese"}, repository.SortByRating},
+		{"explicit", repository.RestaurantFilter{Text: "ramen", Sort: repository.SortByNewest}, repository.SortByNewest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := tt.filter
+			assert.NoError(t, validateRestaurantFilter(&filter))
+			assert.Equal(t, tt.want, filter.Sort)
+		})
+	}
+}
+
+func TestRestaurantService_FilterRestaurants_InvalidInput(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	logger := zap.NewNop()
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		filter  repository.RestaurantFilter
+		wantErr error
+	}{
+		{"invalid location", repository.RestaurantFilter{Near: &repository.NearPoint{Latitude: 91, Longitude: 139, RadiusKm: 1}}, domainerrors.ErrInvalidLocation},
+		{"invalid radius", repository.RestaurantFilter{Near: &repository.NearPoint{Latitude: 35, Longitude: 139}}, domainerrors.ErrInvalidRadius},
+		{"invalid rating", repository.RestaurantFilter{MinRating: 6}, domainerrors.ErrInvalidRating},
+		{"price level too high", repository.RestaurantFilter{MaxPriceLevel: 5}, domainerrors.ErrInvalidPriceLevel},
+		{"price range inverted", repository.RestaurantFilter{MinPriceLevel: 3, MaxPriceLevel: 2}, domainerrors.ErrInvalidPriceLevel},
+		{"unknown cuisine", repository.RestaurantFilter{Cuisine: "fusion"}, domainerrors.ErrUnknownCuisine},
+		{"unknown sort", repository.RestaurantFilter{Sort: "cheapest"}, domainerrors.ErrInvalidSort},
+		{"relevance without text", repository.RestaurantFilter{Sort: repository.SortByRelevance}, domainerrors.ErrInvalidSort},
+		{"distance without point", repository.RestaurantFilter{Sort: repository.SortByDistance}, domainerrors.ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, total, err := service.FilterRestaurants(ctx, tt.filter, 20, 0)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Nil(t, results)
+			assert.Zero(t, total)
+		})
+	}
+
+	mockRestaurantRepo.AssertNotCalled(t, "FindByFilter")
+}
+
 // Test FindRestaurantsByLocation
 func TestRestaurantService_FindRestaurantsByLocation_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	location1, _ := model.NewLocation(35.6762, 139.6503)
 	restaurant1 := model.NewRestaurant("Nearby Restaurant", "Tokyo", model.SourceGoogle, "test1", "Tokyo", location1)
-	expectedRestaurants := []*model.Restaurant{restaurant1}
+	expectedRestaurants := []*repository.NearbyRestaurant{
+		{Restaurant: restaurant1, DistanceKm: 0.42},
+	}
 
 	mockRestaurantRepo.On("FindByLocation", ctx, 35.6762, 139.6503, 5.0, 10).
 		Return(expectedRestaurants, nil)
@@ -674,18 +1023,42 @@ func TestRestaurantService_FindRestaurantsByLocation_Success(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Len(t, restaurants, 1)
-	assert.Equal(t, "Nearby Restaurant", restaurants[0].Name())
+	assert.Equal(t, "Nearby Restaurant", restaurants[0].Restaurant.Name())
+	assert.Equal(t, 0.42, restaurants[0].DistanceKm)
 	mockRestaurantRepo.AssertExpectations(t)
 }
 
+func TestRestaurantService_FindRestaurantsByLocation_InvalidInput(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	logger := zap.NewNop()
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
+
+	ctx := context.Background()
+
+	restaurants, err := service.FindRestaurantsByLocation(ctx, 91.0, 139.6503, 5.0, 10)
+	assert.Equal(t, domainerrors.ErrInvalidLocation, err)
+	assert.Nil(t, restaurants)
+
+	restaurants, err = service.FindRestaurantsByLocation(ctx, 35.6762, 139.6503, 0, 10)
+	assert.Equal(t, domainerrors.ErrInvalidRadius, err)
+	assert.Nil(t, restaurants)
+
+	mockRestaurantRepo.AssertNotCalled(t, "FindByLocation")
+}
+
 // Test FindRestaurantsByCuisineType
 func TestRestaurantService_FindRestaurantsByCuisineType_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	location, _ := model.NewLocation(35.6762, 139.6503)
@@ -708,55 +1081,35 @@ func TestRestaurantService_FindRestaurantsByCuisineType_Success(t *testing.T) {
 func TestRestaurantService_IncrementRestaurantViewCount_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
+	mockPopularity := new(MockPopularityCounter)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, mockPopularity, mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
-	location, _ := model.NewLocation(35.6762, 139.6503)
-	restaurant := model.NewRestaurant("Test", "Tokyo", model.SourceGoogle, "test", "Tokyo", location)
 
-	mockRestaurantRepo.On("FindByID", ctx, restaurantID).Return(restaurant, nil)
-	mockRestaurantRepo.On("Update", ctx, mock.AnythingOfType("*model.Restaurant")).Return(nil)
+	mockPopularity.On("Incr", ctx, restaurantID, model.PopularityEventView, mock.AnythingOfType("time.Time")).Return(nil)
 
 	err := service.IncrementRestaurantViewCount(ctx, restaurantID)
 
 	assert.NoError(t, err)
-	assert.Equal(t, int64(1), restaurant.ViewCount())
-	mockRestaurantRepo.AssertExpectations(t)
-}
-
-func TestRestaurantService_IncrementRestaurantViewCount_NotFound(t *testing.T) {
-	mockRestaurantRepo := new(MockRestaurantRepository)
-	mockFavoriteRepo := new(MockFavoriteRepository)
-	logger := zap.NewNop()
-	mockMapClient := new(MockMapServiceClient)
-	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
-
-	ctx := context.Background()
-	restaurantID := uuid.New()
-
-	mockRestaurantRepo.On("FindByID", ctx, restaurantID).
-		Return(nil, domainerrors.ErrRestaurantNotFound)
-
-	err := service.IncrementRestaurantViewCount(ctx, restaurantID)
-
-	assert.Error(t, err)
-	assert.Equal(t, domainerrors.ErrRestaurantNotFound, err)
-	mockRestaurantRepo.AssertExpectations(t)
+	mockPopularity.AssertExpectations(t)
+	// The restaurant row is not written on every view
+	mockRestaurantRepo.AssertNotCalled(t, "Update")
 }
 
 // Test RemoveFromFavorites
 func TestRestaurantService_RemoveFromFavorites_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -777,10 +1130,11 @@ func TestRestaurantService_RemoveFromFavorites_Success(t *testing.T) {
 func TestRestaurantService_RemoveFromFavorites_NotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -800,10 +1154,11 @@ func TestRestaurantService_RemoveFromFavorites_NotFound(t *testing.T) {
 func TestRestaurantService_GetUserFavorites_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -826,10 +1181,11 @@ func TestRestaurantService_GetUserFavorites_Success(t *testing.T) {
 func TestRestaurantService_GetFavoriteByUserAndRestaurant_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -852,10 +1208,11 @@ func TestRestaurantService_GetFavoriteByUserAndRestaurant_Success(t *testing.T)
 func TestRestaurantService_UpdateFavoriteNotes_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -876,10 +1233,11 @@ func TestRestaurantService_UpdateFavoriteNotes_Success(t *testing.T) {
 func TestRestaurantService_UpdateFavoriteNotes_NotFound(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -900,10 +1258,11 @@ func TestRestaurantService_UpdateFavoriteNotes_NotFound(t *testing.T) {
 func TestRestaurantService_AddFavoriteTag_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -925,10 +1284,11 @@ func TestRestaurantService_AddFavoriteTag_Success(t *testing.T) {
 func TestRestaurantService_RemoveFavoriteTag_Success(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -949,84 +1309,38 @@ func TestRestaurantService_RemoveFavoriteTag_Success(t *testing.T) {
 	mockFavoriteRepo.AssertExpectations(t)
 }
 
-// Test AddFavoriteVisit
-func TestRestaurantService_AddFavoriteVisit_Success(t *testing.T) {
-	mockRestaurantRepo := new(MockRestaurantRepository)
-	mockFavoriteRepo := new(MockFavoriteRepository)
-	logger := zap.NewNop()
-	mockMapClient := new(MockMapServiceClient)
-	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
-
-	ctx := context.Background()
-	userID := uuid.New()
-	restaurantID := uuid.New()
-	favorite := model.NewFavorite(userID, restaurantID)
-
-	mockFavoriteRepo.On("FindByUserAndRestaurant", ctx, userID, restaurantID).Return(favorite, nil)
-	mockFavoriteRepo.On("Update", ctx, mock.AnythingOfType("*model.Favorite")).Return(nil)
-
-	updatedFavorite, err := service.AddFavoriteVisit(ctx, userID, restaurantID)
-
-	assert.NoError(t, err)
-	assert.NotNil(t, updatedFavorite)
-	assert.Equal(t, 1, updatedFavorite.VisitCount())
-	assert.NotNil(t, updatedFavorite.LastVisitedAt())
-	mockFavoriteRepo.AssertExpectations(t)
-}
-
-func TestRestaurantService_AddFavoriteVisit_NotFound(t *testing.T) {
-	mockRestaurantRepo := new(MockRestaurantRepository)
-	mockFavoriteRepo := new(MockFavoriteRepository)
-	logger := zap.NewNop()
-	mockMapClient := new(MockMapServiceClient)
-	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
-
-	ctx := context.Background()
-	userID := uuid.New()
-	restaurantID := uuid.New()
-
-	mockFavoriteRepo.On("FindByUserAndRestaurant", ctx, userID, restaurantID).
-		Return(nil, domainerrors.ErrFavoriteNotFound)
-
-	favorite, err := service.AddFavoriteVisit(ctx, userID, restaurantID)
-
-	assert.Error(t, err)
-	assert.Equal(t, domainerrors.ErrFavoriteNotFound, err)
-	assert.Nil(t, favorite)
-	mockFavoriteRepo.AssertExpectations(t)
-}
-
 // Test error scenarios for better coverage
 
 func TestRestaurantService_SearchRestaurants_Error(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	expectedErr := assert.AnError
 
-	mockRestaurantRepo.On("Search", ctx, "sushi", 20, 0).Return(nil, expectedErr)
+	mockRestaurantRepo.On("Search", ctx, "sushi", 20, 0).Return(nil, int64(0), expectedErr)
 
-	restaurants, err := service.SearchRestaurants(ctx, "sushi", 20, 0)
+	hits, total, err := service.SearchRestaurants(ctx, "sushi", 20, 0)
 
 	assert.Error(t, err)
-	assert.Nil(t, restaurants)
+	assert.Nil(t, hits)
+	assert.Zero(t, total)
 	mockRestaurantRepo.AssertExpectations(t)
 }
 
 func TestRestaurantService_ListRestaurants_Error(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	expectedErr := assert.AnError
@@ -1043,10 +1357,11 @@ func TestRestaurantService_ListRestaurants_Error(t *testing.T) {
 func TestRestaurantService_FindRestaurantsByLocation_Error(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	expectedErr := assert.AnError
@@ -1064,10 +1379,11 @@ func TestRestaurantService_FindRestaurantsByLocation_Error(t *testing.T) {
 func TestRestaurantService_FindRestaurantsByCuisineType_Error(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	expectedErr := assert.AnError
@@ -1085,10 +1401,11 @@ func TestRestaurantService_FindRestaurantsByCuisineType_Error(t *testing.T) {
 func TestRestaurantService_GetUserFavorites_Error(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1106,10 +1423,11 @@ func TestRestaurantService_GetUserFavorites_Error(t *testing.T) {
 func TestRestaurantService_AddToFavorites_ExistsError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1132,10 +1450,11 @@ func TestRestaurantService_AddToFavorites_ExistsError(t *testing.T) {
 func TestRestaurantService_AddToFavorites_CreateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1159,10 +1478,11 @@ func TestRestaurantService_AddToFavorites_CreateError(t *testing.T) {
 func TestRestaurantService_RemoveFromFavorites_DeleteError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1184,10 +1504,11 @@ func TestRestaurantService_RemoveFromFavorites_DeleteError(t *testing.T) {
 func TestRestaurantService_UpdateFavoriteNotes_UpdateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1208,10 +1529,11 @@ func TestRestaurantService_UpdateFavoriteNotes_UpdateError(t *testing.T) {
 func TestRestaurantService_AddFavoriteTag_UpdateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1232,10 +1554,11 @@ func TestRestaurantService_AddFavoriteTag_UpdateError(t *testing.T) {
 func TestRestaurantService_RemoveFavoriteTag_UpdateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1254,37 +1577,14 @@ func TestRestaurantService_RemoveFavoriteTag_UpdateError(t *testing.T) {
 	mockFavoriteRepo.AssertExpectations(t)
 }
 
-func TestRestaurantService_AddFavoriteVisit_UpdateError(t *testing.T) {
-	mockRestaurantRepo := new(MockRestaurantRepository)
-	mockFavoriteRepo := new(MockFavoriteRepository)
-	logger := zap.NewNop()
-	mockMapClient := new(MockMapServiceClient)
-	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
-
-	ctx := context.Background()
-	userID := uuid.New()
-	restaurantID := uuid.New()
-	favorite := model.NewFavorite(userID, restaurantID)
-	expectedErr := assert.AnError
-
-	mockFavoriteRepo.On("FindByUserAndRestaurant", ctx, userID, restaurantID).Return(favorite, nil)
-	mockFavoriteRepo.On("Update", ctx, mock.AnythingOfType("*model.Favorite")).Return(expectedErr)
-
-	updatedFavorite, err := service.AddFavoriteVisit(ctx, userID, restaurantID)
-
-	assert.Error(t, err)
-	assert.Nil(t, updatedFavorite)
-	mockFavoriteRepo.AssertExpectations(t)
-}
-
 func TestRestaurantService_IsFavorite_Error(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -1303,10 +1603,11 @@ func TestRestaurantService_IsFavorite_Error(t *testing.T) {
 func TestRestaurantService_CreateRestaurant_CreateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	req := CreateRestaurantRequest{
@@ -1334,10 +1635,11 @@ func TestRestaurantService_CreateRestaurant_CreateError(t *testing.T) {
 func TestRestaurantService_UpdateRestaurant_UpdateError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, new(MockPopularityCounter), mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
@@ -1356,25 +1658,135 @@ func TestRestaurantService_UpdateRestaurant_UpdateError(t *testing.T) {
 	mockRestaurantRepo.AssertExpectations(t)
 }
 
-func TestRestaurantService_IncrementRestaurantViewCount_UpdateError(t *testing.T) {
+func TestRestaurantService_IncrementRestaurantViewCount_CounterError(t *testing.T) {
 	mockRestaurantRepo := new(MockRestaurantRepository)
 	mockFavoriteRepo := new(MockFavoriteRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
 	logger := zap.NewNop()
 	mockMapClient := new(MockMapServiceClient)
+	mockPopularity := new(MockPopularityCounter)
 	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
-	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockMapClient, config, logger)
+	service := NewRestaurantService(mockRestaurantRepo, mockFavoriteRepo, mockRevisionRepo, mockPopularity, mockMapClient, config, logger)
 
 	ctx := context.Background()
 	restaurantID := uuid.New()
-	location, _ := model.NewLocation(35.6762, 139.6503)
-	restaurant := model.NewRestaurant("Test", "Tokyo", model.SourceGoogle, "test", "Tokyo", location)
 	expectedErr := assert.AnError
 
-	mockRestaurantRepo.On("FindByID", ctx, restaurantID).Return(restaurant, nil)
-	mockRestaurantRepo.On("Update", ctx, mock.AnythingOfType("*model.Restaurant")).Return(expectedErr)
+	mockPopularity.On("Incr", ctx, restaurantID, model.PopularityEventView, mock.AnythingOfType("time.Time")).Return(expectedErr)
 
 	err := service.IncrementRestaurantViewCount(ctx, restaurantID)
 
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+	mockPopularity.AssertExpectations(t)
+}
+
+func TestRestaurantService_SetRestaurantLocalization(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, new(MockFavoriteRepository), new(MockRevisionRepository), new(MockPopularityCounter), mockMapClient, config, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "Ramen", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRestaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockRestaurantRepo.On("Update", ctx, restaurant).Return(nil)
+
+	result, err := service.SetRestaurantLocalization(ctx, restaurant.ID(), "zh_tw", model.LocalizedContent{
+		Name:         "一蘭",
+		CuisineLabel: "拉麵",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "拉麵", result.Localization(model.LanguageTraditionalChinese).CuisineLabel)
+	mockRestaurantRepo.AssertExpectations(t)
+}
+
+func TestRestaurantService_SetRestaurantLocalization_UnsupportedLanguage(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, new(MockFavoriteRepository), new(MockRevisionRepository), new(MockPopularityCounter), new(MockMapServiceClient), config, zap.NewNop())
+
+	ctx := context.Background()
+	// English lives in the restaurant's own fields
+	for _, lang := range []string{"en", "fr", ""} {
+		_, err := service.SetRestaurantLocalization(ctx, uuid.New(), lang, model.LocalizedContent{Name: "x"})
+		assert.ErrorIs(t, err, domainerrors.ErrUnsupportedLanguage, lang)
+	}
+	mockRestaurantRepo.AssertNotCalled(t, "FindByID")
+}
+
+func TestRestaurantService_SetRestaurantCuisines(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, new(MockFavoriteRepository), mockRevisionRepo, new(MockPopularityCounter), new(MockMapServiceClient), config, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	restaurant.AddCuisines("japanese")
+
+	mockRestaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.ActorID() == "admin-1" && len(r.Changes()) == 1 &&
+			r.Changes()[0] == model.FieldChange{Field: model.FieldCuisines, Before: "japanese", After: "ramen,tsukemen"}
+	})).Return(nil)
+
+	result, err := service.SetRestaurantCuisines(ctx, restaurant.ID(), []string{"ramen", "tsukemen", "ramen"}, "admin-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ramen", "tsukemen"}, result.Cuisines())
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestRestaurantService_SetRestaurantCuisines_UnknownCuisine(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, new(MockFavoriteRepository), new(MockRevisionRepository), new(MockPopularityCounter), new(MockMapServiceClient), config, zap.NewNop())
+
+	_, err := service.SetRestaurantCuisines(context.Background(), uuid.New(), []string{"ramen", "Ramen"}, "admin-1")
+
+	assert.ErrorIs(t, err, domainerrors.ErrUnknownCuisine)
+	mockRestaurantRepo.AssertNotCalled(t, "FindByID")
+}
+
+func TestRestaurantService_QuickSearchByPlaceID_FetchesLocalizations(t *testing.T) {
+	mockRestaurantRepo := new(MockRestaurantRepository)
+	mockMapClient := new(MockMapServiceClient)
+	config := &Config{DataFreshnessTTL: 3 * 24 * time.Hour}
+	service := NewRestaurantService(mockRestaurantRepo, new(MockFavoriteRepository), new(MockRevisionRepository), new(MockPopularityCounter), mockMapClient, config, zap.NewNop())
+
+	ctx := context.Background()
+	placeID := "ChIJTest123"
+
+	mockRestaurantRepo.On("FindByExternalID", ctx, model.SourceGoogle, placeID).Return(nil, domainerrors.ErrRestaurantNotFound)
+	mockMapClient.On("QuickSearch", ctx, placeID, model.LanguageEnglish).Return(&mapv1.Place{
+		Id:               placeID,
+		Name:             "Ichiran Shibuya",
+		FormattedAddress: "1-22-7 Jinnan, Shibuya, Tokyo",
+		Location:         &mapv1.Location{Latitude: 35.6614, Longitude: 139.7006},
+	}, nil)
+	mockMapClient.On("QuickSearch", ctx, placeID, model.LanguageJapanese).Return(&mapv1.Place{
+		Id:               placeID,
+		Name:             "一蘭 渋谷店",
+		FormattedAddress: "東京都渋谷区神南1-22-7",
+		EditorialSummary: "天然とんこつラーメン専門店",
+	}, nil)
+	// A failed language leaves it out without failing the search
+	mockMapClient.On("QuickSearch", ctx, placeID, model.LanguageTraditionalChinese).Return(nil, assert.AnError)
+	mockRestaurantRepo.On("Create", ctx, mock.AnythingOfType("*model.Restaurant")).Return(nil)
+
+	restaurant, err := service.QuickSearchByPlaceID(ctx, placeID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ichiran Shibuya", restaurant.Name())
+	assert.Equal(t, model.LocalizedContent{
+		Name:    "一蘭 渋谷店",
+		Address: "東京都渋谷区神南1-22-7",
+		Summary: "天然とんこつラーメン専門店",
+	}, restaurant.Localization(model.LanguageJapanese))
+	assert.Equal(t, "一蘭 渋谷店", restaurant.NameJa())
+	assert.NotContains(t, restaurant.Localizations(), model.LanguageTraditionalChinese)
+	mockMapClient.AssertExpectations(t)
 	mockRestaurantRepo.AssertExpectations(t)
 }
@@ -0,0 +1,13 @@
+package application
+
+import (
+	"context"
+
+	spiderv1 "github.com/Leon180/tabelogo-v2/api/gen/spider/v1"
+)
+
+// SpiderServiceClient defines the interface for Spider Service gRPC client
+type SpiderServiceClient interface {
+	// SearchSimilarRestaurants searches Tabelog for the listings of a place
+	SearchSimilarRestaurants(ctx context.Context, req *spiderv1.SearchSimilarRestaurantsRequest) ([]*spiderv1.TabelogRestaurant, error)
+}
@@ -0,0 +1,264 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DefaultTrustedEditorApprovals is the number of approved edit suggestions
+// after which a user is trusted to edit restaurants directly
+const DefaultTrustedEditorApprovals = 10
+
+// Editor is the user submitting or reviewing an edit
+type Editor struct {
+	UserID string
+	Role   string
+}
+
+// SubmitEditResult is the outcome of an edit: either the updated restaurant
+// when the editor is trusted, or the pending suggestion otherwise
+type SubmitEditResult struct {
+	Restaurant *model.Restaurant
+	Suggestion *model.EditSuggestion
+}
+
+// SuggestionService moderates edits of restaurant details. Edits by admins
+// and trusted users are applied immediately; edits by other users are stored
+// as suggestions until a moderator approves or rejects them.
+type SuggestionService interface {
+	// SubmitEdit applies the edit or stores it as a pending suggestion
+	SubmitEdit(ctx context.Context, restaurantID uuid.UUID, req UpdateRestaurantRequest, editor Editor) (*SubmitEditResult, error)
+
+	// ListSuggestions lists suggestions matching the query. Users who cannot
+	// moderate only see their own suggestions.
+	ListSuggestions(ctx context.Context, query repository.SuggestionQuery, editor Editor) ([]*model.EditSuggestion, int64, error)
+
+	// ApproveSuggestion applies a pending suggestion to its restaurant
+	ApproveSuggestion(ctx context.Context, id uuid.UUID, editor Editor, note string) (*model.EditSuggestion, error)
+
+	// RejectSuggestion rejects a pending suggestion
+	RejectSuggestion(ctx context.Context, id uuid.UUID, editor Editor, note string) (*model.EditSuggestion, error)
+
+	// IsTrusted checks if the editor's edits are applied directly, which
+	// also allows them to moderate suggestions
+	IsTrusted(ctx context.Context, editor Editor) (bool, error)
+}
+
+type suggestionService struct {
+	restaurantService RestaurantService
+	restaurantRepo    repository.RestaurantRepository
+	suggestionRepo    repository.SuggestionRepository
+	config            *Config
+	logger            *zap.Logger
+}
+
+// NewSuggestionService creates a new edit suggestion service
+func NewSuggestionService(
+	restaurantService RestaurantService,
+	restaurantRepo repository.RestaurantRepository,
+	suggestionRepo repository.SuggestionRepository,
+	config *Config,
+	logger *zap.Logger,
+) SuggestionService {
+	return &suggestionService{
+		restaurantService: restaurantService,
+		restaurantRepo:    restaurantRepo,
+		suggestionRepo:    suggestionRepo,
+		config:            config,
+		logger:            logger,
+	}
+}
+
+func (s *suggestionService) IsTrusted(ctx context.Context, editor Editor) (bool, error) {
+	if editor.Role == "admin" {
+		return true, nil
+	}
+	if editor.UserID == "" || s.config == nil || s.config.TrustedEditorApprovals <= 0 {
+		return false, nil
+	}
+
+	approved, err := s.suggestionRepo.CountApprovedByAuthor(ctx, editor.UserID)
+	if err != nil {
+		return false, err
+	}
+	return approved >= int64(s.config.TrustedEditorApprovals), nil
+}
+
+func (s *suggestionService) SubmitEdit(ctx context.Context, restaurantID uuid.UUID, req UpdateRestaurantRequest, editor Editor) (*SubmitEditResult, error) {
+	edit := toRestaurantEdit(req)
+	if edit.IsEmpty() {
+		return nil, domainerrors.ErrEmptyEdit
+	}
+
+	trusted, err := s.IsTrusted(ctx, editor)
+	if err != nil {
+		return nil, err
+	}
+	if trusted {
+		req.ActorID = editor.UserID
+		restaurant, err := s.restaurantService.UpdateRestaurant(ctx, restaurantID, req)
+		if err != nil {
+			return nil, err
+		}
+		return &SubmitEditResult{Restaurant: restaurant}, nil
+	}
+
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+	// Reject invalid coordinates now rather than when a moderator approves
+	if edit.Latitude != 0 && edit.Longitude != 0 {
+		if _, err := model.NewLocation(edit.Latitude, edit.Longitude); err != nil {
+			return nil, err
+		}
+	}
+
+	suggestion := model.NewEditSuggestion(restaurantID, editor.UserID, edit)
+	if err := s.suggestionRepo.Create(ctx, suggestion); err != nil {
+		s.logger.Error("Failed to create edit suggestion",
+			zap.String("restaurant_id", restaurantID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("Edit suggestion submitted",
+		zap.String("id", suggestion.ID().String()),
+		zap.String("restaurant_id", restaurantID.String()),
+		zap.String("author_id", editor.UserID),
+	)
+
+	return &SubmitEditResult{Suggestion: suggestion}, nil
+}
+
+func (s *suggestionService) ListSuggestions(ctx context.Context, query repository.SuggestionQuery, editor Editor) ([]*model.EditSuggestion, int64, error) {
+	trusted, err := s.IsTrusted(ctx, editor)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !trusted {
+		query.AuthorID = editor.UserID
+	}
+
+	return s.suggestionRepo.List(ctx, query)
+}
+
+func (s *suggestionService) ApproveSuggestion(ctx context.Context, id uuid.UUID, editor Editor, note string) (*model.EditSuggestion, error) {
+	suggestion, err := s.findReviewable(ctx, id, editor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The approval is stored before the edit is applied: Update only stores
+	// the review of a pending suggestion, so of two concurrent approvals only
+	// one gets to apply the edit
+	suggestion.Approve(editor.UserID, note)
+	if err := s.suggestionRepo.Update(ctx, suggestion); err != nil {
+		if !errors.Is(err, domainerrors.ErrSuggestionNotPending) {
+			s.logger.Error("Failed to approve edit suggestion", zap.String("id", id.String()), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	// Approved edits go through the same update as direct edits and are
+	// recorded in the revision log under their author
+	req := toUpdateRestaurantRequest(suggestion.Edit())
+	req.ActorID = suggestion.AuthorID()
+	if _, err := s.restaurantService.UpdateRestaurant(ctx, suggestion.RestaurantID(), req); err != nil {
+		// Back to pending, so the suggestion can be reviewed again
+		if reopenErr := s.suggestionRepo.Reopen(ctx, id); reopenErr != nil {
+			s.logger.Error("Failed to reopen edit suggestion", zap.String("id", id.String()), zap.Error(reopenErr))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Edit suggestion approved",
+		zap.String("id", id.String()),
+		zap.String("restaurant_id", suggestion.RestaurantID().String()),
+		zap.String("reviewer_id", editor.UserID),
+	)
+
+	return suggestion, nil
+}
+
+func (s *suggestionService) RejectSuggestion(ctx context.Context, id uuid.UUID, editor Editor, note string) (*model.EditSuggestion, error) {
+	suggestion, err := s.findReviewable(ctx, id, editor)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion.Reject(editor.UserID, note)
+	if err := s.suggestionRepo.Update(ctx, suggestion); err != nil {
+		s.logger.Error("Failed to reject edit suggestion", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Edit suggestion rejected",
+		zap.String("id", id.String()),
+		zap.String("restaurant_id", suggestion.RestaurantID().String()),
+		zap.String("reviewer_id", editor.UserID),
+	)
+
+	return suggestion, nil
+}
+
+// findReviewable loads a pending suggestion the editor may review
+func (s *suggestionService) findReviewable(ctx context.Context, id uuid.UUID, editor Editor) (*model.EditSuggestion, error) {
+	trusted, err := s.IsTrusted(ctx, editor)
+	if err != nil {
+		return nil, err
+	}
+	if !trusted {
+		return nil, domainerrors.ErrNotModerator
+	}
+
+	suggestion, err := s.suggestionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !suggestion.IsPending() {
+		return nil, domainerrors.ErrSuggestionNotPending
+	}
+	if suggestion.AuthorID() == editor.UserID {
+		return nil, domainerrors.ErrOwnSuggestion
+	}
+	return suggestion, nil
+}
+
+func toRestaurantEdit(req UpdateRestaurantRequest) model.RestaurantEdit {
+	return model.RestaurantEdit{
+		Name:        req.Name,
+		NameJa:      req.NameJa,
+		Area:        req.Area,
+		Address:     req.Address,
+		Rating:      req.Rating,
+		PriceRange:  req.PriceRange,
+		CuisineType: req.CuisineType,
+		Phone:       req.Phone,
+		Website:     req.Website,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+	}
+}
+
+func toUpdateRestaurantRequest(edit model.RestaurantEdit) UpdateRestaurantRequest {
+	return UpdateRestaurantRequest{
+		Name:        edit.Name,
+		NameJa:      edit.NameJa,
+		Area:        edit.Area,
+		Address:     edit.Address,
+		Rating:      edit.Rating,
+		PriceRange:  edit.PriceRange,
+		CuisineType: edit.CuisineType,
+		Phone:       edit.Phone,
+		Website:     edit.Website,
+		Latitude:    edit.Latitude,
+		Longitude:   edit.Longitude,
+	}
+}
@@ -0,0 +1,282 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockSuggestionRepository is a mock implementation of SuggestionRepository
+type MockSuggestionRepository struct {
+	mock.Mock
+}
+
+func (m *MockSuggestionRepository) Create(ctx context.Context, suggestion *model.EditSuggestion) error {
+	args := m.Called(ctx, suggestion)
+	return args.Error(0)
+}
+
+func (m *MockSuggestionRepository) Update(ctx context.Context, suggestion *model.EditSuggestion) error {
+	args := m.Called(ctx, suggestion)
+	return args.Error(0)
+}
+
+func (m *MockSuggestionRepository) Reopen(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSuggestionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.EditSuggestion, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.EditSuggestion), args.Error(1)
+}
+
+func (m *MockSuggestionRepository) List(ctx context.Context, query repository.SuggestionQuery) ([]*model.EditSuggestion, int64, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*model.EditSuggestion), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSuggestionRepository) CountApprovedByAuthor(ctx context.Context, authorID string) (int64, error) {
+	args := m.Called(ctx, authorID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type suggestionTestDeps struct {
+	restaurantRepo *MockRestaurantRepository
+	revisionRepo   *MockRevisionRepository
+	suggestionRepo *MockSuggestionRepository
+	service        SuggestionService
+}
+
+// newTestSuggestionService wires the suggestion service to a real restaurant
+// service so approved edits go through UpdateRestaurant
+func newTestSuggestionService() *suggestionTestDeps {
+	deps := &suggestionTestDeps{
+		restaurantRepo: new(MockRestaurantRepository),
+		revisionRepo:   new(MockRevisionRepository),
+		suggestionRepo: new(MockSuggestionRepository),
+	}
+	config := &Config{TrustedEditorApprovals: 3}
+	restaurantService := NewRestaurantService(deps.restaurantRepo, new(MockFavoriteRepository), deps.revisionRepo, new(MockPopularityCounter), new(MockMapServiceClient), config, zap.NewNop())
+	deps.service = NewSuggestionService(restaurantService, deps.restaurantRepo, deps.suggestionRepo, config, zap.NewNop())
+	return deps
+}
+
+// Test SubmitEdit
+func TestSuggestionService_SubmitEdit_RegularUserCreatesSuggestion(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	editor := Editor{UserID: "user-1", Role: "user"}
+
+	deps.suggestionRepo.On("CountApprovedByAuthor", ctx, "user-1").Return(int64(2), nil)
+	deps.restaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	deps.suggestionRepo.On("Create", ctx, mock.MatchedBy(func(s *model.EditSuggestion) bool {
+		return s.RestaurantID() == restaurant.ID() && s.AuthorID() == "user-1" && s.Edit().NameJa == "一蘭" && s.IsPending()
+	})).Return(nil)
+
+	result, err := deps.service.SubmitEdit(ctx, restaurant.ID(), UpdateRestaurantRequest{NameJa: "一蘭"}, editor)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Restaurant)
+	assert.NotNil(t, result.Suggestion)
+	assert.Empty(t, restaurant.NameJa())
+	deps.suggestionRepo.AssertExpectations(t)
+	deps.revisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestSuggestionService_SubmitEdit_TrustedUserAppliesDirectly(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	editor := Editor{UserID: "user-1", Role: "user"}
+
+	deps.suggestionRepo.On("CountApprovedByAuthor", ctx, "user-1").Return(int64(3), nil)
+	deps.restaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	deps.revisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.ActorID() == "user-1"
+	})).Return(nil)
+
+	result, err := deps.service.SubmitEdit(ctx, restaurant.ID(), UpdateRestaurantRequest{NameJa: "一蘭"}, editor)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Suggestion)
+	assert.Equal(t, "一蘭", result.Restaurant.NameJa())
+	deps.suggestionRepo.AssertNotCalled(t, "Create")
+	deps.revisionRepo.AssertExpectations(t)
+}
+
+func TestSuggestionService_SubmitEdit_AdminAppliesDirectly(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+
+	deps.restaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	deps.revisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.AnythingOfType("*model.RestaurantRevision")).Return(nil)
+
+	result, err := deps.service.SubmitEdit(ctx, restaurant.ID(), UpdateRestaurantRequest{NameJa: "一蘭"}, Editor{UserID: "admin-1", Role: "admin"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "一蘭", result.Restaurant.NameJa())
+	deps.suggestionRepo.AssertNotCalled(t, "CountApprovedByAuthor")
+}
+
+func TestSuggestionService_SubmitEdit_EmptyEdit(t *testing.T) {
+	deps := newTestSuggestionService()
+
+	result, err := deps.service.SubmitEdit(context.Background(), uuid.New(), UpdateRestaurantRequest{}, Editor{UserID: "user-1"})
+
+	assert.ErrorIs(t, err, domainerrors.ErrEmptyEdit)
+	assert.Nil(t, result)
+}
+
+// Test ListSuggestions
+func TestSuggestionService_ListSuggestions_RegularUserSeesOwn(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+
+	deps.suggestionRepo.On("CountApprovedByAuthor", ctx, "user-1").Return(int64(0), nil)
+	deps.suggestionRepo.On("List", ctx, repository.SuggestionQuery{
+		Status:   model.SuggestionPending,
+		AuthorID: "user-1",
+		Limit:    20,
+	}).Return([]*model.EditSuggestion{}, int64(0), nil)
+
+	_, _, err := deps.service.ListSuggestions(ctx, repository.SuggestionQuery{Status: model.SuggestionPending, Limit: 20}, Editor{UserID: "user-1"})
+
+	assert.NoError(t, err)
+	deps.suggestionRepo.AssertExpectations(t)
+}
+
+// Test ApproveSuggestion
+func TestSuggestionService_ApproveSuggestion(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran", "", model.SourceGoogle, 35.6595, 139.7005)
+	suggestion := model.NewEditSuggestion(restaurant.ID(), "user-1", model.RestaurantEdit{NameJa: "一蘭"})
+	moderator := Editor{UserID: "admin-1", Role: "admin"}
+
+	deps.suggestionRepo.On("FindByID", ctx, suggestion.ID()).Return(suggestion, nil)
+	deps.restaurantRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	deps.revisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.Source() == model.RevisionSourceUserEdit && r.ActorID() == "user-1"
+	})).Return(nil)
+	deps.suggestionRepo.On("Update", ctx, suggestion).Return(nil)
+
+	result, err := deps.service.ApproveSuggestion(ctx, suggestion.ID(), moderator, "looks right")
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.SuggestionApproved, result.Status())
+	assert.Equal(t, "admin-1", result.ReviewerID())
+	assert.Equal(t, "looks right", result.ReviewNote())
+	assert.NotNil(t, result.ReviewedAt())
+	assert.Equal(t, "一蘭", restaurant.NameJa())
+	deps.revisionRepo.AssertExpectations(t)
+	deps.suggestionRepo.AssertExpectations(t)
+}
+
+func TestSuggestionService_ApproveSuggestion_NotModerator(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+
+	deps.suggestionRepo.On("CountApprovedByAuthor", ctx, "user-2").Return(int64(1), nil)
+
+	result, err := deps.service.ApproveSuggestion(ctx, uuid.New(), Editor{UserID: "user-2", Role: "user"}, "")
+
+	assert.ErrorIs(t, err, domainerrors.ErrNotModerator)
+	assert.Nil(t, result)
+	deps.suggestionRepo.AssertNotCalled(t, "FindByID")
+}
+
+func TestSuggestionService_ApproveSuggestion_OwnSuggestion(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	suggestion := model.NewEditSuggestion(uuid.New(), "user-1", model.RestaurantEdit{NameJa: "一蘭"})
+
+	deps.suggestionRepo.On("CountApprovedByAuthor", ctx, "user-1").Return(int64(5), nil)
+	deps.suggestionRepo.On("FindByID", ctx, suggestion.ID()).Return(suggestion, nil)
+
+	result, err := deps.service.ApproveSuggestion(ctx, suggestion.ID(), Editor{UserID: "user-1", Role: "user"}, "")
+
+	assert.ErrorIs(t, err, domainerrors.ErrOwnSuggestion)
+	assert.Nil(t, result)
+	assert.True(t, suggestion.IsPending())
+}
+
+func TestSuggestionService_ApproveSuggestion_ConcurrentlyReviewed(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	suggestion := model.NewEditSuggestion(uuid.New(), "user-1", model.RestaurantEdit{NameJa: "一蘭"})
+
+	deps.suggestionRepo.On("FindByID", ctx, suggestion.ID()).Return(suggestion, nil)
+	deps.suggestionRepo.On("Update", ctx, suggestion).Return(domainerrors.ErrSuggestionNotPending)
+
+	result, err := deps.service.ApproveSuggestion(ctx, suggestion.ID(), Editor{UserID: "admin-1", Role: "admin"}, "")
+
+	assert.ErrorIs(t, err, domainerrors.ErrSuggestionNotPending)
+	assert.Nil(t, result)
+	deps.restaurantRepo.AssertNotCalled(t, "FindByID")
+	deps.revisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestSuggestionService_ApproveSuggestion_EditFailsReopens(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	suggestion := model.NewEditSuggestion(uuid.New(), "user-1", model.RestaurantEdit{NameJa: "一蘭"})
+
+	deps.suggestionRepo.On("FindByID", ctx, suggestion.ID()).Return(suggestion, nil)
+	deps.suggestionRepo.On("Update", ctx, suggestion).Return(nil)
+	deps.restaurantRepo.On("FindByID", ctx, suggestion.RestaurantID()).Return(nil, domainerrors.ErrRestaurantNotFound)
+	deps.suggestionRepo.On("Reopen", ctx, suggestion.ID()).Return(nil)
+
+	result, err := deps.service.ApproveSuggestion(ctx, suggestion.ID(), Editor{UserID: "admin-1", Role: "admin"}, "")
+
+	assert.ErrorIs(t, err, domainerrors.ErrRestaurantNotFound)
+	assert.Nil(t, result)
+	deps.suggestionRepo.AssertExpectations(t)
+}
+
+// Test RejectSuggestion
+func TestSuggestionService_RejectSuggestion(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	suggestion := model.NewEditSuggestion(uuid.New(), "user-1", model.RestaurantEdit{NameJa: "一蘭"})
+
+	deps.suggestionRepo.On("FindByID", ctx, suggestion.ID()).Return(suggestion, nil)
+	deps.suggestionRepo.On("Update", ctx, suggestion).Return(nil)
+
+	result, err := deps.service.RejectSuggestion(ctx, suggestion.ID(), Editor{UserID: "admin-1", Role: "admin"}, "wrong restaurant")
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.SuggestionRejected, result.Status())
+	deps.restaurantRepo.AssertNotCalled(t, "FindByID")
+	deps.revisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestSuggestionService_RejectSuggestion_AlreadyReviewed(t *testing.T) {
+	deps := newTestSuggestionService()
+	ctx := context.Background()
+	suggestion := model.NewEditSuggestion(uuid.New(), "user-1", model.RestaurantEdit{NameJa: "一蘭"})
+	suggestion.Approve("admin-2", "")
+
+	deps.suggestionRepo.On("FindByID", ctx, suggestion.ID()).Return(suggestion, nil)
+
+	result, err := deps.service.RejectSuggestion(ctx, suggestion.ID(), Editor{UserID: "admin-1", Role: "admin"}, "")
+
+	assert.ErrorIs(t, err, domainerrors.ErrSuggestionNotPending)
+	assert.Nil(t, result)
+	deps.suggestionRepo.AssertNotCalled(t, "Update")
+}
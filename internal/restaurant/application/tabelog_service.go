@@ -0,0 +1,247 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	spiderv1 "github.com/Leon180/tabelogo-v2/api/gen/spider/v1"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application/converters"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/dedup"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DefaultTabelogAutoAttachScore is the match score above which a scraped
+// Tabelog listing is attached without user confirmation
+const DefaultTabelogAutoAttachScore = 0.85
+
+// tabelogSearchResults is how many listings the spider service returns per search
+const tabelogSearchResults = 10
+
+// TabelogMatchResult is the outcome of matching scraped listings to a restaurant
+type TabelogMatchResult struct {
+	Restaurant *model.Restaurant
+	Listings   []TabelogListing // every listing found, for the user to confirm one
+	Best       *TabelogListing  // best scoring listing, nil when none was found
+	Match      dedup.Match
+	Attached   bool // whether Best was attached to the restaurant
+}
+
+// TabelogService links restaurants to their Tabelog listing. Listings are
+// always loaded from the spider service, never taken from the caller.
+type TabelogService interface {
+	// MatchTabelogListings searches Tabelog for the restaurant through the
+	// spider service, scores the listings found and attaches the best one
+	// when it scores above the auto-attach threshold. A listing confirmed by
+	// a user is never replaced automatically.
+	MatchTabelogListings(ctx context.Context, restaurantID uuid.UUID) (*TabelogMatchResult, error)
+
+	// ConfirmTabelogListing attaches the listing at link, which must be one
+	// the spider service finds for the restaurant, as chosen by the user actorID
+	ConfirmTabelogListing(ctx context.Context, restaurantID uuid.UUID, link string, actorID string) (*model.Restaurant, error)
+
+	// DetachTabelogListing removes the restaurant's Tabelog listing
+	DetachTabelogListing(ctx context.Context, restaurantID uuid.UUID, actorID string) (*model.Restaurant, error)
+}
+
+type tabelogService struct {
+	restaurantRepo repository.RestaurantRepository
+	revisionRepo   repository.RevisionRepository
+	spiderClient   SpiderServiceClient
+	config         *Config
+	logger         *zap.Logger
+}
+
+// NewTabelogService creates a new Tabelog service
+func NewTabelogService(
+	restaurantRepo repository.RestaurantRepository,
+	revisionRepo repository.RevisionRepository,
+	spiderClient SpiderServiceClient,
+	config *Config,
+	logger *zap.Logger,
+) TabelogService {
+	return &tabelogService{
+		restaurantRepo: restaurantRepo,
+		revisionRepo:   revisionRepo,
+		spiderClient:   spiderClient,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+func (s *tabelogService) autoAttachScore() float64 {
+	if s.config == nil || s.config.TabelogAutoAttachScore <= 0 {
+		return DefaultTabelogAutoAttachScore
+	}
+	return s.config.TabelogAutoAttachScore
+}
+
+func (s *tabelogService) MatchTabelogListings(ctx context.Context, restaurantID uuid.UUID) (*TabelogMatchResult, error) {
+	restaurant, err := s.restaurantRepo.FindByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	listings, err := s.searchListings(ctx, restaurant)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TabelogMatchResult{Restaurant: restaurant, Listings: listings}
+	for i := range listings {
+		match := dedup.CompareListing(restaurant, listings[i].Name, listings[i].Phone)
+		if result.Best == nil || match.Score > result.Match.Score {
+			result.Best = &listings[i]
+			result.Match = match
+		}
+	}
+
+	if result.Best == nil || result.Match.Score < s.autoAttachScore() {
+		return result, nil
+	}
+	if current := restaurant.Tabelog(); current != nil && current.Confirmed() {
+		return result, nil
+	}
+
+	if err := s.attach(ctx, restaurant, *result.Best, result.Match.Score, ""); err != nil {
+		return nil, err
+	}
+	result.Attached = true
+
+	return result, nil
+}
+
+func (s *tabelogService) ConfirmTabelogListing(ctx context.Context, restaurantID uuid.UUID, link string, actorID string) (*model.Restaurant, error) {
+	restaurant, err := s.restaurantRepo.FindByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	listings, err := s.searchListings(ctx, restaurant)
+	if err != nil {
+		return nil, err
+	}
+	var listing *TabelogListing
+	for i := range listings {
+		if listings[i].Link == link {
+			listing = &listings[i]
+			break
+		}
+	}
+	if listing == nil {
+		return nil, domainerrors.ErrTabelogListingNotFound
+	}
+
+	match := dedup.CompareListing(restaurant, listing.Name, listing.Phone)
+	if err := s.attach(ctx, restaurant, *listing, match.Score, actorID); err != nil {
+		return nil, err
+	}
+
+	return restaurant, nil
+}
+
+func (s *tabelogService) DetachTabelogListing(ctx context.Context, restaurantID uuid.UUID, actorID string) (*model.Restaurant, error) {
+	restaurant, err := s.restaurantRepo.FindByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if restaurant.Tabelog() == nil {
+		return restaurant, nil
+	}
+
+	before := restaurant.TrackedFields()
+	restaurant.DetachTabelog()
+	if err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, model.RevisionSourceUserEdit, actorID); err != nil {
+		s.logger.Error("Failed to detach tabelog listing", zap.String("id", restaurantID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	return restaurant, nil
+}
+
+// searchListings loads the Tabelog listings of the restaurant from the spider
+// service, searching by its Japanese name when it has one
+func (s *tabelogService) searchListings(ctx context.Context, restaurant *model.Restaurant) ([]TabelogListing, error) {
+	req := &spiderv1.SearchSimilarRestaurantsRequest{
+		Area:        restaurant.Area(),
+		PlaceName:   restaurant.Name(),
+		PlaceNameJa: restaurant.NameJa(),
+		MaxResults:  tabelogSearchResults,
+	}
+	if restaurant.Source() == model.SourceGoogle {
+		req.GoogleId = restaurant.ExternalID()
+	}
+
+	results, err := s.spiderClient.SearchSimilarRestaurants(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to search tabelog listings", zap.String("id", restaurant.ID().String()), zap.Error(err))
+		return nil, err
+	}
+
+	listings := make([]TabelogListing, 0, len(results))
+	for _, r := range results {
+		listings = append(listings, TabelogListing{
+			Link:         r.Link,
+			Name:         r.Name,
+			Rating:       r.Rating,
+			RatingCount:  int(r.RatingCount),
+			Bookmarks:    int(r.Bookmarks),
+			Phone:        r.Phone,
+			Types:        r.Types,
+			LunchBudget:  r.LunchBudget,
+			DinnerBudget: r.DinnerBudget,
+		})
+	}
+	return listings, nil
+}
+
+// attach attaches a listing; it is user-confirmed when actorID is set and
+// recorded as a scrape otherwise
+func (s *tabelogService) attach(ctx context.Context, restaurant *model.Restaurant, listing TabelogListing, score float64, actorID string) error {
+	confirmed := actorID != ""
+	source := model.RevisionSourceScrape
+	if confirmed {
+		source = model.RevisionSourceUserEdit
+	}
+
+	profile, err := model.NewTabelogProfile(
+		listing.Link,
+		listing.Rating,
+		listing.RatingCount,
+		listing.Bookmarks,
+		listing.Types,
+		score,
+		confirmed,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domainerrors.ErrInvalidTabelogListing, err)
+	}
+
+	before := restaurant.TrackedFields()
+	restaurant.AttachTabelog(profile)
+	// Tabelog's yen budgets are more precise than a price level
+	if price := converters.TabelogBudgetToPrice(listing.LunchBudget, listing.DinnerBudget); price != nil {
+		restaurant.UpdatePrice(price)
+	}
+	restaurant.AddCuisines(model.Cuisines.MatchTabelogGenres(listing.Types)...)
+	if err := saveWithRevision(ctx, s.restaurantRepo, s.revisionRepo, restaurant, before, source, actorID); err != nil {
+		s.logger.Error("Failed to attach tabelog listing",
+			zap.String("id", restaurant.ID().String()),
+			zap.String("url", listing.Link),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.Info("Tabelog listing attached",
+		zap.String("id", restaurant.ID().String()),
+		zap.String("url", listing.Link),
+		zap.Float64("score", score),
+		zap.Bool("confirmed", confirmed),
+	)
+
+	return nil
+}
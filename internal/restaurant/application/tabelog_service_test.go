@@ -0,0 +1,319 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	spiderv1 "github.com/Leon180/tabelogo-v2/api/gen/spider/v1"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockSpiderServiceClient is a mock implementation of SpiderServiceClient
+type MockSpiderServiceClient struct {
+	mock.Mock
+}
+
+func (m *MockSpiderServiceClient) SearchSimilarRestaurants(ctx context.Context, req *spiderv1.SearchSimilarRestaurantsRequest) ([]*spiderv1.TabelogRestaurant, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*spiderv1.TabelogRestaurant), args.Error(1)
+}
+
+func newTestTabelogListing(link, name, phone string) *spiderv1.TabelogRestaurant {
+	return &spiderv1.TabelogRestaurant{
+		Link:        link,
+		Name:        name,
+		Rating:      3.52,
+		RatingCount: 1200,
+		Bookmarks:   45000,
+		Phone:       phone,
+		Types:       []string{"ラーメン"},
+	}
+}
+
+const (
+	testTabelogLink      = "https://tabelog.com/tokyo/A1303/A130301/13001234/"
+	testOtherTabelogLink = "https://tabelog.com/tokyo/A1303/A130301/13009999/"
+)
+
+// Test MatchTabelogListings
+func TestTabelogService_MatchTabelogListings_AutoAttach(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("一蘭 渋谷店", "+81 3-3463-3667", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.MatchedBy(func(req *spiderv1.SearchSimilarRestaurantsRequest) bool {
+		return req.GoogleId == restaurant.ExternalID() && req.PlaceName == restaurant.Name()
+	})).Return([]*spiderv1.TabelogRestaurant{
+		newTestTabelogListing(testOtherTabelogLink, "天ぷら 近藤", "03-1111-2222"),
+		newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "03-3463-3667"),
+	}, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.Source() == model.RevisionSourceScrape && r.ActorID() == ""
+	})).Return(nil)
+
+	result, err := service.MatchTabelogListings(ctx, restaurant.ID())
+
+	assert.NoError(t, err)
+	assert.True(t, result.Attached)
+	assert.Len(t, result.Listings, 2)
+	assert.Equal(t, "一蘭 渋谷店", result.Best.Name)
+	assert.NotNil(t, restaurant.Tabelog())
+	assert.Equal(t, 3.52, restaurant.Tabelog().Rating())
+	assert.Equal(t, 1200, restaurant.Tabelog().ReviewCount())
+	assert.Equal(t, []string{"ラーメン"}, restaurant.Tabelog().Genres())
+	assert.False(t, restaurant.Tabelog().Confirmed())
+	mockRepo.AssertExpectations(t)
+	mockSpider.AssertExpectations(t)
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestTabelogService_MatchTabelogListings_BelowThreshold(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("一蘭 渋谷店", "03-3463-3667", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{
+		newTestTabelogListing(testTabelogLink, "一蘭 新宿店", "03-9999-0000"),
+	}, nil)
+
+	result, err := service.MatchTabelogListings(ctx, restaurant.ID())
+
+	assert.NoError(t, err)
+	assert.False(t, result.Attached)
+	assert.NotNil(t, result.Best)
+	assert.Nil(t, restaurant.Tabelog())
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestTabelogService_MatchTabelogListings_KeepsConfirmed(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("一蘭 渋谷店", "03-3463-3667", model.SourceGoogle, 35.6595, 139.7005)
+	confirmed, _ := model.NewTabelogProfile("https://tabelog.com/tokyo/A1303/A130301/13005678/", 3.4, 10, 20, nil, 0.5, true)
+	restaurant.AttachTabelog(confirmed)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{
+		newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "03-3463-3667"),
+	}, nil)
+
+	result, err := service.MatchTabelogListings(ctx, restaurant.ID())
+
+	assert.NoError(t, err)
+	assert.False(t, result.Attached)
+	assert.Equal(t, confirmed, restaurant.Tabelog())
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestTabelogService_MatchTabelogListings_NoResults(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("一蘭", "", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{}, nil)
+
+	result, err := service.MatchTabelogListings(ctx, restaurant.ID())
+
+	assert.NoError(t, err)
+	assert.False(t, result.Attached)
+	assert.Nil(t, result.Best)
+	assert.Empty(t, result.Listings)
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestTabelogService_MatchTabelogListings_SpiderError(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("一蘭", "", model.SourceGoogle, 35.6595, 139.7005)
+	spiderErr := errors.New("spider service unavailable")
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return(nil, spiderErr)
+
+	result, err := service.MatchTabelogListings(ctx, restaurant.ID())
+
+	assert.ErrorIs(t, err, spiderErr)
+	assert.Nil(t, result)
+}
+
+// Test ConfirmTabelogListing
+func TestTabelogService_ConfirmTabelogListing_Success(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Shibuya", "", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{
+		newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "03-3463-3667"),
+		newTestTabelogListing(testOtherTabelogLink, "一蘭 新宿店", "03-9999-0000"),
+	}, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		return r.Source() == model.RevisionSourceUserEdit && r.ActorID() == "user-1"
+	})).Return(nil)
+
+	result, err := service.ConfirmTabelogListing(ctx, restaurant.ID(), testOtherTabelogLink, "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testOtherTabelogLink, result.Tabelog().URL())
+	assert.True(t, result.Tabelog().Confirmed())
+	mockRepo.AssertExpectations(t)
+	mockSpider.AssertExpectations(t)
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestTabelogService_ConfirmTabelogListing_NotFound(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Shibuya", "", model.SourceGoogle, 35.6595, 139.7005)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{
+		newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "03-3463-3667"),
+	}, nil)
+
+	result, err := service.ConfirmTabelogListing(ctx, restaurant.ID(), testOtherTabelogLink, "user-1")
+
+	assert.ErrorIs(t, err, domainerrors.ErrTabelogListingNotFound)
+	assert.Nil(t, result)
+	assert.Nil(t, restaurant.Tabelog())
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+func TestTabelogService_ConfirmTabelogListing_SetsPriceFromBudgets(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Shibuya", "", model.SourceGoogle, 35.6595, 139.7005)
+	restaurant.UpdatePriceRange("$")
+	listing := newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "03-3463-3667")
+	listing.LunchBudget = "～￥999"
+	listing.DinnerBudget = "￥3,000～￥3,999"
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{listing}, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.MatchedBy(func(r *model.RestaurantRevision) bool {
+		for _, change := range r.Changes() {
+			if change.Field == model.FieldPriceRange {
+				return change.Before == "$" && change.After == "$$"
+			}
+		}
+		return false
+	})).Return(nil)
+
+	result, err := service.ConfirmTabelogListing(ctx, restaurant.ID(), testTabelogLink, "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "$$", result.PriceRange())
+	assert.Equal(t, &model.PriceBand{Min: 0, Max: 999}, result.Price().Lunch())
+	assert.Equal(t, &model.PriceBand{Min: 3000, Max: 3999}, result.Price().Dinner())
+	assert.Equal(t, "[夜]￥3,000～￥3,999 [昼]～￥999", result.Price().Text())
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestTabelogService_ConfirmTabelogListing_AddsCuisinesFromGenres(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Shibuya", "", model.SourceGoogle, 35.6595, 139.7005)
+	restaurant.AddCuisines("japanese")
+	listing := newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "03-3463-3667")
+	listing.Types = []string{"ラーメン", "つけ麺", "デリカテッセン"}
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{listing}, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.Anything).Return(nil)
+
+	result, err := service.ConfirmTabelogListing(ctx, restaurant.ID(), testTabelogLink, "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"japanese", "ramen", "tsukemen"}, result.Cuisines())
+}
+
+func TestTabelogService_ConfirmTabelogListing_InvalidListing(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Shibuya", "", model.SourceGoogle, 35.6595, 139.7005)
+	listing := newTestTabelogListing(testTabelogLink, "一蘭 渋谷店", "")
+	listing.Rating = 7
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockSpider.On("SearchSimilarRestaurants", ctx, mock.Anything).Return([]*spiderv1.TabelogRestaurant{listing}, nil)
+
+	result, err := service.ConfirmTabelogListing(ctx, restaurant.ID(), testTabelogLink, "user-1")
+
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidTabelogListing)
+	assert.Nil(t, result)
+	mockRevisionRepo.AssertNotCalled(t, "UpdateWithRevision")
+}
+
+// Test DetachTabelogListing
+func TestTabelogService_DetachTabelogListing_Success(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	mockRevisionRepo := new(MockRevisionRepository)
+	mockSpider := new(MockSpiderServiceClient)
+	service := NewTabelogService(mockRepo, mockRevisionRepo, mockSpider, &Config{}, zap.NewNop())
+
+	ctx := context.Background()
+	restaurant := newTestRestaurant("Ichiran Shibuya", "", model.SourceGoogle, 35.6595, 139.7005)
+	profile, _ := model.NewTabelogProfile("https://tabelog.com/tokyo/A1303/A130301/13001234/", 3.5, 10, 20, nil, 0.9, false)
+	restaurant.AttachTabelog(profile)
+
+	mockRepo.On("FindByID", ctx, restaurant.ID()).Return(restaurant, nil)
+	mockRevisionRepo.On("UpdateWithRevision", ctx, restaurant, mock.AnythingOfType("*model.RestaurantRevision")).Return(nil)
+
+	result, err := service.DetachTabelogListing(ctx, restaurant.ID(), "user-1")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Tabelog())
+	mockRepo.AssertExpectations(t)
+	mockRevisionRepo.AssertExpectations(t)
+}
@@ -0,0 +1,387 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultVisitStatsMonths is how many months the monthly visit stats
+	// cover when no range is given
+	DefaultVisitStatsMonths = 12
+	// MaxVisitStatsMonths is the longest range of the monthly visit stats
+	MaxVisitStatsMonths = 120
+)
+
+// ListVisitsRequest selects a page of a user's visits
+type ListVisitsRequest struct {
+	RestaurantID *uuid.UUID
+	From         *time.Time
+	To           *time.Time
+	Limit        int
+	Offset       int
+}
+
+// VisitPage is a page of a user's visits with the number of all matches
+type VisitPage struct {
+	Visits []*model.Visit
+	Total  int64
+}
+
+// RevisitedPlace is a restaurant a user went back to, with how often
+type RevisitedPlace struct {
+	Restaurant    *model.Restaurant
+	Visits        int64
+	LastVisitedOn time.Time
+	AverageRating *float64
+}
+
+// VisitService keeps a user's visit history and the stats computed from it.
+// Every method takes the owner's ID; another user's visit is reported as not
+// found. The visit count and last visit of a favorite are derived from the
+// history and follow every change to it.
+type VisitService interface {
+	// LogVisit records a visit of the restaurant
+	LogVisit(ctx context.Context, userID, restaurantID uuid.UUID, details model.VisitDetails) (*model.Visit, error)
+
+	// LogFavoriteVisit records a visit today of one of the user's favorite
+	// restaurants and returns the updated favorite. It backs the favorite
+	// visit endpoints that predate the visit history.
+	LogFavoriteVisit(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error)
+
+	GetVisit(ctx context.Context, userID, visitID uuid.UUID) (*model.Visit, error)
+	ListVisits(ctx context.Context, userID uuid.UUID, req ListVisitsRequest) (*VisitPage, error)
+	UpdateVisit(ctx context.Context, userID, visitID uuid.UUID, details model.VisitDetails) (*model.Visit, error)
+	DeleteVisit(ctx context.Context, userID, visitID uuid.UUID) error
+
+	// GetMonthlyVisits counts the user's visits in each of the last months,
+	// oldest first and including months without visits
+	GetMonthlyVisits(ctx context.Context, userID uuid.UUID, months int) ([]*repository.MonthlyVisits, error)
+
+	// GetSpendByCuisine sums the user's recorded spend per cuisine and
+	// currency
+	GetSpendByCuisine(ctx context.Context, userID uuid.UUID) ([]*repository.CuisineSpend, error)
+
+	// GetMostRevisited lists the restaurants the user went back to most
+	GetMostRevisited(ctx context.Context, userID uuid.UUID, limit int) ([]*RevisitedPlace, error)
+}
+
+type visitService struct {
+	visitRepo      repository.VisitRepository
+	favoriteRepo   repository.FavoriteRepository
+	restaurantRepo repository.RestaurantRepository
+	popularity     repository.PopularityCounter
+	logger         *zap.Logger
+}
+
+// NewVisitService creates a new visit service
+func NewVisitService(
+	visitRepo repository.VisitRepository,
+	favoriteRepo repository.FavoriteRepository,
+	restaurantRepo repository.RestaurantRepository,
+	popularity repository.PopularityCounter,
+	logger *zap.Logger,
+) VisitService {
+	return &visitService{
+		visitRepo:      visitRepo,
+		favoriteRepo:   favoriteRepo,
+		restaurantRepo: restaurantRepo,
+		popularity:     popularity,
+		logger:         logger,
+	}
+}
+
+func (s *visitService) LogVisit(ctx context.Context, userID, restaurantID uuid.UUID, details model.VisitDetails) (*model.Visit, error) {
+	if err := validateVisitDetails(details, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.restaurantRepo.FindByID(ctx, restaurantID); err != nil {
+		return nil, err
+	}
+
+	visit := model.NewVisit(userID, restaurantID, details)
+	if err := s.visitRepo.Create(ctx, visit); err != nil {
+		s.logger.Error("Failed to log visit", zap.Error(err))
+		return nil, err
+	}
+
+	s.syncFavoriteVisits(ctx, userID, restaurantID)
+	recordPopularity(ctx, s.popularity, s.logger, restaurantID, model.PopularityEventVisit)
+
+	s.logger.Info("Visit logged",
+		zap.String("visit_id", visit.ID().String()),
+		zap.String("user_id", userID.String()),
+		zap.String("restaurant_id", restaurantID.String()),
+	)
+	return visit, nil
+}
+
+func (s *visitService) LogFavoriteVisit(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Favorite, error) {
+	favorite, err := s.favoriteRepo.FindByUserAndRestaurant(ctx, userID, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	visit := model.NewVisit(userID, restaurantID, model.VisitDetails{VisitedOn: time.Now()})
+	if err := s.visitRepo.Create(ctx, visit); err != nil {
+		s.logger.Error("Failed to log favorite visit", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.syncVisits(ctx, favorite); err != nil {
+		s.logger.Error("Failed to update favorite visit count", zap.Error(err))
+		return nil, err
+	}
+	recordPopularity(ctx, s.popularity, s.logger, restaurantID, model.PopularityEventVisit)
+
+	return favorite, nil
+}
+
+// syncFavoriteVisits recounts the visits of the user's favorite after its
+// history changed, if the restaurant is a favorite. The history is already
+// stored, so failures are only logged.
+func (s *visitService) syncFavoriteVisits(ctx context.Context, userID, restaurantID uuid.UUID) {
+	favorite, err := s.favoriteRepo.FindByUserAndRestaurant(ctx, userID, restaurantID)
+	if errors.Is(err, domainerrors.ErrFavoriteNotFound) {
+		return
+	}
+	if err != nil {
+		s.logger.Warn("Failed to find favorite for visit", zap.Error(err))
+		return
+	}
+
+	if err := s.syncVisits(ctx, favorite); err != nil {
+		s.logger.Warn("Failed to update favorite visit count", zap.Error(err))
+	}
+}
+
+// syncVisits sets the visit count and last visit of favorite from the history
+func (s *visitService) syncVisits(ctx context.Context, favorite *model.Favorite) error {
+	visits, err := s.visitRepo.CountByRestaurant(ctx, favorite.UserID(), favorite.RestaurantID())
+	if err != nil {
+		return err
+	}
+	favorite.SyncVisits(int(visits.Visits), visits.LastVisitedOn)
+	return s.favoriteRepo.Update(ctx, favorite)
+}
+
+func (s *visitService) GetVisit(ctx context.Context, userID, visitID uuid.UUID) (*model.Visit, error) {
+	return s.ownedVisit(ctx, userID, visitID)
+}
+
+func (s *visitService) ListVisits(ctx context.Context, userID uuid.UUID, req ListVisitsRequest) (*VisitPage, error) {
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	visits, total, err := s.visitRepo.FindByUser(ctx, repository.VisitQuery{
+		UserID:       userID,
+		RestaurantID: req.RestaurantID,
+		From:         req.From,
+		To:           req.To,
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list visits", zap.Error(err))
+		return nil, err
+	}
+
+	return &VisitPage{Visits: visits, Total: total}, nil
+}
+
+func (s *visitService) UpdateVisit(ctx context.Context, userID, visitID uuid.UUID, details model.VisitDetails) (*model.Visit, error) {
+	if err := validateVisitDetails(details, time.Now()); err != nil {
+		return nil, err
+	}
+
+	visit, err := s.ownedVisit(ctx, userID, visitID)
+	if err != nil {
+		return nil, err
+	}
+
+	visitedOn := visit.VisitedOn()
+	visit.UpdateDetails(details)
+	if err := s.visitRepo.Update(ctx, visit); err != nil {
+		s.logger.Error("Failed to update visit", zap.Error(err))
+		return nil, err
+	}
+
+	if !visit.VisitedOn().Equal(visitedOn) {
+		s.syncFavoriteVisits(ctx, userID, visit.RestaurantID())
+	}
+	return visit, nil
+}
+
+func (s *visitService) DeleteVisit(ctx context.Context, userID, visitID uuid.UUID) error {
+	visit, err := s.ownedVisit(ctx, userID, visitID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.visitRepo.Delete(ctx, visitID); err != nil {
+		s.logger.Error("Failed to delete visit", zap.Error(err))
+		return err
+	}
+
+	s.syncFavoriteVisits(ctx, userID, visit.RestaurantID())
+
+	s.logger.Info("Visit deleted", zap.String("visit_id", visitID.String()))
+	return nil
+}
+
+func (s *visitService) GetMonthlyVisits(ctx context.Context, userID uuid.UUID, months int) ([]*repository.MonthlyVisits, error) {
+	if months <= 0 {
+		months = DefaultVisitStatsMonths
+	}
+	if months > MaxVisitStatsMonths {
+		months = MaxVisitStatsMonths
+	}
+
+	now := time.Now().UTC()
+	since := time.Date(now.Year(), now.Month()-time.Month(months-1), 1, 0, 0, 0, 0, time.UTC)
+
+	counts, err := s.visitRepo.CountByMonth(ctx, userID, since)
+	if err != nil {
+		s.logger.Error("Failed to count visits by month", zap.Error(err))
+		return nil, err
+	}
+
+	return fillVisitMonths(counts, since, months), nil
+}
+
+// fillVisitMonths lays the counts out over the months from since, adding the
+// months without visits
+func fillVisitMonths(counts []*repository.MonthlyVisits, since time.Time, months int) []*repository.MonthlyVisits {
+	byMonth := make(map[string]*repository.MonthlyVisits, len(counts))
+	for _, c := range counts {
+		byMonth[c.Month.Format("2006-01")] = c
+	}
+
+	result := make([]*repository.MonthlyVisits, months)
+	for i := range result {
+		month := since.AddDate(0, i, 0)
+		if c, ok := byMonth[month.Format("2006-01")]; ok {
+			result[i] = &repository.MonthlyVisits{Month: month, Visits: c.Visits, Restaurants: c.Restaurants}
+			continue
+		}
+		result[i] = &repository.MonthlyVisits{Month: month}
+	}
+	return result
+}
+
+func (s *visitService) GetSpendByCuisine(ctx context.Context, userID uuid.UUID) ([]*repository.CuisineSpend, error) {
+	spend, err := s.visitRepo.SpendByCuisine(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to sum spend by cuisine", zap.Error(err))
+		return nil, err
+	}
+
+	return spend, nil
+}
+
+func (s *visitService) GetMostRevisited(ctx context.Context, userID uuid.UUID, limit int) ([]*RevisitedPlace, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	revisited, err := s.visitRepo.MostRevisited(ctx, userID, limit)
+	if err != nil {
+		s.logger.Error("Failed to find most revisited restaurants", zap.Error(err))
+		return nil, err
+	}
+	if len(revisited) == 0 {
+		return []*RevisitedPlace{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(revisited))
+	for i, r := range revisited {
+		ids[i] = r.RestaurantID
+	}
+	restaurants, err := s.restaurantRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("Failed to find revisited restaurants", zap.Error(err))
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*model.Restaurant, len(restaurants))
+	for _, r := range restaurants {
+		byID[r.ID()] = r
+	}
+
+	// Restaurants deleted since the visits are left out
+	places := make([]*RevisitedPlace, 0, len(revisited))
+	for _, r := range revisited {
+		if restaurant, ok := byID[r.RestaurantID]; ok {
+			places = append(places, &RevisitedPlace{
+				Restaurant:    restaurant,
+				Visits:        r.Visits,
+				LastVisitedOn: r.LastVisitedOn,
+				AverageRating: r.AverageRating,
+			})
+		}
+	}
+	return places, nil
+}
+
+// ownedVisit finds a visit of the user. Another user's visit is reported as
+// not found so its existence is not revealed.
+func (s *visitService) ownedVisit(ctx context.Context, userID, visitID uuid.UUID) (*model.Visit, error) {
+	visit, err := s.visitRepo.FindByID(ctx, visitID)
+	if err != nil {
+		return nil, err
+	}
+	if visit.UserID() != userID {
+		return nil, domainerrors.ErrVisitNotFound
+	}
+	return visit, nil
+}
+
+// validateVisitDetails checks the details against the limits of a visit. The
+// visit date may be today in any time zone, so one day of slack is allowed.
+func validateVisitDetails(details model.VisitDetails, now time.Time) error {
+	if details.VisitedOn.IsZero() || model.VisitDate(details.VisitedOn).After(now.AddDate(0, 0, 1)) {
+		return domainerrors.ErrInvalidVisitDate
+	}
+	if details.PartySize < 0 || details.PartySize > model.MaxVisitPartySize {
+		return domainerrors.ErrInvalidPartySize
+	}
+	if details.Rating != 0 && (details.Rating < model.MinVisitRating || details.Rating > model.MaxVisitRating) {
+		return domainerrors.ErrInvalidVisitRating
+	}
+	if details.Spend != nil && !model.NewMoney(details.Spend.Amount, details.Spend.Currency).IsValid() {
+		return domainerrors.ErrInvalidVisitSpend
+	}
+
+	if len(details.Dishes) > model.MaxVisitDishes {
+		return domainerrors.ErrInvalidVisitDishes
+	}
+	for _, dish := range details.Dishes {
+		if len([]rune(dish)) > model.MaxVisitDishLength {
+			return domainerrors.ErrInvalidVisitDishes
+		}
+	}
+
+	if len(details.PhotoURLs) > model.MaxVisitPhotos {
+		return domainerrors.ErrInvalidVisitPhotos
+	}
+	for _, raw := range details.PhotoURLs {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return domainerrors.ErrInvalidVisitPhotos
+		}
+	}
+	return nil
+}
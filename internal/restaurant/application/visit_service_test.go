@@ -0,0 +1,355 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+type visitServiceMocks struct {
+	visitRepo      *mocks.MockVisitRepository
+	favoriteRepo   *mocks.MockFavoriteRepository
+	restaurantRepo *mocks.MockRestaurantRepository
+	popularity     *mocks.MockPopularityCounter
+}
+
+func newTestVisitService(t *testing.T) (VisitService, visitServiceMocks) {
+	ctrl := gomock.NewController(t)
+	m := visitServiceMocks{
+		visitRepo:      mocks.NewMockVisitRepository(ctrl),
+		favoriteRepo:   mocks.NewMockFavoriteRepository(ctrl),
+		restaurantRepo: mocks.NewMockRestaurantRepository(ctrl),
+		popularity:     mocks.NewMockPopularityCounter(ctrl),
+	}
+	service := NewVisitService(m.visitRepo, m.favoriteRepo, m.restaurantRepo, m.popularity, zap.NewNop())
+	return service, m
+}
+
+// Test LogVisit
+func TestVisitService_LogVisit_Favorite(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurant := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+	favorite := model.NewFavorite(userID, restaurant.ID())
+	visitedOn := time.Date(2026, 3, 14, 19, 30, 0, 0, time.UTC)
+
+	m.restaurantRepo.EXPECT().FindByID(ctx, restaurant.ID()).Return(restaurant, nil)
+	m.visitRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurant.ID()).Return(favorite, nil)
+	lastVisitedOn := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	m.visitRepo.EXPECT().CountByRestaurant(ctx, userID, restaurant.ID()).
+		Return(&repository.RestaurantVisits{Visits: 1, LastVisitedOn: &lastVisitedOn}, nil)
+	m.favoriteRepo.EXPECT().Update(ctx, favorite).Return(nil)
+	m.popularity.EXPECT().Incr(ctx, restaurant.ID(), model.PopularityEventVisit, gomock.Any()).Return(nil)
+
+	visit, err := service.LogVisit(ctx, userID, restaurant.ID(), model.VisitDetails{
+		VisitedOn: visitedOn,
+		PartySize: 2,
+		Rating:    4,
+		Dishes:    []string{" Tonkotsu ", ""},
+		Spend:     &model.Money{Amount: 3200, Currency: "jpy"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), visit.VisitedOn())
+	assert.Equal(t, []string{"Tonkotsu"}, visit.Dishes())
+	assert.Equal(t, "JPY", visit.Spend().Currency)
+	assert.Equal(t, 1, favorite.VisitCount())
+	require.NotNil(t, favorite.LastVisitedAt())
+	assert.Equal(t, visit.VisitedOn(), *favorite.LastVisitedAt())
+}
+
+func TestVisitService_LogVisit_NotFavorite(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurant := newCatalogRestaurant("Afuri", "Ebisu", "Ramen", "$$", 4.3)
+
+	m.restaurantRepo.EXPECT().FindByID(ctx, restaurant.ID()).Return(restaurant, nil)
+	m.visitRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurant.ID()).Return(nil, domainerrors.ErrFavoriteNotFound)
+	m.popularity.EXPECT().Incr(ctx, restaurant.ID(), model.PopularityEventVisit, gomock.Any()).Return(nil)
+
+	visit, err := service.LogVisit(ctx, userID, restaurant.ID(), model.VisitDetails{VisitedOn: time.Now()})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, visit.PartySize())
+	assert.False(t, visit.IsRated())
+}
+
+func TestVisitService_LogVisit_RestaurantNotFound(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	restaurantID := uuid.New()
+
+	m.restaurantRepo.EXPECT().FindByID(ctx, restaurantID).Return(nil, domainerrors.ErrRestaurantNotFound)
+
+	_, err := service.LogVisit(ctx, uuid.New(), restaurantID, model.VisitDetails{VisitedOn: time.Now()})
+	assert.ErrorIs(t, err, domainerrors.ErrRestaurantNotFound)
+}
+
+func TestVisitService_LogVisit_InvalidDetails(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		details model.VisitDetails
+		want    error
+	}{
+		{"missing date", model.VisitDetails{}, domainerrors.ErrInvalidVisitDate},
+		{"future date", model.VisitDetails{VisitedOn: now.AddDate(0, 0, 3)}, domainerrors.ErrInvalidVisitDate},
+		{"negative party", model.VisitDetails{VisitedOn: now, PartySize: -1}, domainerrors.ErrInvalidPartySize},
+		{"party too large", model.VisitDetails{VisitedOn: now, PartySize: 101}, domainerrors.ErrInvalidPartySize},
+		{"rating too high", model.VisitDetails{VisitedOn: now, Rating: 6}, domainerrors.ErrInvalidVisitRating},
+		{"negative spend", model.VisitDetails{VisitedOn: now, Spend: &model.Money{Amount: -1, Currency: "JPY"}}, domainerrors.ErrInvalidVisitSpend},
+		{"bad currency", model.VisitDetails{VisitedOn: now, Spend: &model.Money{Amount: 10, Currency: "¥"}}, domainerrors.ErrInvalidVisitSpend},
+		{"bad photo", model.VisitDetails{VisitedOn: now, PhotoURLs: []string{"file:///etc/passwd"}}, domainerrors.ErrInvalidVisitPhotos},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newTestVisitService(t)
+
+			_, err := service.LogVisit(context.Background(), uuid.New(), uuid.New(), tt.details)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+// Test LogFavoriteVisit
+func TestVisitService_LogFavoriteVisit(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+	favorite := model.NewFavorite(userID, restaurantID)
+	today := model.VisitDate(time.Now())
+
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(favorite, nil)
+	m.visitRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, visit *model.Visit) error {
+		assert.Equal(t, today, visit.VisitedOn())
+		return nil
+	})
+	m.visitRepo.EXPECT().CountByRestaurant(ctx, userID, restaurantID).
+		Return(&repository.RestaurantVisits{Visits: 3, LastVisitedOn: &today}, nil)
+	m.favoriteRepo.EXPECT().Update(ctx, favorite).Return(nil)
+	// A failed count does not fail the visit
+	m.popularity.EXPECT().Incr(ctx, restaurantID, model.PopularityEventVisit, gomock.Any()).Return(assert.AnError)
+
+	updated, err := service.LogFavoriteVisit(ctx, userID, restaurantID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, updated.VisitCount(), "counts the whole history")
+	require.NotNil(t, updated.LastVisitedAt())
+	assert.Equal(t, today, *updated.LastVisitedAt())
+}
+
+func TestVisitService_LogFavoriteVisit_NotFavorite(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(nil, domainerrors.ErrFavoriteNotFound)
+
+	favorite, err := service.LogFavoriteVisit(ctx, userID, restaurantID)
+
+	assert.ErrorIs(t, err, domainerrors.ErrFavoriteNotFound)
+	assert.Nil(t, favorite)
+}
+
+func TestVisitService_LogFavoriteVisit_UpdateError(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+	favorite := model.NewFavorite(userID, restaurantID)
+
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(favorite, nil)
+	m.visitRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	m.visitRepo.EXPECT().CountByRestaurant(ctx, userID, restaurantID).Return(&repository.RestaurantVisits{Visits: 1}, nil)
+	m.favoriteRepo.EXPECT().Update(ctx, favorite).Return(assert.AnError)
+
+	updated, err := service.LogFavoriteVisit(ctx, userID, restaurantID)
+
+	assert.Error(t, err)
+	assert.Nil(t, updated)
+}
+
+// Test UpdateVisit
+func TestVisitService_UpdateVisit(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	visit := model.NewVisit(userID, uuid.New(), model.VisitDetails{
+		VisitedOn: time.Now(),
+		Rating:    3,
+		Spend:     &model.Money{Amount: 1000, Currency: "JPY"},
+	})
+
+	m.visitRepo.EXPECT().FindByID(ctx, visit.ID()).Return(visit, nil)
+	m.visitRepo.EXPECT().Update(ctx, visit).Return(nil)
+
+	updated, err := service.UpdateVisit(ctx, userID, visit.ID(), model.VisitDetails{VisitedOn: time.Now(), PartySize: 4})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, updated.PartySize())
+	assert.False(t, updated.IsRated())
+	assert.Nil(t, updated.Spend())
+}
+
+func TestVisitService_UpdateVisit_OtherUser(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	visit := model.NewVisit(uuid.New(), uuid.New(), model.VisitDetails{VisitedOn: time.Now()})
+
+	m.visitRepo.EXPECT().FindByID(ctx, visit.ID()).Return(visit, nil)
+
+	_, err := service.UpdateVisit(ctx, uuid.New(), visit.ID(), model.VisitDetails{VisitedOn: time.Now()})
+	assert.ErrorIs(t, err, domainerrors.ErrVisitNotFound)
+}
+
+func TestVisitService_UpdateVisit_DateChangeRecountsFavorite(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+	visit := model.NewVisit(userID, restaurantID, model.VisitDetails{VisitedOn: time.Now()})
+	favorite := model.NewFavorite(userID, restaurantID)
+	earlier := model.VisitDate(time.Now().AddDate(0, -1, 0))
+
+	m.visitRepo.EXPECT().FindByID(ctx, visit.ID()).Return(visit, nil)
+	m.visitRepo.EXPECT().Update(ctx, visit).Return(nil)
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(favorite, nil)
+	m.visitRepo.EXPECT().CountByRestaurant(ctx, userID, restaurantID).
+		Return(&repository.RestaurantVisits{Visits: 1, LastVisitedOn: &earlier}, nil)
+	m.favoriteRepo.EXPECT().Update(ctx, favorite).Return(nil)
+
+	_, err := service.UpdateVisit(ctx, userID, visit.ID(), model.VisitDetails{VisitedOn: earlier})
+
+	require.NoError(t, err)
+	assert.Equal(t, earlier, *favorite.LastVisitedAt())
+}
+
+// Test DeleteVisit
+func TestVisitService_DeleteVisit_RecountsFavorite(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	restaurantID := uuid.New()
+	visit := model.NewVisit(userID, restaurantID, model.VisitDetails{VisitedOn: time.Now()})
+	favorite := model.NewFavorite(userID, restaurantID)
+	favorite.AddVisit()
+
+	m.visitRepo.EXPECT().FindByID(ctx, visit.ID()).Return(visit, nil)
+	m.visitRepo.EXPECT().Delete(ctx, visit.ID()).Return(nil)
+	m.favoriteRepo.EXPECT().FindByUserAndRestaurant(ctx, userID, restaurantID).Return(favorite, nil)
+	m.visitRepo.EXPECT().CountByRestaurant(ctx, userID, restaurantID).
+		Return(&repository.RestaurantVisits{}, nil)
+	m.favoriteRepo.EXPECT().Update(ctx, favorite).Return(nil)
+
+	err := service.DeleteVisit(ctx, userID, visit.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, favorite.VisitCount())
+	assert.Nil(t, favorite.LastVisitedAt())
+}
+
+func TestVisitService_DeleteVisit_OtherUser(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	visit := model.NewVisit(uuid.New(), uuid.New(), model.VisitDetails{VisitedOn: time.Now()})
+
+	m.visitRepo.EXPECT().FindByID(ctx, visit.ID()).Return(visit, nil)
+
+	err := service.DeleteVisit(ctx, uuid.New(), visit.ID())
+	assert.ErrorIs(t, err, domainerrors.ErrVisitNotFound)
+}
+
+// Test ListVisits
+func TestVisitService_ListVisits_DefaultLimit(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.visitRepo.EXPECT().FindByUser(ctx, repository.VisitQuery{UserID: userID, Limit: 20}).Return([]*model.Visit{}, int64(0), nil)
+
+	page, err := service.ListVisits(ctx, userID, ListVisitsRequest{Offset: -5})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Visits)
+	assert.Zero(t, page.Total)
+}
+
+// Test GetMonthlyVisits
+func TestVisitService_GetMonthlyVisits_FillsEmptyMonths(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	now := time.Now().UTC()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	since := thisMonth.AddDate(0, -2, 0)
+
+	m.visitRepo.EXPECT().CountByMonth(ctx, userID, since).Return([]*repository.MonthlyVisits{
+		{Month: thisMonth, Visits: 5, Restaurants: 3},
+	}, nil)
+
+	months, err := service.GetMonthlyVisits(ctx, userID, 3)
+
+	require.NoError(t, err)
+	require.Len(t, months, 3)
+	assert.Equal(t, since, months[0].Month)
+	assert.Zero(t, months[0].Visits)
+	assert.Zero(t, months[1].Visits)
+	assert.Equal(t, thisMonth, months[2].Month)
+	assert.Equal(t, int64(5), months[2].Visits)
+	assert.Equal(t, int64(3), months[2].Restaurants)
+}
+
+// Test GetMostRevisited
+func TestVisitService_GetMostRevisited(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	ichiran := newCatalogRestaurant("Ichiran", "Shibuya", "Ramen", "$$", 4.1)
+	rating := 4.5
+
+	deletedID := uuid.New()
+	m.visitRepo.EXPECT().MostRevisited(ctx, userID, 10).Return([]*repository.RevisitedRestaurant{
+		{RestaurantID: ichiran.ID(), Visits: 4, AverageRating: &rating},
+		{RestaurantID: deletedID, Visits: 2},
+	}, nil)
+	m.restaurantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{ichiran.ID(), deletedID}).Return([]*model.Restaurant{ichiran}, nil)
+
+	places, err := service.GetMostRevisited(ctx, userID, 0)
+
+	require.NoError(t, err)
+	require.Len(t, places, 1)
+	assert.Equal(t, ichiran, places[0].Restaurant)
+	assert.Equal(t, int64(4), places[0].Visits)
+	assert.Equal(t, &rating, places[0].AverageRating)
+}
+
+func TestVisitService_GetSpendByCuisine_Error(t *testing.T) {
+	service, m := newTestVisitService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	dbErr := errors.New("connection refused")
+
+	m.visitRepo.EXPECT().SpendByCuisine(ctx, userID).Return(nil, dbErr)
+
+	_, err := service.GetSpendByCuisine(ctx, userID)
+	assert.ErrorIs(t, err, dbErr)
+}
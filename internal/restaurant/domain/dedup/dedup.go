@@ -0,0 +1,210 @@
+// Package dedup scores how likely two restaurant records describe the same
+// place. It is used to find the same shop imported from several sources
+// (e.g. Google and Tabelog), which are stored as separate rows because
+// restaurants are keyed by (source, external_id).
+//
+// Three signals are combined: name similarity (name and name_ja, compared
+// after kana/width normalization and in romaji), phone equality after
+// normalizing to the domestic Japanese format, and location proximity.
+// Signals missing on either side are left out of the weighted average.
+package dedup
+
+import (
+	"strings"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/textnorm"
+)
+
+const (
+	// Weights of the signals in the score
+	NameWeight     = 0.5
+	PhoneWeight    = 0.3
+	LocationWeight = 0.2
+
+	// MaxDistanceKm is the distance at which the location signal drops to zero.
+	// Duplicate records of one shop are usually geocoded within a few dozen meters.
+	MaxDistanceKm = 0.3
+
+	// DefaultMinScore is the score above which a pair is reported as a candidate
+	DefaultMinScore = 0.6
+)
+
+// Match is the comparison of two restaurants. Phone and Location are nil when
+// the signal is missing on either side; Score is the weighted average of the
+// available signals in [0, 1].
+type Match struct {
+	Name       float64
+	Phone      *float64
+	Location   *float64
+	DistanceKm *float64
+	Score      float64
+}
+
+// Compare scores two restaurants as potential duplicates
+func Compare(a, b *model.Restaurant) Match {
+	m := Match{Name: NameSimilarity(names(a), names(b))}
+
+	total := NameWeight * m.Name
+	weights := NameWeight
+
+	pa, pb := NormalizePhone(a.Phone()), NormalizePhone(b.Phone())
+	if pa != "" && pb != "" {
+		phone := 0.0
+		if pa == pb {
+			phone = 1
+		}
+		m.Phone = &phone
+		total += PhoneWeight * phone
+		weights += PhoneWeight
+	}
+
+	if a.Location() != nil && b.Location() != nil {
+		distance := a.Location().DistanceTo(b.Location())
+		location := 1 - distance/MaxDistanceKm
+		if location < 0 {
+			location = 0
+		}
+		m.DistanceKm = &distance
+		m.Location = &location
+		total += LocationWeight * location
+		weights += LocationWeight
+	}
+
+	m.Score = total / weights
+	return m
+}
+
+// CompareListing scores a scraped listing (e.g. a Tabelog search result)
+// against a restaurant. Listings carry no coordinates, so only the name and
+// phone signals are used.
+func CompareListing(r *model.Restaurant, name, phone string) Match {
+	var listingNames []string
+	if name != "" {
+		listingNames = append(listingNames, name)
+	}
+	m := Match{Name: NameSimilarity(names(r), listingNames)}
+
+	total := NameWeight * m.Name
+	weights := NameWeight
+
+	pr, pl := NormalizePhone(r.Phone()), NormalizePhone(phone)
+	if pr != "" && pl != "" {
+		phoneScore := 0.0
+		if pr == pl {
+			phoneScore = 1
+		}
+		m.Phone = &phoneScore
+		total += PhoneWeight * phoneScore
+		weights += PhoneWeight
+	}
+
+	m.Score = total / weights
+	return m
+}
+
+func names(r *model.Restaurant) []string {
+	var out []string
+	for _, name := range []string{r.Name(), r.NameJa()} {
+		if name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// NameSimilarity returns the best similarity between any name of a and any
+// name of b. Names are compared as written and as romaji readings, so
+// "ラーメン一郎" matches "らーめん一郎" and "Ramen Ichiro" partially.
+func NameSimilarity(a, b []string) float64 {
+	best := 0.0
+	for _, x := range nameVariants(a) {
+		for _, y := range nameVariants(b) {
+			if s := dice(x, y); s > best {
+				best = s
+			}
+		}
+	}
+	return best
+}
+
+// nameVariants returns the normalized names without spaces and their romaji readings
+func nameVariants(names []string) []string {
+	var out []string
+	for _, name := range names {
+		normalized := strings.ReplaceAll(textnorm.Normalize(name), " ", "")
+		if normalized == "" {
+			continue
+		}
+		out = append(out, normalized)
+		if romaji := textnorm.ToRomaji(normalized); romaji != normalized {
+			out = append(out, romaji)
+		}
+	}
+	return out
+}
+
+// dice is the Sørensen–Dice coefficient of the character bigrams of a and b
+func dice(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ba, bb := bigrams(a), bigrams(b)
+	if len(ba) == 0 || len(bb) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(ba))
+	for _, g := range ba {
+		counts[g]++
+	}
+	shared := 0
+	for _, g := range bb {
+		if counts[g] > 0 {
+			counts[g]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ba)+len(bb))
+}
+
+func bigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return nil
+	}
+	out := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		out = append(out, string(runes[i:i+2]))
+	}
+	return out
+}
+
+// NormalizePhone reduces a phone number to its digits in the domestic
+// Japanese format: "+81 3-1234-5678", "+81 (0)3 1234 5678" and "03-1234-5678"
+// all become "0312345678"; full-width digits are folded first. It returns ""
+// when there are too few digits to identify a number.
+func NormalizePhone(phone string) string {
+	phone = textnorm.Normalize(phone)
+
+	var b strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	digits := b.String()
+
+	international := strings.HasPrefix(phone, "+") || strings.HasPrefix(digits, "0081")
+	if international {
+		digits = strings.TrimPrefix(digits, "00")
+		if strings.HasPrefix(digits, "81") {
+			digits = "0" + strings.TrimLeft(digits[2:], "0")
+		}
+	}
+
+	if len(digits) < 9 {
+		return ""
+	}
+	return digits
+}
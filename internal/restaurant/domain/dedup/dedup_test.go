@@ -0,0 +1,98 @@
+package dedup
+
+import (
+	"testing"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name  string
+		phone string
+		want  string
+	}{
+		{"domestic", "03-1234-5678", "0312345678"},
+		{"international", "+81 3-1234-5678", "0312345678"},
+		{"international with trunk prefix", "+81 (0)3 1234 5678", "0312345678"},
+		{"international dialing prefix", "0081-3-1234-5678", "0312345678"},
+		{"full-width", "０３－１２３４－５６７８", "0312345678"},
+		{"mobile", "090-1234-5678", "09012345678"},
+		{"too short", "1234", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizePhone(tt.phone))
+		})
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, NameSimilarity([]string{"ラーメン一郎"}, []string{"らーめん 一郎"}))
+	assert.Equal(t, 1.0, NameSimilarity([]string{"Ichiran"}, []string{"ICHIRAN"}))
+	assert.Greater(t, NameSimilarity([]string{"Ramen Ichiro", "らーめん一郎"}, []string{"ラーメン一郎 渋谷店"}), 0.6)
+	assert.Less(t, NameSimilarity([]string{"Sushi Dai"}, []string{"Tempura Kondo"}), 0.3)
+	assert.Equal(t, 0.0, NameSimilarity(nil, []string{"Ichiran"}))
+}
+
+func TestCompare_SameShopAcrossSources(t *testing.T) {
+	loc1, _ := model.NewLocation(35.6595, 139.7005)
+	loc2, _ := model.NewLocation(35.6596, 139.7006)
+	google := model.NewRestaurantWithDetails("Ichiran Shibuya", "Tokyo", model.SourceGoogle, "g1", "", loc1, 0, "", "", "+81 3-3463-3667", "", nil, nil)
+	tabelog := model.NewRestaurantWithDetails("一蘭 渋谷店", "Tokyo", model.SourceTabelog, "t1", "", loc2, 0, "", "", "03-3463-3667", "", nil, nil)
+	tabelog.UpdateNameJa("一蘭 渋谷店")
+	google.UpdateNameJa("一蘭 渋谷")
+
+	m := Compare(google, tabelog)
+
+	assert.Equal(t, 1.0, *m.Phone)
+	assert.Greater(t, *m.Location, 0.9)
+	assert.Greater(t, m.Score, DefaultMinScore)
+}
+
+func TestCompare_DifferentShops(t *testing.T) {
+	loc1, _ := model.NewLocation(35.6595, 139.7005)
+	loc2, _ := model.NewLocation(35.6700, 139.7100)
+	a := model.NewRestaurantWithDetails("Sushi Dai", "Tokyo", model.SourceGoogle, "g1", "", loc1, 0, "", "", "03-1111-2222", "", nil, nil)
+	b := model.NewRestaurantWithDetails("Tempura Kondo", "Tokyo", model.SourceTabelog, "t1", "", loc2, 0, "", "", "03-3333-4444", "", nil, nil)
+
+	m := Compare(a, b)
+
+	assert.Equal(t, 0.0, *m.Phone)
+	assert.Equal(t, 0.0, *m.Location)
+	assert.Less(t, m.Score, DefaultMinScore)
+}
+
+func TestCompare_MissingSignals(t *testing.T) {
+	a := model.NewRestaurant("Ichiran", "Tokyo", model.SourceGoogle, "g1", "", nil)
+	b := model.NewRestaurant("ICHIRAN", "Tokyo", model.SourceTabelog, "t1", "", nil)
+
+	m := Compare(a, b)
+
+	assert.Nil(t, m.Phone)
+	assert.Nil(t, m.Location)
+	assert.Nil(t, m.DistanceKm)
+	assert.Equal(t, 1.0, m.Score)
+}
+
+func TestCompareListing(t *testing.T) {
+	loc, _ := model.NewLocation(35.6595, 139.7005)
+	r := model.NewRestaurantWithDetails("Ichiran Shibuya", "Tokyo", model.SourceGoogle, "g1", "", loc, 0, "", "", "+81 3-3463-3667", "", nil, nil)
+	r.UpdateNameJa("一蘭 渋谷店")
+
+	m := CompareListing(r, "一蘭 渋谷店", "03-3463-3667")
+	assert.Equal(t, 1.0, *m.Phone)
+	assert.Nil(t, m.Location)
+	assert.Equal(t, 1.0, m.Score)
+
+	m = CompareListing(r, "一蘭 渋谷店", "")
+	assert.Nil(t, m.Phone)
+	assert.Equal(t, 1.0, m.Score)
+
+	m = CompareListing(r, "天ぷら 近藤", "03-1111-2222")
+	assert.Equal(t, 0.0, *m.Phone)
+	assert.Less(t, m.Score, DefaultMinScore)
+}
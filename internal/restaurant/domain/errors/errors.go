@@ -8,10 +8,85 @@ var (
 	ErrRestaurantAlreadyExists = errors.New("restaurant already exists")
 	ErrInvalidLocation         = errors.New("invalid location coordinates")
 	ErrInvalidRating           = errors.New("invalid rating value")
+	ErrInvalidRadius           = errors.New("search radius must be positive")
+	ErrInvalidPriceLevel       = errors.New("price level must be between 1 and 4")
+	ErrInvalidBudget           = errors.New("budget must not be negative, min must not exceed max and meal must be lunch or dinner")
+	ErrUnsupportedCurrency     = errors.New("currency is not in the rate table")
+	ErrInvalidSort             = errors.New("invalid sort option")
+	ErrUnknownCuisine          = errors.New("unknown cuisine category")
+	ErrInvalidCursor           = errors.New("invalid pagination cursor")
+	ErrUnsupportedLanguage     = errors.New("localized content language must be ja or zh-TW")
+	ErrRestaurantNotDeleted    = errors.New("restaurant is not deleted")
+	ErrRestaurantMerged        = errors.New("restaurant is merged into another restaurant; undo the merge instead")
+	ErrRestaurantReplaced      = errors.New("another restaurant has the same source and external ID; merge the restaurants instead")
+
+	// Merge errors
+	ErrMergeNotFound      = errors.New("restaurant merge not found")
+	ErrMergeSelf          = errors.New("cannot merge a restaurant into itself")
+	ErrMergeAlreadyUndone = errors.New("restaurant merge already undone")
+	ErrInvalidMinScore    = errors.New("min score must be between 0 and 1")
+
+	// Tabelog errors
+	ErrInvalidTabelogListing  = errors.New("invalid tabelog listing")
+	ErrTabelogListingNotFound = errors.New("tabelog listing not found for the restaurant")
+
+	// Revision errors
+	ErrRevisionNotFound = errors.New("restaurant revision not found")
+
+	// Suggestion errors
+	ErrSuggestionNotFound   = errors.New("edit suggestion not found")
+	ErrSuggestionNotPending = errors.New("edit suggestion was already reviewed")
+	ErrEmptyEdit            = errors.New("edit does not change any field")
+	ErrNotModerator         = errors.New("user cannot moderate edit suggestions")
+	ErrOwnSuggestion        = errors.New("cannot review own edit suggestion")
+
+	// Photo errors
+	ErrPhotoNotFound         = errors.New("photo not found")
+	ErrPhotoAlreadyExists    = errors.New("photo already ingested")
+	ErrInvalidPhotoURL       = errors.New("invalid photo URL")
+	ErrInvalidPhotoSignature = errors.New("invalid or expired photo signature")
+	ErrBlobNotFound          = errors.New("blob not found")
+
+	// Catalog errors
+	ErrInvalidCatalogFormat = errors.New("catalog format must be csv, jsonl or geojson")
+	ErrInvalidImportFile    = errors.New("invalid import file")
+	ErrBatchTooLarge        = errors.New("too many items in batch")
+
+	// Refresh errors
+	ErrRefreshInProgress = errors.New("a refresh run is already in progress")
 
 	// Favorite errors
 	ErrFavoriteNotFound      = errors.New("favorite not found")
 	ErrFavoriteAlreadyExists = errors.New("favorite already exists")
 	ErrInvalidUserID         = errors.New("invalid user ID")
 	ErrInvalidRestaurantID   = errors.New("invalid restaurant ID")
+
+	// Visit errors
+	ErrVisitNotFound      = errors.New("visit not found")
+	ErrInvalidVisitDate   = errors.New("visit date must be set and not in the future")
+	ErrInvalidPartySize   = errors.New("party size must be between 1 and 100")
+	ErrInvalidVisitRating = errors.New("rating must be between 1 and 5")
+	ErrInvalidVisitSpend  = errors.New("spend must not be negative and needs a 3-letter currency code")
+	ErrInvalidVisitDishes = errors.New("at most 50 dishes of up to 200 characters")
+	ErrInvalidVisitPhotos = errors.New("at most 10 photos with http or https URLs")
+
+	// Review errors
+	ErrReviewNotFound        = errors.New("review not found")
+	ErrReviewAlreadyExists   = errors.New("restaurant already reviewed by this user")
+	ErrInvalidReviewScore    = errors.New("score must be between 1 and 5")
+	ErrReviewTooLong         = errors.New("review text is too long")
+	ErrInvalidReviewLanguage = errors.New("language must be a BCP 47 tag such as ja or en-US")
+	ErrInvalidReviewStatus   = errors.New("invalid review status")
+	ErrInvalidReviewSort     = errors.New("invalid review sort")
+	ErrCannotVoteOwnReview   = errors.New("cannot vote on own review")
+	ErrVoteNotFound          = errors.New("helpful vote not found")
+
+	// Collection errors
+	ErrCollectionNotFound      = errors.New("collection not found")
+	ErrCollectionAlreadyExists = errors.New("a collection with this name already exists")
+	ErrInvalidCollectionName   = errors.New("collection name must be 1 to 100 characters")
+	ErrNotInCollection         = errors.New("favorite is not in the collection")
+	ErrInvalidCollectionOrder  = errors.New("order must list every favorite in the collection once")
+	ErrCollectionNotShared     = errors.New("collection is not shared")
+	ErrSharedListNotFound      = errors.New("shared list not found")
 )
@@ -0,0 +1,124 @@
+// Package imaging inspects restaurant photos and renders their thumbnails.
+// It only uses the standard library codecs, so JPEG, PNG and GIF images are
+// supported; the Places API and Tabelog serve JPEG.
+//
+// Thumbnails are scaled down with a box filter, averaging the source pixels
+// covered by each thumbnail pixel, and always encoded as JPEG.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+
+	// Registered for image.Decode
+	_ "image/gif"
+	_ "image/png"
+)
+
+const (
+	// maxPixels rejects images that would take too much memory to decode
+	maxPixels = 40_000_000
+
+	thumbnailQuality = 80
+)
+
+// ErrUnsupportedImage is returned for data that is not a supported image
+var ErrUnsupportedImage = errors.New("unsupported or corrupt image")
+
+// Info describes an encoded image
+type Info struct {
+	Width       int
+	Height      int
+	ContentType string
+}
+
+// Inspect reads the format and dimensions of an encoded image without
+// decoding its pixels
+func Inspect(data []byte) (Info, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width <= 0 || cfg.Height <= 0 {
+		return Info{}, ErrUnsupportedImage
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return Info{}, errors.New("image is too large to process")
+	}
+
+	return Info{
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+		ContentType: "image/" + format,
+	}, nil
+}
+
+// Thumbnail renders a JPEG no wider than maxWidth, keeping the aspect ratio.
+// Images that are already narrow enough are re-encoded at their own size.
+func Thumbnail(data []byte, maxWidth int) ([]byte, error) {
+	if maxWidth <= 0 {
+		return nil, errors.New("thumbnail width must be positive")
+	}
+	if _, err := Inspect(data); err != nil {
+		return nil, err
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxWidth {
+		height = max(1, height*maxWidth/width)
+		width = maxWidth
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, scale(src, width, height), &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// scale resizes src to width x height with a box filter
+func scale(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	if width == bounds.Dx() && height == bounds.Dy() {
+		return rgba
+	}
+
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := y * srcH / height
+		y1 := max(y0+1, (y+1)*srcH/height)
+		for x := 0; x < width; x++ {
+			x0 := x * srcW / width
+			x1 := max(x0+1, (x+1)*srcW/width)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += int(p[0])
+					g += int(p[1])
+					b += int(p[2])
+					a += int(p[3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
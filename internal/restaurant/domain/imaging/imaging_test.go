@@ -0,0 +1,62 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestInspect(t *testing.T) {
+	info, err := Inspect(encodePNG(t, 64, 48))
+
+	require.NoError(t, err)
+	assert.Equal(t, Info{Width: 64, Height: 48, ContentType: "image/png"}, info)
+
+	_, err = Inspect([]byte("<html>not an image</html>"))
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+}
+
+func TestThumbnail_ScalesDownToWidth(t *testing.T) {
+	thumb, err := Thumbnail(encodePNG(t, 200, 100), 50)
+	require.NoError(t, err)
+
+	info, err := Inspect(thumb)
+	require.NoError(t, err)
+	assert.Equal(t, Info{Width: 50, Height: 25, ContentType: "image/jpeg"}, info)
+}
+
+func TestThumbnail_KeepsSmallImages(t *testing.T) {
+	thumb, err := Thumbnail(encodePNG(t, 40, 30), 320)
+	require.NoError(t, err)
+
+	info, err := Inspect(thumb)
+	require.NoError(t, err)
+	assert.Equal(t, 40, info.Width)
+	assert.Equal(t, 30, info.Height)
+}
+
+func TestThumbnail_Invalid(t *testing.T) {
+	_, err := Thumbnail([]byte("garbage"), 320)
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+
+	_, err = Thumbnail(encodePNG(t, 10, 10), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxCollectionNameLength is the longest collection name, in characters
+const MaxCollectionNameLength = 100
+
+// shareSlugBytes is the randomness in a share slug; 128 bits cannot be guessed
+const shareSlugBytes = 16
+
+// Collection is a named list of a user's favorites, such as "Osaka trip".
+// Its favorites are kept in the order the user chose, and a favorite can
+// belong to any number of collections.
+type Collection struct {
+	id          uuid.UUID
+	userID      uuid.UUID
+	name        string
+	description string
+	shareSlug   string // empty unless the collection is shared
+	sharedAt    *time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewCollection creates an empty collection
+func NewCollection(userID uuid.UUID, name, description string) *Collection {
+	now := time.Now()
+	return &Collection{
+		id:          uuid.New(),
+		userID:      userID,
+		name:        strings.TrimSpace(name),
+		description: description,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+}
+
+// ReconstructCollection is used by repository to reconstruct the Collection entity from persistence
+// This should NOT be used by application layer to create new collections
+func ReconstructCollection(
+	id uuid.UUID,
+	userID uuid.UUID,
+	name string,
+	description string,
+	shareSlug string,
+	sharedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Collection {
+	return &Collection{
+		id:          id,
+		userID:      userID,
+		name:        name,
+		description: description,
+		shareSlug:   shareSlug,
+		sharedAt:    sharedAt,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (c *Collection) ID() uuid.UUID        { return c.id }
+func (c *Collection) UserID() uuid.UUID    { return c.userID }
+func (c *Collection) Name() string         { return c.name }
+func (c *Collection) Description() string  { return c.description }
+func (c *Collection) ShareSlug() string    { return c.shareSlug }
+func (c *Collection) SharedAt() *time.Time { return c.sharedAt }
+func (c *Collection) CreatedAt() time.Time { return c.createdAt }
+func (c *Collection) UpdatedAt() time.Time { return c.updatedAt }
+
+// IsValidCollectionName checks that a name is not blank and not too long
+func IsValidCollectionName(name string) bool {
+	name = strings.TrimSpace(name)
+	return name != "" && utf8.RuneCountInString(name) <= MaxCollectionNameLength
+}
+
+// Rename changes the collection name
+func (c *Collection) Rename(name string) {
+	c.name = strings.TrimSpace(name)
+	c.updatedAt = time.Now()
+}
+
+// UpdateDescription changes the collection description
+func (c *Collection) UpdateDescription(description string) {
+	c.description = description
+	c.updatedAt = time.Now()
+}
+
+// IsShared reports whether the collection is published as a public list
+func (c *Collection) IsShared() bool {
+	return c.shareSlug != ""
+}
+
+// Share publishes the collection as a public list under slug. Sharing an
+// already shared collection replaces its slug, so the old link stops working.
+func (c *Collection) Share(slug string) {
+	now := time.Now()
+	c.shareSlug = slug
+	c.sharedAt = &now
+	c.updatedAt = now
+}
+
+// Unshare revokes the public list
+func (c *Collection) Unshare() {
+	c.shareSlug = ""
+	c.sharedAt = nil
+	c.updatedAt = time.Now()
+}
+
+// NewShareSlug generates a random, URL safe slug for a public list
+func NewShareSlug() (string, error) {
+	b := make([]byte, shareSlugBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CollectionItem is a favorite's place in a collection
+type CollectionItem struct {
+	Favorite *Favorite
+	Position int
+	AddedAt  time.Time
+}
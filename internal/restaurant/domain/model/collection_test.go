@@ -0,0 +1,45 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidCollectionName(t *testing.T) {
+	assert.True(t, IsValidCollectionName("Osaka trip"))
+	assert.True(t, IsValidCollectionName(strings.Repeat("旅", MaxCollectionNameLength)), "length counts characters")
+	assert.False(t, IsValidCollectionName("   "))
+	assert.False(t, IsValidCollectionName(strings.Repeat("a", MaxCollectionNameLength+1)))
+}
+
+func TestCollection_Rename(t *testing.T) {
+	c := NewCollection(uuid.New(), " Osaka trip ", "")
+	assert.Equal(t, "Osaka trip", c.Name())
+
+	before := c.UpdatedAt()
+	c.Rename("  Kyoto trip")
+
+	assert.Equal(t, "Kyoto trip", c.Name())
+	assert.False(t, c.UpdatedAt().Before(before))
+}
+
+func TestCollection_Share(t *testing.T) {
+	c := NewCollection(uuid.New(), "Osaka trip", "")
+	assert.False(t, c.IsShared())
+
+	slug, err := NewShareSlug()
+	assert.NoError(t, err)
+	assert.Len(t, slug, 22)
+	c.Share(slug)
+
+	assert.True(t, c.IsShared())
+	assert.Equal(t, slug, c.ShareSlug())
+	assert.NotNil(t, c.SharedAt())
+
+	c.Unshare()
+	assert.False(t, c.IsShared())
+	assert.Nil(t, c.SharedAt())
+}
@@ -0,0 +1,258 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/textnorm"
+)
+
+// CuisineCategory is a category of the cuisine taxonomy, such as "ramen"
+// under "japanese"
+type CuisineCategory struct {
+	ID     string            // stable slug stored on restaurants
+	Parent string            // ID of the parent category, empty for top-level categories
+	Labels map[string]string // display label per SupportedLanguages
+	// Google place types and Tabelog genres that map onto the category
+	GoogleTypes   []string
+	TabelogGenres []string
+}
+
+// Label returns the label in the first of langs the category has one for,
+// falling back to the DefaultLanguage
+func (c *CuisineCategory) Label(langs ...string) string {
+	for _, lang := range langs {
+		if label, ok := c.Labels[lang]; ok {
+			return label
+		}
+	}
+	return c.Labels[DefaultLanguage]
+}
+
+// CuisineTaxonomy is a tree of cuisine categories. Restaurants can be in
+// many categories; a category includes its descendants when filtering.
+type CuisineTaxonomy struct {
+	categories   []*CuisineCategory
+	byID         map[string]*CuisineCategory
+	children     map[string][]string
+	byName       map[string]string // normalized ID, label or Tabelog genre to ID
+	byGoogleType map[string]string
+}
+
+// NewCuisineTaxonomy builds a taxonomy. Parents must be declared before their
+// children, every category needs a DefaultLanguage label and a name may
+// only map onto one category.
+func NewCuisineTaxonomy(categories []CuisineCategory) (*CuisineTaxonomy, error) {
+	t := &CuisineTaxonomy{
+		byID:         make(map[string]*CuisineCategory),
+		children:     make(map[string][]string),
+		byName:       make(map[string]string),
+		byGoogleType: make(map[string]string),
+	}
+
+	for i := range categories {
+		category := &categories[i]
+		if category.ID == "" {
+			return nil, fmt.Errorf("cuisine category %d has no ID", i)
+		}
+		if _, ok := t.byID[category.ID]; ok {
+			return nil, fmt.Errorf("cuisine category %q is declared twice", category.ID)
+		}
+		if category.Parent != "" {
+			if _, ok := t.byID[category.Parent]; !ok {
+				return nil, fmt.Errorf("cuisine category %q is declared before its parent %q", category.ID, category.Parent)
+			}
+			t.children[category.Parent] = append(t.children[category.Parent], category.ID)
+		}
+		if category.Labels[DefaultLanguage] == "" {
+			return nil, fmt.Errorf("cuisine category %q has no %s label", category.ID, DefaultLanguage)
+		}
+
+		names := []string{category.ID}
+		for _, label := range category.Labels {
+			names = append(names, label)
+		}
+		names = append(names, category.TabelogGenres...)
+		for _, name := range names {
+			if err := addCuisineName(t.byName, textnorm.Normalize(name), category.ID); err != nil {
+				return nil, err
+			}
+		}
+		for _, googleType := range category.GoogleTypes {
+			if err := addCuisineName(t.byGoogleType, googleType, category.ID); err != nil {
+				return nil, err
+			}
+		}
+
+		t.byID[category.ID] = category
+		t.categories = append(t.categories, category)
+	}
+	return t, nil
+}
+
+func addCuisineName(names map[string]string, name, id string) error {
+	if other, ok := names[name]; ok && other != id {
+		return fmt.Errorf("cuisine name %q maps onto both %q and %q", name, other, id)
+	}
+	names[name] = id
+	return nil
+}
+
+// Categories returns every category, parents before their children
+func (t *CuisineTaxonomy) Categories() []*CuisineCategory {
+	return t.categories
+}
+
+// Category returns the category with the given ID
+func (t *CuisineTaxonomy) Category(id string) (*CuisineCategory, bool) {
+	category, ok := t.byID[id]
+	return category, ok
+}
+
+// WithDescendants returns id followed by the IDs of all categories below it,
+// or nil when id is not a category
+func (t *CuisineTaxonomy) WithDescendants(id string) []string {
+	if _, ok := t.byID[id]; !ok {
+		return nil
+	}
+	ids := []string{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, t.children[ids[i]]...)
+	}
+	return ids
+}
+
+// Match finds the category a cuisine text names: a category ID, a label in
+// any language or a Tabelog genre, compared after textnorm.Normalize
+func (t *CuisineTaxonomy) Match(text string) (*CuisineCategory, bool) {
+	id, ok := t.byName[textnorm.Normalize(text)]
+	if !ok {
+		return nil, false
+	}
+	return t.byID[id], true
+}
+
+// MatchGoogleTypes returns the categories of Google place types, in the
+// order of types. Types outside the taxonomy, such as "restaurant" or
+// "point_of_interest", are skipped.
+func (t *CuisineTaxonomy) MatchGoogleTypes(types []string) []string {
+	var ids []string
+	for _, googleType := range types {
+		if id, ok := t.byGoogleType[googleType]; ok {
+			ids = appendCuisine(ids, id)
+		}
+	}
+	return ids
+}
+
+// MatchTabelogGenres returns the categories of Tabelog genres, in the order
+// of genres. Genres outside the taxonomy are skipped.
+func (t *CuisineTaxonomy) MatchTabelogGenres(genres []string) []string {
+	var ids []string
+	for _, genre := range genres {
+		if category, ok := t.Match(genre); ok {
+			ids = appendCuisine(ids, category.ID)
+		}
+	}
+	return ids
+}
+
+// appendCuisine appends id unless ids already has it
+func appendCuisine(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+// Cuisines is the curated cuisine taxonomy
+var Cuisines = mustCuisineTaxonomy(cuisineCategories)
+
+func mustCuisineTaxonomy(categories []CuisineCategory) *CuisineTaxonomy {
+	t, err := NewCuisineTaxonomy(categories)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func cuisineLabels(en, ja, zhTW string) map[string]string {
+	return map[string]string{
+		LanguageEnglish:            en,
+		LanguageJapanese:           ja,
+		LanguageTraditionalChinese: zhTW,
+	}
+}
+
+var cuisineCategories = []CuisineCategory{
+	{ID: "japanese", Labels: cuisineLabels("Japanese", "和食", "日本料理"),
+		GoogleTypes: []string{"japanese_restaurant"}, TabelogGenres: []string{"日本料理", "郷土料理"}},
+	{ID: "sushi", Parent: "japanese", Labels: cuisineLabels("Sushi", "寿司", "壽司"),
+		GoogleTypes: []string{"sushi_restaurant"}, TabelogGenres: []string{"すし", "回転寿司"}},
+	{ID: "ramen", Parent: "japanese", Labels: cuisineLabels("Ramen", "ラーメン", "拉麵"),
+		GoogleTypes: []string{"ramen_restaurant"}, TabelogGenres: []string{"担々麺", "油そば・まぜそば"}},
+	{ID: "tsukemen", Parent: "ramen", Labels: cuisineLabels("Tsukemen", "つけ麺", "沾麵")},
+	{ID: "soba", Parent: "japanese", Labels: cuisineLabels("Soba", "そば", "蕎麥麵"),
+		TabelogGenres: []string{"蕎麦", "立ち食いそば"}},
+	{ID: "udon", Parent: "japanese", Labels: cuisineLabels("Udon", "うどん", "烏龍麵")},
+	{ID: "tempura", Parent: "japanese", Labels: cuisineLabels("Tempura", "天ぷら", "天婦羅")},
+	{ID: "tonkatsu", Parent: "japanese", Labels: cuisineLabels("Tonkatsu", "とんかつ", "炸豬排")},
+	{ID: "yakitori", Parent: "japanese", Labels: cuisineLabels("Yakitori", "焼き鳥", "日式串燒"),
+		TabelogGenres: []string{"焼鳥", "串焼き"}},
+	{ID: "yakiniku", Parent: "japanese", Labels: cuisineLabels("Yakiniku", "焼肉", "燒肉"),
+		TabelogGenres: []string{"ホルモン", "ジンギスカン"}},
+	{ID: "shabu_shabu", Parent: "japanese", Labels: cuisineLabels("Shabu-shabu", "しゃぶしゃぶ", "涮涮鍋"),
+		TabelogGenres: []string{"すき焼き"}},
+	{ID: "unagi", Parent: "japanese", Labels: cuisineLabels("Unagi", "うなぎ", "鰻魚飯")},
+	{ID: "okonomiyaki", Parent: "japanese", Labels: cuisineLabels("Okonomiyaki", "お好み焼き", "大阪燒"),
+		TabelogGenres: []string{"もんじゃ焼き", "たこ焼き"}},
+	{ID: "kaiseki", Parent: "japanese", Labels: cuisineLabels("Kaiseki", "懐石・会席料理", "懷石料理"),
+		TabelogGenres: []string{"懐石料理", "会席料理", "割烹・小料理", "料亭"}},
+	{ID: "izakaya", Parent: "japanese", Labels: cuisineLabels("Izakaya", "居酒屋", "日式居酒屋"),
+		TabelogGenres: []string{"ダイニングバー"}},
+	{ID: "chinese", Labels: cuisineLabels("Chinese", "中華料理", "中式料理"),
+		GoogleTypes: []string{"chinese_restaurant"}, TabelogGenres: []string{"中華", "四川料理", "広東料理", "上海料理", "台湾料理"}},
+	{ID: "dim_sum", Parent: "chinese", Labels: cuisineLabels("Dim sum", "飲茶・点心", "港式點心"),
+		TabelogGenres: []string{"飲茶", "点心"}},
+	{ID: "gyoza", Parent: "chinese", Labels: cuisineLabels("Gyoza", "餃子", "煎餃")},
+	{ID: "korean", Labels: cuisineLabels("Korean", "韓国料理", "韓式料理"),
+		GoogleTypes: []string{"korean_restaurant"}},
+	{ID: "asian", Labels: cuisineLabels("Asian", "アジア・エスニック", "亞洲料理"),
+		GoogleTypes: []string{"asian_restaurant"}, TabelogGenres: []string{"アジア・エスニック料理", "エスニック料理"}},
+	{ID: "thai", Parent: "asian", Labels: cuisineLabels("Thai", "タイ料理", "泰式料理"),
+		GoogleTypes: []string{"thai_restaurant"}},
+	{ID: "vietnamese", Parent: "asian", Labels: cuisineLabels("Vietnamese", "ベトナム料理", "越南料理"),
+		GoogleTypes: []string{"vietnamese_restaurant"}},
+	{ID: "indian", Parent: "asian", Labels: cuisineLabels("Indian", "インド料理", "印度料理"),
+		GoogleTypes: []string{"indian_restaurant"}, TabelogGenres: []string{"インドカレー"}},
+	{ID: "curry", Labels: cuisineLabels("Curry", "カレー", "咖哩"),
+		TabelogGenres: []string{"カレーライス", "スープカレー"}},
+	{ID: "italian", Labels: cuisineLabels("Italian", "イタリアン", "義式料理"),
+		GoogleTypes: []string{"italian_restaurant"}, TabelogGenres: []string{"イタリア料理"}},
+	{ID: "pizza", Parent: "italian", Labels: cuisineLabels("Pizza", "ピザ", "披薩"),
+		GoogleTypes: []string{"pizza_restaurant"}},
+	{ID: "pasta", Parent: "italian", Labels: cuisineLabels("Pasta", "パスタ", "義大利麵")},
+	{ID: "french", Labels: cuisineLabels("French", "フレンチ", "法式料理"),
+		GoogleTypes: []string{"french_restaurant"}, TabelogGenres: []string{"フランス料理", "ビストロ"}},
+	{ID: "spanish", Labels: cuisineLabels("Spanish", "スペイン料理", "西班牙料理"),
+		GoogleTypes: []string{"spanish_restaurant"}, TabelogGenres: []string{"バル"}},
+	{ID: "western", Labels: cuisineLabels("Western", "洋食", "西式料理"),
+		GoogleTypes: []string{"american_restaurant"}, TabelogGenres: []string{"オムライス", "ハンバーグ"}},
+	{ID: "burger", Parent: "western", Labels: cuisineLabels("Burgers", "ハンバーガー", "漢堡"),
+		GoogleTypes: []string{"hamburger_restaurant"}},
+	{ID: "steak", Parent: "western", Labels: cuisineLabels("Steak", "ステーキ", "牛排"),
+		GoogleTypes: []string{"steak_house"}, TabelogGenres: []string{"鉄板焼き"}},
+	{ID: "seafood", Labels: cuisineLabels("Seafood", "海鮮", "海鮮料理"),
+		GoogleTypes: []string{"seafood_restaurant"}, TabelogGenres: []string{"魚介料理・海鮮料理", "海鮮丼"}},
+	{ID: "vegetarian", Labels: cuisineLabels("Vegetarian", "ベジタリアン", "素食"),
+		GoogleTypes: []string{"vegetarian_restaurant", "vegan_restaurant"}, TabelogGenres: []string{"自然食", "ヴィーガン"}},
+	{ID: "cafe", Labels: cuisineLabels("Cafe", "カフェ", "咖啡廳"),
+		GoogleTypes: []string{"cafe", "coffee_shop"}, TabelogGenres: []string{"喫茶店", "コーヒー専門店"}},
+	{ID: "sweets", Labels: cuisineLabels("Sweets", "スイーツ", "甜點"),
+		GoogleTypes: []string{"dessert_shop", "ice_cream_shop", "confectionery"}, TabelogGenres: []string{"ケーキ", "和菓子", "甘味処", "かき氷"}},
+	{ID: "bakery", Labels: cuisineLabels("Bakery", "パン", "麵包店"),
+		GoogleTypes: []string{"bakery"}, TabelogGenres: []string{"ベーカリー", "サンドイッチ"}},
+	{ID: "bar", Labels: cuisineLabels("Bar", "バー", "酒吧"),
+		GoogleTypes: []string{"bar", "pub", "wine_bar"}, TabelogGenres: []string{"ワインバー", "ビアバー", "日本酒バー"}},
+}
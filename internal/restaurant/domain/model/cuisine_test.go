@@ -0,0 +1,129 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCuisines_IsValidTaxonomy(t *testing.T) {
+	for _, category := range Cuisines.Categories() {
+		for _, lang := range SupportedLanguages {
+			assert.NotEmpty(t, category.Labels[lang], "%s has no %s label", category.ID, lang)
+		}
+	}
+}
+
+func TestCuisineTaxonomy_WithDescendants(t *testing.T) {
+	assert.Equal(t, []string{"ramen", "tsukemen"}, Cuisines.WithDescendants("ramen"))
+	assert.Equal(t, []string{"tsukemen"}, Cuisines.WithDescendants("tsukemen"))
+	assert.Contains(t, Cuisines.WithDescendants("japanese"), "tsukemen")
+	assert.Contains(t, Cuisines.WithDescendants("japanese"), "sushi")
+	assert.NotContains(t, Cuisines.WithDescendants("japanese"), "chinese")
+	assert.Nil(t, Cuisines.WithDescendants("unknown"))
+}
+
+func TestCuisineTaxonomy_Match(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"ramen", "ramen"},
+		{"Ramen", "ramen"},
+		{"ラーメン", "ramen"},
+		{"ﾗｰﾒﾝ", "ramen"},
+		{"拉麵", "ramen"},
+		{"つけ麺", "tsukemen"},
+		{"回転寿司", "sushi"},
+		{"Japanese", "japanese"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			category, ok := Cuisines.Match(tt.text)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, category.ID)
+		})
+	}
+
+	_, ok := Cuisines.Match("Fusion")
+	assert.False(t, ok)
+}
+
+func TestCuisineTaxonomy_MatchGoogleTypes(t *testing.T) {
+	ids := Cuisines.MatchGoogleTypes([]string{"ramen_restaurant", "japanese_restaurant", "restaurant", "food", "ramen_restaurant"})
+
+	assert.Equal(t, []string{"ramen", "japanese"}, ids)
+}
+
+func TestCuisineTaxonomy_MatchTabelogGenres(t *testing.T) {
+	ids := Cuisines.MatchTabelogGenres([]string{"ラーメン", "つけ麺", "餃子", "デリカテッセン"})
+
+	assert.Equal(t, []string{"ramen", "tsukemen", "gyoza"}, ids)
+}
+
+func TestNewCuisineTaxonomy_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []CuisineCategory
+	}{
+		{"child before parent", []CuisineCategory{
+			{ID: "tsukemen", Parent: "ramen", Labels: cuisineLabels("Tsukemen", "つけ麺", "沾麵")},
+			{ID: "ramen", Labels: cuisineLabels("Ramen", "ラーメン", "拉麵")},
+		}},
+		{"duplicate ID", []CuisineCategory{
+			{ID: "ramen", Labels: cuisineLabels("Ramen", "ラーメン", "拉麵")},
+			{ID: "ramen", Labels: cuisineLabels("Noodles", "麺", "麵")},
+		}},
+		{"shared name", []CuisineCategory{
+			{ID: "ramen", Labels: cuisineLabels("Ramen", "ラーメン", "拉麵")},
+			{ID: "noodles", Labels: cuisineLabels("Noodles", "麺", "麵"), TabelogGenres: []string{"らーめん"}},
+		}},
+		{"missing default label", []CuisineCategory{
+			{ID: "ramen", Labels: map[string]string{LanguageJapanese: "ラーメン"}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCuisineTaxonomy(tt.categories)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCuisineCategory_Label(t *testing.T) {
+	category, _ := Cuisines.Category("ramen")
+
+	assert.Equal(t, "ラーメン", category.Label("ko", LanguageJapanese))
+	assert.Equal(t, "Ramen", category.Label("ko"))
+}
+
+func TestRestaurant_Cuisines(t *testing.T) {
+	restaurant := createTestRestaurant(t)
+	assert.Empty(t, restaurant.Cuisines())
+
+	restaurant.UpdateCuisineType("ラーメン")
+	restaurant.AddCuisines("tsukemen", "ramen")
+	assert.Equal(t, []string{"ramen", "tsukemen"}, restaurant.Cuisines())
+
+	restaurant.UpdateCuisineType("Fusion")
+	assert.Equal(t, []string{"ramen", "tsukemen"}, restaurant.Cuisines())
+
+	restaurant.SetCuisines([]string{"sushi", "sushi"})
+	assert.Equal(t, []string{"sushi"}, restaurant.Cuisines())
+}
+
+func TestRestaurant_RestoreField_Cuisines(t *testing.T) {
+	restaurant := createTestRestaurant(t)
+	before := restaurant.TrackedFields()
+
+	restaurant.SetCuisines([]string{"ramen", "tsukemen"})
+	changes := DiffFields(before, restaurant.TrackedFields())
+	require.Equal(t, []FieldChange{{Field: FieldCuisines, Before: "", After: "ramen,tsukemen"}}, changes)
+
+	require.NoError(t, restaurant.RestoreField(FieldCuisines, "sushi,retired"))
+	assert.Equal(t, []string{"sushi"}, restaurant.Cuisines())
+
+	require.NoError(t, restaurant.RestoreField(FieldCuisines, ""))
+	assert.Empty(t, restaurant.Cuisines())
+}
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType names a domain event other services can subscribe to
+type EventType string
+
+const (
+	EventRestaurantCreated EventType = "restaurant.created"
+	EventRestaurantUpdated EventType = "restaurant.updated"
+	EventRestaurantDeleted EventType = "restaurant.deleted"
+	EventFavoriteAdded     EventType = "favorite.added"
+	EventFavoriteRemoved   EventType = "favorite.removed"
+	EventVisitRecorded     EventType = "visit.recorded"
+)
+
+// Aggregates events are keyed by. Events of one aggregate are published in
+// the order they were written.
+const (
+	AggregateRestaurant = "restaurant"
+	AggregateFavorite   = "favorite"
+	AggregateVisit      = "visit"
+)
+
+// Payload versions. A version is bumped when a field is removed or changes
+// meaning; adding a field keeps the version.
+const (
+	RestaurantEventVersion = 1
+	FavoriteEventVersion   = 1
+	VisitEventVersion      = 1
+)
+
+// RestaurantEventPayload is the payload of restaurant.created and
+// restaurant.updated events
+type RestaurantEventPayload struct {
+	RestaurantID uuid.UUID        `json:"restaurant_id"`
+	Name         string           `json:"name"`
+	NameJa       string           `json:"name_ja,omitempty"`
+	Source       RestaurantSource `json:"source"`
+	ExternalID   string           `json:"external_id"`
+	Address      string           `json:"address"`
+	Latitude     float64          `json:"latitude"`
+	Longitude    float64          `json:"longitude"`
+	CuisineType  string           `json:"cuisine_type,omitempty"`
+	Cuisines     []string         `json:"cuisines,omitempty"`
+	PriceRange   string           `json:"price_range,omitempty"`
+	PriceLevel   int              `json:"price_level,omitempty"`
+	Rating       float64          `json:"rating"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// RestaurantDeletedPayload is the payload of restaurant.deleted events.
+// MergedInto is set when the restaurant was merged into another one.
+type RestaurantDeletedPayload struct {
+	RestaurantID uuid.UUID  `json:"restaurant_id"`
+	MergedInto   *uuid.UUID `json:"merged_into,omitempty"`
+}
+
+// FavoriteEventPayload is the payload of favorite.added and favorite.removed events
+type FavoriteEventPayload struct {
+	FavoriteID   uuid.UUID `json:"favorite_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+}
+
+// VisitEventPayload is the payload of visit.recorded events
+type VisitEventPayload struct {
+	VisitID      uuid.UUID `json:"visit_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+	VisitedOn    string    `json:"visited_on"` // YYYY-MM-DD
+	PartySize    int       `json:"party_size,omitempty"`
+	Rating       int       `json:"rating,omitempty"`
+}
+
+// DomainEvent is a change other services are notified of. It is written to
+// the outbox in the transaction of the change and published afterwards, at
+// least once: consumers deduplicate by ID.
+type DomainEvent struct {
+	id            uuid.UUID
+	eventType     EventType
+	version       int
+	aggregateType string
+	aggregateID   uuid.UUID
+	payload       json.RawMessage
+	occurredAt    time.Time
+}
+
+// newDomainEvent creates an event with the payload encoded as JSON
+func newDomainEvent(eventType EventType, version int, aggregateType string, aggregateID uuid.UUID, payload interface{}) *DomainEvent {
+	// The payloads are plain structs, so encoding cannot fail
+	data, _ := json.Marshal(payload)
+	return &DomainEvent{
+		id:            uuid.New(),
+		eventType:     eventType,
+		version:       version,
+		aggregateType: aggregateType,
+		aggregateID:   aggregateID,
+		payload:       data,
+		occurredAt:    time.Now(),
+	}
+}
+
+// ReconstructDomainEvent is used by repository to reconstruct the DomainEvent from persistence
+// This should NOT be used by application layer to create new events
+func ReconstructDomainEvent(
+	id uuid.UUID,
+	eventType EventType,
+	version int,
+	aggregateType string,
+	aggregateID uuid.UUID,
+	payload json.RawMessage,
+	occurredAt time.Time,
+) *DomainEvent {
+	return &DomainEvent{
+		id:            id,
+		eventType:     eventType,
+		version:       version,
+		aggregateType: aggregateType,
+		aggregateID:   aggregateID,
+		payload:       payload,
+		occurredAt:    occurredAt,
+	}
+}
+
+// NewRestaurantCreatedEvent creates a restaurant.created event
+func NewRestaurantCreatedEvent(r *Restaurant) *DomainEvent {
+	return newDomainEvent(EventRestaurantCreated, RestaurantEventVersion, AggregateRestaurant, r.ID(), restaurantEventPayload(r))
+}
+
+// NewRestaurantUpdatedEvent creates a restaurant.updated event
+func NewRestaurantUpdatedEvent(r *Restaurant) *DomainEvent {
+	return newDomainEvent(EventRestaurantUpdated, RestaurantEventVersion, AggregateRestaurant, r.ID(), restaurantEventPayload(r))
+}
+
+// NewRestaurantDeletedEvent creates a restaurant.deleted event; mergedInto is
+// the canonical restaurant when the restaurant was merged, nil otherwise
+func NewRestaurantDeletedEvent(restaurantID uuid.UUID, mergedInto *uuid.UUID) *DomainEvent {
+	return newDomainEvent(EventRestaurantDeleted, RestaurantEventVersion, AggregateRestaurant, restaurantID, RestaurantDeletedPayload{
+		RestaurantID: restaurantID,
+		MergedInto:   mergedInto,
+	})
+}
+
+// NewFavoriteAddedEvent creates a favorite.added event
+func NewFavoriteAddedEvent(f *Favorite) *DomainEvent {
+	return newDomainEvent(EventFavoriteAdded, FavoriteEventVersion, AggregateFavorite, f.ID(), favoriteEventPayload(f))
+}
+
+// NewFavoriteRemovedEvent creates a favorite.removed event
+func NewFavoriteRemovedEvent(f *Favorite) *DomainEvent {
+	return newDomainEvent(EventFavoriteRemoved, FavoriteEventVersion, AggregateFavorite, f.ID(), favoriteEventPayload(f))
+}
+
+// NewVisitRecordedEvent creates a visit.recorded event
+func NewVisitRecordedEvent(v *Visit) *DomainEvent {
+	return newDomainEvent(EventVisitRecorded, VisitEventVersion, AggregateVisit, v.ID(), VisitEventPayload{
+		VisitID:      v.ID(),
+		UserID:       v.UserID(),
+		RestaurantID: v.RestaurantID(),
+		VisitedOn:    v.VisitedOn().Format(time.DateOnly),
+		PartySize:    v.PartySize(),
+		Rating:       v.Rating(),
+	})
+}
+
+func restaurantEventPayload(r *Restaurant) RestaurantEventPayload {
+	payload := RestaurantEventPayload{
+		RestaurantID: r.ID(),
+		Name:         r.Name(),
+		NameJa:       r.NameJa(),
+		Source:       r.Source(),
+		ExternalID:   r.ExternalID(),
+		Address:      r.Address(),
+		CuisineType:  r.CuisineType(),
+		Cuisines:     r.Cuisines(),
+		PriceRange:   r.PriceRange(),
+		Rating:       r.Rating(),
+		UpdatedAt:    r.UpdatedAt(),
+	}
+	if price := r.Price(); price != nil {
+		payload.PriceLevel = price.Level()
+	}
+	if location := r.Location(); location != nil {
+		payload.Latitude = location.Latitude()
+		payload.Longitude = location.Longitude()
+	}
+	return payload
+}
+
+func favoriteEventPayload(f *Favorite) FavoriteEventPayload {
+	return FavoriteEventPayload{
+		FavoriteID:   f.ID(),
+		UserID:       f.UserID(),
+		RestaurantID: f.RestaurantID(),
+	}
+}
+
+// Getters
+func (e *DomainEvent) ID() uuid.UUID            { return e.id }
+func (e *DomainEvent) Type() EventType          { return e.eventType }
+func (e *DomainEvent) Version() int             { return e.version }
+func (e *DomainEvent) AggregateType() string    { return e.aggregateType }
+func (e *DomainEvent) AggregateID() uuid.UUID   { return e.aggregateID }
+func (e *DomainEvent) Payload() json.RawMessage { return e.payload }
+func (e *DomainEvent) OccurredAt() time.Time    { return e.occurredAt }
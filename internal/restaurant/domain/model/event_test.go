@@ -0,0 +1,94 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRestaurantCreatedEvent(t *testing.T) {
+	location, err := NewLocation(35.6595, 139.7005)
+	require.NoError(t, err)
+	restaurant := NewRestaurant("Ichiran", "Shibuya", SourceGoogle, "place-1", "1-22-7 Jinnan", location)
+	restaurant.UpdateDetails("", "", "$$", "Ramen", "", "")
+
+	event := NewRestaurantCreatedEvent(restaurant)
+
+	assert.NotEqual(t, uuid.Nil, event.ID())
+	assert.Equal(t, EventRestaurantCreated, event.Type())
+	assert.Equal(t, RestaurantEventVersion, event.Version())
+	assert.Equal(t, AggregateRestaurant, event.AggregateType())
+	assert.Equal(t, restaurant.ID(), event.AggregateID())
+
+	var payload RestaurantEventPayload
+	require.NoError(t, json.Unmarshal(event.Payload(), &payload))
+	assert.Equal(t, restaurant.ID(), payload.RestaurantID)
+	assert.Equal(t, "Ichiran", payload.Name)
+	assert.Equal(t, SourceGoogle, payload.Source)
+	assert.Equal(t, "place-1", payload.ExternalID)
+	assert.Equal(t, "Ramen", payload.CuisineType)
+	assert.Equal(t, 35.6595, payload.Latitude)
+	assert.Equal(t, 139.7005, payload.Longitude)
+}
+
+func TestNewRestaurantDeletedEvent_Merged(t *testing.T) {
+	restaurantID, canonicalID := uuid.New(), uuid.New()
+
+	event := NewRestaurantDeletedEvent(restaurantID, &canonicalID)
+
+	assert.Equal(t, EventRestaurantDeleted, event.Type())
+	assert.Equal(t, restaurantID, event.AggregateID())
+	assert.JSONEq(t, `{"restaurant_id":"`+restaurantID.String()+`","merged_into":"`+canonicalID.String()+`"}`, string(event.Payload()))
+
+	assert.JSONEq(t, `{"restaurant_id":"`+restaurantID.String()+`"}`, string(NewRestaurantDeletedEvent(restaurantID, nil).Payload()))
+}
+
+func TestNewFavoriteEvents(t *testing.T) {
+	favorite := NewFavorite(uuid.New(), uuid.New())
+
+	added := NewFavoriteAddedEvent(favorite)
+	removed := NewFavoriteRemovedEvent(favorite)
+
+	assert.Equal(t, EventFavoriteAdded, added.Type())
+	assert.Equal(t, EventFavoriteRemoved, removed.Type())
+	assert.NotEqual(t, added.ID(), removed.ID())
+	for _, event := range []*DomainEvent{added, removed} {
+		assert.Equal(t, AggregateFavorite, event.AggregateType())
+		assert.Equal(t, favorite.ID(), event.AggregateID())
+
+		var payload FavoriteEventPayload
+		require.NoError(t, json.Unmarshal(event.Payload(), &payload))
+		assert.Equal(t, FavoriteEventPayload{
+			FavoriteID:   favorite.ID(),
+			UserID:       favorite.UserID(),
+			RestaurantID: favorite.RestaurantID(),
+		}, payload)
+	}
+}
+
+func TestNewVisitRecordedEvent(t *testing.T) {
+	visit := NewVisit(uuid.New(), uuid.New(), VisitDetails{
+		VisitedOn: time.Date(2026, 3, 14, 19, 30, 0, 0, time.UTC),
+		PartySize: 2,
+		Rating:    4,
+		Spend:     &Money{Amount: 3200, Currency: "JPY"},
+	})
+
+	event := NewVisitRecordedEvent(visit)
+
+	assert.Equal(t, EventVisitRecorded, event.Type())
+	assert.Equal(t, AggregateVisit, event.AggregateType())
+	assert.Equal(t, visit.ID(), event.AggregateID())
+
+	var payload VisitEventPayload
+	require.NoError(t, json.Unmarshal(event.Payload(), &payload))
+	assert.Equal(t, "2026-03-14", payload.VisitedOn)
+	assert.Equal(t, 2, payload.PartySize)
+	assert.Equal(t, 4, payload.Rating)
+	assert.Equal(t, visit.UserID(), payload.UserID)
+	assert.Equal(t, visit.RestaurantID(), payload.RestaurantID)
+}
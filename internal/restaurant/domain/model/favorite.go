@@ -87,6 +87,14 @@ func (f *Favorite) AddVisit() {
 	f.updatedAt = now
 }
 
+// SyncVisits sets the visit count and last visited time from the user's
+// visit history; lastVisitedAt is nil when the history is empty
+func (f *Favorite) SyncVisits(count int, lastVisitedAt *time.Time) {
+	f.visitCount = count
+	f.lastVisitedAt = lastVisitedAt
+	f.updatedAt = time.Now()
+}
+
 // UpdateNotes updates the user's private notes
 func (f *Favorite) UpdateNotes(notes string) {
 	f.notes = notes
@@ -215,3 +215,17 @@ func createTestFavorite(t *testing.T) *Favorite {
 	restaurantID := uuid.New()
 	return NewFavorite(userID, restaurantID)
 }
+
+func TestFavorite_SyncVisits(t *testing.T) {
+	favorite := createTestFavorite(t)
+	favorite.AddVisit()
+	lastVisit := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	favorite.SyncVisits(3, &lastVisit)
+	assert.Equal(t, 3, favorite.VisitCount())
+	assert.Equal(t, lastVisit, *favorite.LastVisitedAt())
+
+	favorite.SyncVisits(0, nil)
+	assert.Equal(t, 0, favorite.VisitCount())
+	assert.Nil(t, favorite.LastVisitedAt(), "no visits left")
+}
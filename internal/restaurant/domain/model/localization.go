@@ -0,0 +1,61 @@
+package model
+
+// Languages the restaurant content is kept in, as BCP 47 tags. They match
+// the languages the Map Service answers in.
+const (
+	LanguageEnglish            = "en"
+	LanguageJapanese           = "ja"
+	LanguageTraditionalChinese = "zh-TW"
+)
+
+// DefaultLanguage is the language of the restaurant's own fields (name,
+// address, cuisine type) and the last step of every fallback chain
+const DefaultLanguage = LanguageEnglish
+
+// SupportedLanguages lists every content language, the default first
+var SupportedLanguages = []string{LanguageEnglish, LanguageJapanese, LanguageTraditionalChinese}
+
+// LocalizedLanguages lists the languages stored as localizations; the default
+// language lives in the restaurant's own fields
+var LocalizedLanguages = []string{LanguageJapanese, LanguageTraditionalChinese}
+
+// IsLocalizedLanguage checks if lang is stored as a localization
+func IsLocalizedLanguage(lang string) bool {
+	for _, l := range LocalizedLanguages {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// LocalizedContent is the display text of a restaurant in one language. Empty
+// fields are not translated and fall back to the next language.
+type LocalizedContent struct {
+	Name         string
+	Address      string
+	CuisineLabel string
+	Summary      string
+}
+
+// IsEmpty checks if no field is translated
+func (c LocalizedContent) IsEmpty() bool {
+	return c.Name == "" && c.Address == "" && c.CuisineLabel == "" && c.Summary == ""
+}
+
+// merge fills the empty fields of c from other
+func (c LocalizedContent) merge(other LocalizedContent) LocalizedContent {
+	if c.Name == "" {
+		c.Name = other.Name
+	}
+	if c.Address == "" {
+		c.Address = other.Address
+	}
+	if c.CuisineLabel == "" {
+		c.CuisineLabel = other.CuisineLabel
+	}
+	if c.Summary == "" {
+		c.Summary = other.Summary
+	}
+	return c
+}
@@ -1,6 +1,9 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // Location is a value object representing geographic coordinates
 type Location struct {
@@ -39,3 +42,23 @@ func (l *Location) Equals(other *Location) bool {
 	}
 	return l.latitude == other.latitude && l.longitude == other.longitude
 }
+
+// EarthRadiusKm is the mean Earth radius used for great-circle distances
+const EarthRadiusKm = 6371.0088
+
+// DistanceTo returns the great-circle (haversine) distance in kilometers
+func (l *Location) DistanceTo(other *Location) float64 {
+	if other == nil {
+		return 0
+	}
+
+	lat1 := l.latitude * math.Pi / 180
+	lat2 := other.latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.longitude - l.longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
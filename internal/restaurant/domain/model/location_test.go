@@ -135,3 +135,60 @@ func TestLocation_Equals(t *testing.T) {
 	assert.False(t, location1.Equals(location3))
 	assert.False(t, location1.Equals(nil))
 }
+
+func TestLocation_DistanceTo(t *testing.T) {
+	tokyoStation, _ := NewLocation(35.681236, 139.767125)
+	shinjukuStation, _ := NewLocation(35.690921, 139.700258)
+	osakaStation, _ := NewLocation(34.702485, 135.495951)
+	sapporoStation, _ := NewLocation(43.068661, 141.350755)
+
+	tests := []struct {
+		name     string
+		from     *Location
+		to       *Location
+		expected float64
+		delta    float64
+	}{
+		{
+			name:     "Same point",
+			from:     tokyoStation,
+			to:       tokyoStation,
+			expected: 0,
+			delta:    0.0001,
+		},
+		{
+			name:     "Tokyo to Shinjuku",
+			from:     tokyoStation,
+			to:       shinjukuStation,
+			expected: 6.13,
+			delta:    0.05,
+		},
+		{
+			name:     "Tokyo to Osaka",
+			from:     tokyoStation,
+			to:       osakaStation,
+			expected: 403.5,
+			delta:    1.0,
+		},
+		{
+			name:     "High latitude (Sapporo to Tokyo)",
+			from:     sapporoStation,
+			to:       tokyoStation,
+			expected: 831.3,
+			delta:    2.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.from.DistanceTo(tt.to), tt.delta)
+			assert.InDelta(t, tt.from.DistanceTo(tt.to), tt.to.DistanceTo(tt.from), 1e-9)
+		})
+	}
+}
+
+func TestLocation_DistanceTo_Nil(t *testing.T) {
+	location, _ := NewLocation(35.6762, 139.6503)
+
+	assert.Equal(t, 0.0, location.DistanceTo(nil))
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RestaurantMerge records that a duplicate restaurant was merged into a
+// canonical one. The duplicate's (source, external_id) stays linked to the
+// canonical restaurant as one of its identities until the merge is undone.
+type RestaurantMerge struct {
+	id          uuid.UUID
+	canonicalID uuid.UUID
+	mergedID    uuid.UUID
+	source      RestaurantSource // source of the merged restaurant
+	externalID  string           // external ID of the merged restaurant
+	score       float64          // match score at merge time, 0 for manual merges
+	mergedBy    string
+	// movedFavoriteIDs were repointed to the canonical restaurant;
+	// droppedFavoriteIDs were removed because the user already had the canonical one
+	movedFavoriteIDs   []uuid.UUID
+	droppedFavoriteIDs []uuid.UUID
+	createdAt          time.Time
+	unmergedAt         *time.Time
+}
+
+// NewRestaurantMerge creates the merge of duplicate into canonical
+func NewRestaurantMerge(canonical, duplicate *Restaurant, score float64, mergedBy string) *RestaurantMerge {
+	return &RestaurantMerge{
+		id:                 uuid.New(),
+		canonicalID:        canonical.ID(),
+		mergedID:           duplicate.ID(),
+		source:             duplicate.Source(),
+		externalID:         duplicate.ExternalID(),
+		score:              score,
+		mergedBy:           mergedBy,
+		movedFavoriteIDs:   []uuid.UUID{},
+		droppedFavoriteIDs: []uuid.UUID{},
+		createdAt:          time.Now(),
+		unmergedAt:         nil,
+	}
+}
+
+// ReconstructRestaurantMerge is used by repository to reconstruct the RestaurantMerge entity from persistence
+// This should NOT be used by application layer to create new merges
+func ReconstructRestaurantMerge(
+	id uuid.UUID,
+	canonicalID uuid.UUID,
+	mergedID uuid.UUID,
+	source RestaurantSource,
+	externalID string,
+	score float64,
+	mergedBy string,
+	movedFavoriteIDs []uuid.UUID,
+	droppedFavoriteIDs []uuid.UUID,
+	createdAt time.Time,
+	unmergedAt *time.Time,
+) *RestaurantMerge {
+	return &RestaurantMerge{
+		id:                 id,
+		canonicalID:        canonicalID,
+		mergedID:           mergedID,
+		source:             source,
+		externalID:         externalID,
+		score:              score,
+		mergedBy:           mergedBy,
+		movedFavoriteIDs:   movedFavoriteIDs,
+		droppedFavoriteIDs: droppedFavoriteIDs,
+		createdAt:          createdAt,
+		unmergedAt:         unmergedAt,
+	}
+}
+
+// Getters
+func (m *RestaurantMerge) ID() uuid.UUID                   { return m.id }
+func (m *RestaurantMerge) CanonicalID() uuid.UUID          { return m.canonicalID }
+func (m *RestaurantMerge) MergedID() uuid.UUID             { return m.mergedID }
+func (m *RestaurantMerge) Source() RestaurantSource        { return m.source }
+func (m *RestaurantMerge) ExternalID() string              { return m.externalID }
+func (m *RestaurantMerge) Score() float64                  { return m.score }
+func (m *RestaurantMerge) MergedBy() string                { return m.mergedBy }
+func (m *RestaurantMerge) MovedFavoriteIDs() []uuid.UUID   { return m.movedFavoriteIDs }
+func (m *RestaurantMerge) DroppedFavoriteIDs() []uuid.UUID { return m.droppedFavoriteIDs }
+func (m *RestaurantMerge) CreatedAt() time.Time            { return m.createdAt }
+func (m *RestaurantMerge) UnmergedAt() *time.Time          { return m.unmergedAt }
+
+// Domain Methods
+
+// RecordFavorites records which favorites of the merged restaurant were
+// repointed and which were dropped, so that Unmerge can put them back
+func (m *RestaurantMerge) RecordFavorites(moved, dropped []uuid.UUID) {
+	m.movedFavoriteIDs = moved
+	m.droppedFavoriteIDs = dropped
+}
+
+// Unmerge marks the merge as undone
+func (m *RestaurantMerge) Unmerge() {
+	now := time.Now()
+	m.unmergedAt = &now
+}
+
+// IsActive checks if the merge is still in effect
+func (m *RestaurantMerge) IsActive() bool {
+	return m.unmergedAt == nil
+}
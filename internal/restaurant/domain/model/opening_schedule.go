@@ -0,0 +1,133 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ScheduleLocation is the timezone opening periods are expressed in.
+// Japan has no daylight saving time, so a fixed offset avoids depending on
+// the tz database being installed.
+var ScheduleLocation = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+
+	holidayLayout = "2006-01-02"
+)
+
+// WeekTime is a time of the week in ScheduleLocation
+type WeekTime struct {
+	Day    time.Weekday `json:"day"` // 0 is Sunday
+	Hour   int          `json:"hour"`
+	Minute int          `json:"minute"`
+}
+
+// MinuteOfWeek returns the minutes since Sunday 00:00
+func (t WeekTime) MinuteOfWeek() int {
+	return int(t.Day)*minutesPerDay + t.Hour*60 + t.Minute
+}
+
+func (t WeekTime) valid() bool {
+	return t.Day >= time.Sunday && t.Day <= time.Saturday &&
+		t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60
+}
+
+// OpeningPeriod is a span a restaurant is open. A period closing at or before
+// its opening time runs overnight, e.g. Friday 18:00 to Saturday 02:00 or
+// Saturday 22:00 to Sunday 03:00. A period without Close is open around the clock.
+type OpeningPeriod struct {
+	Open  WeekTime  `json:"open"`
+	Close *WeekTime `json:"close,omitempty"`
+}
+
+// span returns the start and end of the period in minutes of the week.
+// The end may exceed a week when the period wraps into the next one.
+func (p OpeningPeriod) span() (int, int) {
+	start := p.Open.MinuteOfWeek()
+	if p.Close == nil {
+		return start, start + minutesPerWeek
+	}
+	end := p.Close.MinuteOfWeek()
+	if end <= start {
+		end += minutesPerWeek
+	}
+	return start, end
+}
+
+// OpeningSchedule is a restaurant's weekly opening hours with any number of
+// periods per day, and the holidays on which it stays closed
+type OpeningSchedule struct {
+	periods  []OpeningPeriod
+	holidays []string // YYYY-MM-DD in ScheduleLocation, sorted
+}
+
+// NewOpeningSchedule creates a schedule from weekly periods and holiday dates
+// (YYYY-MM-DD). A period opening on a holiday is skipped, including the part
+// of an overnight period that runs into the next day.
+func NewOpeningSchedule(periods []OpeningPeriod, holidays []string) (*OpeningSchedule, error) {
+	for _, p := range periods {
+		if !p.Open.valid() || (p.Close != nil && !p.Close.valid()) {
+			return nil, errors.New("opening period times must be a weekday, an hour 0-23 and a minute 0-59")
+		}
+	}
+
+	dates := make([]string, 0, len(holidays))
+	seen := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		if _, err := time.Parse(holidayLayout, h); err != nil {
+			return nil, errors.New("holidays must be dates formatted as YYYY-MM-DD")
+		}
+		if !seen[h] {
+			seen[h] = true
+			dates = append(dates, h)
+		}
+	}
+	sort.Strings(dates)
+
+	if periods == nil {
+		periods = []OpeningPeriod{}
+	}
+	return &OpeningSchedule{periods: periods, holidays: dates}, nil
+}
+
+// Getters
+func (s *OpeningSchedule) Periods() []OpeningPeriod { return s.periods }
+func (s *OpeningSchedule) Holidays() []string       { return s.holidays }
+
+// IsOpenAt checks if the restaurant is open at the given instant
+func (s *OpeningSchedule) IsOpenAt(t time.Time) bool {
+	local := t.In(ScheduleLocation)
+	now := local.Hour()*60 + local.Minute() + int(local.Weekday())*minutesPerDay
+
+	for _, p := range s.periods {
+		start, end := p.span()
+		at := now
+		if at < start {
+			// Only reachable through last week's occurrence wrapping over
+			at += minutesPerWeek
+		}
+		if at >= end {
+			continue
+		}
+
+		opened := local
+		if p.Close != nil {
+			opened = local.Add(-time.Duration(at-start) * time.Minute)
+		}
+		if s.isHoliday(opened) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func (s *OpeningSchedule) isHoliday(t time.Time) bool {
+	date := t.Format(holidayLayout)
+	i := sort.SearchStrings(s.holidays, date)
+	return i < len(s.holidays) && s.holidays[i] == date
+}
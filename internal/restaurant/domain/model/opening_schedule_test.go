@@ -0,0 +1,106 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func period(openDay time.Weekday, openHour int, closeDay time.Weekday, closeHour int) OpeningPeriod {
+	return OpeningPeriod{
+		Open:  WeekTime{Day: openDay, Hour: openHour},
+		Close: &WeekTime{Day: closeDay, Hour: closeHour},
+	}
+}
+
+// tokyo returns a time on the week of Monday 2026-10-12 in Asia/Tokyo
+func tokyo(day, hour, minute int) time.Time {
+	return time.Date(2026, 10, day, hour, minute, 0, 0, ScheduleLocation)
+}
+
+func TestOpeningSchedule_IsOpenAt(t *testing.T) {
+	schedule, err := NewOpeningSchedule([]OpeningPeriod{
+		// Lunch and dinner on Monday
+		period(time.Monday, 11, time.Monday, 14),
+		period(time.Monday, 17, time.Monday, 22),
+		// Friday night into Saturday
+		period(time.Friday, 18, time.Saturday, 2),
+		// Saturday night into Sunday, wrapping the end of the week
+		period(time.Saturday, 22, time.Sunday, 3),
+	}, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"lunch", tokyo(12, 12, 30), true},
+		{"between lunch and dinner", tokyo(12, 15, 0), false},
+		{"dinner", tokyo(12, 21, 59), true},
+		{"closing time", tokyo(12, 22, 0), false},
+		{"opening time", tokyo(12, 11, 0), true},
+		{"closed day", tokyo(14, 12, 0), false},
+		{"overnight before midnight", tokyo(16, 23, 0), true},
+		{"overnight after midnight", tokyo(17, 1, 30), true},
+		{"after overnight close", tokyo(17, 2, 0), false},
+		{"saturday late", tokyo(17, 23, 0), true},
+		{"sunday early across week boundary", tokyo(18, 2, 0), true},
+		{"sunday after close", tokyo(18, 3, 0), false},
+		{"converted from UTC", time.Date(2026, 10, 12, 3, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, schedule.IsOpenAt(tt.at))
+		})
+	}
+}
+
+func TestOpeningSchedule_AlwaysOpen(t *testing.T) {
+	schedule, err := NewOpeningSchedule([]OpeningPeriod{{Open: WeekTime{Day: time.Sunday}}}, nil)
+	require.NoError(t, err)
+
+	assert.True(t, schedule.IsOpenAt(tokyo(14, 4, 0)))
+	assert.True(t, schedule.IsOpenAt(tokyo(18, 23, 59)))
+}
+
+func TestOpeningSchedule_Holidays(t *testing.T) {
+	schedule, err := NewOpeningSchedule([]OpeningPeriod{
+		period(time.Friday, 18, time.Saturday, 2),
+		period(time.Saturday, 18, time.Sunday, 2),
+	}, []string{"2026-10-16", "2026-10-16"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2026-10-16"}, schedule.Holidays())
+	assert.False(t, schedule.IsOpenAt(tokyo(16, 20, 0)))
+	// The overnight part of a period opening on a holiday is closed too
+	assert.False(t, schedule.IsOpenAt(tokyo(17, 1, 0)))
+	assert.True(t, schedule.IsOpenAt(tokyo(17, 19, 0)))
+	// The same weekday in other weeks is unaffected
+	assert.True(t, schedule.IsOpenAt(tokyo(23, 20, 0)))
+}
+
+func TestNewOpeningSchedule_Invalid(t *testing.T) {
+	_, err := NewOpeningSchedule([]OpeningPeriod{{Open: WeekTime{Day: time.Monday, Hour: 24}}}, nil)
+	assert.Error(t, err)
+
+	_, err = NewOpeningSchedule(nil, []string{"16/10/2026"})
+	assert.Error(t, err)
+}
+
+func TestRestaurant_IsOpenAt(t *testing.T) {
+	location, _ := NewLocation(35.6595, 139.7005)
+	r := NewRestaurant("Ichiran", "Shibuya", SourceGoogle, "g1", "", location)
+
+	_, ok := r.IsOpenAt(tokyo(12, 12, 0))
+	assert.False(t, ok)
+
+	schedule, _ := NewOpeningSchedule([]OpeningPeriod{period(time.Monday, 11, time.Monday, 14)}, nil)
+	r.UpdateOpeningSchedule(schedule)
+
+	open, ok := r.IsOpenAt(tokyo(12, 12, 0))
+	assert.True(t, ok)
+	assert.True(t, open)
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PhotoSize selects the stored rendition of a photo
+type PhotoSize string
+
+const (
+	PhotoSizeFull      PhotoSize = "full"
+	PhotoSizeThumbnail PhotoSize = "thumb"
+)
+
+// IsValid checks if the size is a known rendition
+func (s PhotoSize) IsValid() bool {
+	return s == PhotoSizeFull || s == PhotoSizeThumbnail
+}
+
+// Photo is a restaurant image copied from its source into our blob storage,
+// along with a JPEG thumbnail. SourceRef identifies the image at the source
+// (a Google photo name or a Tabelog image URL) so it is only ingested once.
+type Photo struct {
+	id           uuid.UUID
+	restaurantID uuid.UUID
+	source       RestaurantSource
+	sourceRef    string
+	contentType  string
+	width        int
+	height       int
+	blobKey      string
+	thumbnailKey string
+	createdAt    time.Time
+}
+
+// NewPhoto creates a photo of an ingested image and assigns its blob keys
+func NewPhoto(restaurantID uuid.UUID, source RestaurantSource, sourceRef, contentType string, width, height int) *Photo {
+	id := uuid.New()
+	prefix := fmt.Sprintf("restaurants/%s/photos/%s", restaurantID, id)
+
+	return &Photo{
+		id:           id,
+		restaurantID: restaurantID,
+		source:       source,
+		sourceRef:    sourceRef,
+		contentType:  contentType,
+		width:        width,
+		height:       height,
+		blobKey:      prefix,
+		thumbnailKey: prefix + "_thumb.jpg",
+		createdAt:    time.Now(),
+	}
+}
+
+// ReconstructPhoto is used by repository to reconstruct the Photo entity from persistence
+// This should NOT be used by application layer to create new photos
+func ReconstructPhoto(
+	id uuid.UUID,
+	restaurantID uuid.UUID,
+	source RestaurantSource,
+	sourceRef string,
+	contentType string,
+	width int,
+	height int,
+	blobKey string,
+	thumbnailKey string,
+	createdAt time.Time,
+) *Photo {
+	return &Photo{
+		id:           id,
+		restaurantID: restaurantID,
+		source:       source,
+		sourceRef:    sourceRef,
+		contentType:  contentType,
+		width:        width,
+		height:       height,
+		blobKey:      blobKey,
+		thumbnailKey: thumbnailKey,
+		createdAt:    createdAt,
+	}
+}
+
+// Getters
+func (p *Photo) ID() uuid.UUID            { return p.id }
+func (p *Photo) RestaurantID() uuid.UUID  { return p.restaurantID }
+func (p *Photo) Source() RestaurantSource { return p.source }
+func (p *Photo) SourceRef() string        { return p.sourceRef }
+func (p *Photo) ContentType() string      { return p.contentType }
+func (p *Photo) Width() int               { return p.width }
+func (p *Photo) Height() int              { return p.height }
+func (p *Photo) BlobKey() string          { return p.blobKey }
+func (p *Photo) ThumbnailKey() string     { return p.thumbnailKey }
+func (p *Photo) CreatedAt() time.Time     { return p.createdAt }
+
+// Rendition returns the blob key and content type of the given size
+func (p *Photo) Rendition(size PhotoSize) (key string, contentType string) {
+	if size == PhotoSizeThumbnail {
+		return p.thumbnailKey, "image/jpeg"
+	}
+	return p.blobKey, p.contentType
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// PopularityEvent is a user interaction counted towards a restaurant's popularity
+type PopularityEvent string
+
+const (
+	PopularityEventView     PopularityEvent = "view"
+	PopularityEventFavorite PopularityEvent = "favorite"
+	PopularityEventVisit    PopularityEvent = "visit"
+)
+
+// PopularityBucketSize is the width of the time buckets events are counted in
+const PopularityBucketSize = time.Hour
+
+// IsValid checks if the event is a counted interaction
+func (e PopularityEvent) IsValid() bool {
+	switch e {
+	case PopularityEventView, PopularityEventFavorite, PopularityEventVisit:
+		return true
+	}
+	return false
+}
+
+// Weight is how much one event adds to the trending score. Favorites and
+// visits take more effort than a page view, so they count for more.
+func (e PopularityEvent) Weight() float64 {
+	switch e {
+	case PopularityEventFavorite:
+		return 5
+	case PopularityEventVisit:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// PopularityBucket returns the start of the bucket the time falls in
+func PopularityBucket(t time.Time) time.Time {
+	return t.UTC().Truncate(PopularityBucketSize)
+}
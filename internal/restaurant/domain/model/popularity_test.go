@@ -0,0 +1,25 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopularityBucket(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	at := time.Date(2024, 5, 1, 21, 45, 30, 0, tokyo)
+
+	bucket := PopularityBucket(at)
+
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), bucket)
+	assert.Equal(t, time.UTC, bucket.Location())
+}
+
+func TestPopularityEvent_Weight(t *testing.T) {
+	assert.Greater(t, PopularityEventFavorite.Weight(), PopularityEventVisit.Weight())
+	assert.Greater(t, PopularityEventVisit.Weight(), PopularityEventView.Weight())
+	assert.True(t, PopularityEventVisit.IsValid())
+	assert.False(t, PopularityEvent("share").IsValid())
+}
@@ -0,0 +1,203 @@
+package model
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// MaxPriceLevel is the most expensive price level ("$$$$")
+const MaxPriceLevel = 4
+
+// maxPriceTextLength is the length original price texts are cut to
+const maxPriceTextLength = 100
+
+// Meal is the meal a price band applies to
+type Meal string
+
+const (
+	MealLunch  Meal = "lunch"
+	MealDinner Meal = "dinner"
+)
+
+// IsValid checks if the meal is valid
+func (m Meal) IsValid() bool {
+	return m == MealLunch || m == MealDinner
+}
+
+// priceLevelCeilings are the highest per-person yen amounts of price levels
+// 1 to 3; anything above is level 4
+var priceLevelCeilings = [MaxPriceLevel - 1]int{1999, 4999, 9999}
+
+// PriceLevelForYen returns the price level of a per-person amount in yen
+func PriceLevelForYen(yen int) int {
+	for i, ceiling := range priceLevelCeilings {
+		if yen <= ceiling {
+			return i + 1
+		}
+	}
+	return MaxPriceLevel
+}
+
+// PriceBand is a per-person price range in yen
+type PriceBand struct {
+	Min int
+	Max int // 0 when the band has no upper bound, as in "￥30,000～"
+}
+
+// NewPriceBand creates a price band
+func NewPriceBand(min, max int) (*PriceBand, error) {
+	if min < 0 || max < 0 {
+		return nil, errors.New("price band must not be negative")
+	}
+	if max > 0 && max < min {
+		return nil, errors.New("price band max must not be below min")
+	}
+	if min == 0 && max == 0 {
+		return nil, errors.New("price band needs a bound")
+	}
+	return &PriceBand{Min: min, Max: max}, nil
+}
+
+// level is the price level of the band's upper bound, or of its lower
+// bound when it is open-ended
+func (b *PriceBand) level() int {
+	if b.Max > 0 {
+		return PriceLevelForYen(b.Max)
+	}
+	return PriceLevelForYen(b.Min)
+}
+
+// ParseYenBand parses a yen range as shown on Tabelog: "￥3,000～￥3,999",
+// "～￥999" or "￥30,000～". It returns nil for text without an amount, such
+// as the "-" Tabelog shows for an unknown budget.
+func ParseYenBand(text string) *PriceBand {
+	text = strings.NewReplacer("￥", "", "¥", "", "円", "", ",", "", "，", "", " ", "", "　", "").Replace(text)
+	if text == "" {
+		return nil
+	}
+
+	lower, upper, isRange := text, "", false
+	for _, sep := range []string{"～", "〜", "~", "-"} {
+		if i := strings.Index(text, sep); i >= 0 {
+			lower, upper, isRange = text[:i], text[i+len(sep):], true
+			break
+		}
+	}
+
+	min, err := parseYen(lower)
+	if err != nil {
+		return nil
+	}
+	max := min
+	if isRange {
+		if max, err = parseYen(upper); err != nil {
+			return nil
+		}
+	}
+
+	band, err := NewPriceBand(min, max)
+	if err != nil {
+		return nil
+	}
+	return band
+}
+
+// parseYen parses a yen amount; an empty amount is an open bound
+func parseYen(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+// Price is the normalized price of a restaurant: a level from 1 ("$") to 4
+// ("$$$$"), the per-person lunch and dinner bands in yen when the source
+// gives them, and the source's original text
+type Price struct {
+	level  int
+	lunch  *PriceBand
+	dinner *PriceBand
+	text   string
+}
+
+// NewPrice creates a price. A zero level is derived from the dinner band,
+// or the lunch band when there is no dinner band.
+func NewPrice(level int, lunch, dinner *PriceBand, text string) (*Price, error) {
+	if level < 0 || level > MaxPriceLevel {
+		return nil, errors.New("price level must be between 1 and 4")
+	}
+	if level == 0 {
+		switch {
+		case dinner != nil:
+			level = dinner.level()
+		case lunch != nil:
+			level = lunch.level()
+		}
+	}
+	if level == 0 && text == "" {
+		return nil, errors.New("price needs a level, a band or a text")
+	}
+
+	return &Price{
+		level:  level,
+		lunch:  lunch,
+		dinner: dinner,
+		text:   truncatePriceText(text),
+	}, nil
+}
+
+// ReconstructPrice is used by repository to reconstruct the Price from persistence
+func ReconstructPrice(level int, lunch, dinner *PriceBand, text string) *Price {
+	return &Price{
+		level:  level,
+		lunch:  lunch,
+		dinner: dinner,
+		text:   text,
+	}
+}
+
+// ParsePrice normalizes a free-text price range: a level written as "$" to
+// "$$$$" or a yen range such as "￥3,000～￥3,999". Text that is neither is
+// kept without a level. It returns nil for empty text.
+func ParsePrice(text string) *Price {
+	text = truncatePriceText(strings.TrimSpace(text))
+	if text == "" {
+		return nil
+	}
+
+	if len(text) <= MaxPriceLevel && strings.Trim(text, "$") == "" {
+		return &Price{level: len(text), text: text}
+	}
+	if band := ParseYenBand(text); band != nil {
+		return &Price{level: band.level(), text: text}
+	}
+	return &Price{text: text}
+}
+
+func truncatePriceText(text string) string {
+	if runes := []rune(text); len(runes) > maxPriceTextLength {
+		return string(runes[:maxPriceTextLength])
+	}
+	return text
+}
+
+// Getters
+func (p *Price) Level() int         { return p.level }
+func (p *Price) Lunch() *PriceBand  { return p.lunch }
+func (p *Price) Dinner() *PriceBand { return p.dinner }
+func (p *Price) Text() string       { return p.text }
+
+// HasBands reports whether the price has a lunch or dinner band
+func (p *Price) HasBands() bool {
+	return p.lunch != nil || p.dinner != nil
+}
+
+// Symbols returns the level as "$" to "$$$$", or "" for a nil price or one
+// without a level
+func (p *Price) Symbols() string {
+	if p == nil {
+		return ""
+	}
+	return strings.Repeat("$", p.level)
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseYenBand(t *testing.T) {
+	tests := []struct {
+		text string
+		want *PriceBand
+	}{
+		{"￥3,000～￥3,999", &PriceBand{Min: 3000, Max: 3999}},
+		{"～￥999", &PriceBand{Min: 0, Max: 999}},
+		{"￥30,000～", &PriceBand{Min: 30000, Max: 0}},
+		{"¥1000~¥1999", &PriceBand{Min: 1000, Max: 1999}},
+		{"1,500円", &PriceBand{Min: 1500, Max: 1500}},
+		{"-", nil},
+		{"", nil},
+		{"￥4,000～￥3,000", nil},
+		{"cheap", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseYenBand(tt.text))
+		})
+	}
+}
+
+func TestPriceLevelForYen(t *testing.T) {
+	assert.Equal(t, 1, PriceLevelForYen(999))
+	assert.Equal(t, 1, PriceLevelForYen(1999))
+	assert.Equal(t, 2, PriceLevelForYen(3999))
+	assert.Equal(t, 3, PriceLevelForYen(9999))
+	assert.Equal(t, 4, PriceLevelForYen(10000))
+}
+
+func TestNewPrice_DerivesLevelFromDinner(t *testing.T) {
+	lunch := &PriceBand{Min: 1000, Max: 1999}
+	dinner := &PriceBand{Min: 15000, Max: 0}
+
+	price, err := NewPrice(0, lunch, dinner, "")
+	require.NoError(t, err)
+	assert.Equal(t, 4, price.Level())
+	assert.Equal(t, "$$$$", price.Symbols())
+
+	price, err = NewPrice(0, lunch, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, price.Level())
+	assert.True(t, price.HasBands())
+}
+
+func TestNewPrice_Invalid(t *testing.T) {
+	_, err := NewPrice(5, nil, nil, "")
+	assert.Error(t, err)
+
+	_, err = NewPrice(0, nil, nil, "")
+	assert.Error(t, err)
+}
+
+func TestParsePrice(t *testing.T) {
+	price := ParsePrice("$$$")
+	require.NotNil(t, price)
+	assert.Equal(t, 3, price.Level())
+
+	price = ParsePrice("￥3,000～￥3,999")
+	require.NotNil(t, price)
+	assert.Equal(t, 2, price.Level())
+	assert.Equal(t, "￥3,000～￥3,999", price.Text())
+	assert.False(t, price.HasBands())
+
+	price = ParsePrice("ask the chef")
+	require.NotNil(t, price)
+	assert.Zero(t, price.Level())
+	assert.Equal(t, "", price.Symbols())
+
+	assert.Nil(t, ParsePrice("  "))
+	assert.Equal(t, "", ParsePrice("").Symbols())
+}
+
+func TestRestaurant_UpdatePrice(t *testing.T) {
+	location, _ := NewLocation(35.6595, 139.7005)
+	restaurant := NewRestaurant("Ichiran", "Tokyo", SourceGoogle, "ext", "", location)
+
+	price, err := NewPrice(0, nil, &PriceBand{Min: 5000, Max: 5999}, "[夜]￥5,000～￥5,999")
+	require.NoError(t, err)
+	restaurant.UpdatePrice(price)
+	assert.Equal(t, "$$$", restaurant.PriceRange())
+	assert.Equal(t, price, restaurant.Price())
+
+	restaurant.UpdatePriceRange("$")
+	assert.Equal(t, "$", restaurant.PriceRange())
+	assert.False(t, restaurant.Price().HasBands())
+
+	restaurant.UpdatePriceRange("")
+	assert.Equal(t, "", restaurant.PriceRange())
+	assert.Nil(t, restaurant.Price())
+}
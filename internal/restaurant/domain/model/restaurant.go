@@ -15,6 +15,11 @@ const (
 	SourceOpenTable RestaurantSource = "opentable"
 )
 
+// IsValid checks if the source is a known data source
+func (s RestaurantSource) IsValid() bool {
+	return s == SourceTabelog || s == SourceGoogle || s == SourceOpenTable
+}
+
 // Restaurant is the aggregate root for the restaurant domain
 type Restaurant struct {
 	id           uuid.UUID
@@ -26,16 +31,24 @@ type Restaurant struct {
 	address      string
 	location     *Location
 	rating       float64
-	priceRange   string
+	priceRange   string // "$" to "$$$$", the level of price
+	price        *Price // nil when no source gave a price
 	cuisineType  string
+	cuisines     []string // Cuisines category IDs
 	phone        string
 	website      string
-	openingHours map[string]string
+	openingHours map[string]string // free-text weekday lines for display
+	schedule     *OpeningSchedule  // nil when the source has no structured hours
 	metadata     map[string]interface{}
 	viewCount    int64
-	createdAt    time.Time
-	updatedAt    time.Time
-	deletedAt    *time.Time
+	tabelog      *TabelogProfile // nil until a Tabelog listing is matched
+	reviews      ReviewSummary   // maintained by the review repository
+	// Display text in the LocalizedLanguages; the DefaultLanguage text is
+	// the fields above
+	localized map[string]LocalizedContent
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
 }
 
 // NewRestaurant creates a new restaurant
@@ -60,11 +73,13 @@ func NewRestaurant(
 		rating:       0.0,
 		priceRange:   "",
 		cuisineType:  "",
+		cuisines:     []string{},
 		phone:        "",
 		website:      "",
 		openingHours: make(map[string]string),
 		metadata:     make(map[string]interface{}),
 		viewCount:    0,
+		localized:    make(map[string]LocalizedContent),
 		createdAt:    now,
 		updatedAt:    now,
 		deletedAt:    nil,
@@ -96,6 +111,7 @@ func NewRestaurantWithDetails(
 	if metadata == nil {
 		metadata = make(map[string]interface{})
 	}
+	price := ParsePrice(priceRange)
 
 	return &Restaurant{
 		id:           uuid.New(),
@@ -107,13 +123,16 @@ func NewRestaurantWithDetails(
 		address:      address,
 		location:     location,
 		rating:       rating,
-		priceRange:   priceRange,
+		priceRange:   price.Symbols(),
+		price:        price,
 		cuisineType:  cuisineType,
+		cuisines:     matchCuisines(nil, cuisineType),
 		phone:        phone,
 		website:      website,
 		openingHours: openingHours,
 		metadata:     metadata,
 		viewCount:    0,
+		localized:    make(map[string]LocalizedContent),
 		createdAt:    now,
 		updatedAt:    now,
 		deletedAt:    nil,
@@ -132,13 +151,18 @@ func ReconstructRestaurant(
 	address string,
 	location *Location,
 	rating float64,
-	priceRange string,
+	price *Price,
 	cuisineType string,
+	cuisines []string,
 	phone string,
 	website string,
 	openingHours map[string]string,
+	schedule *OpeningSchedule,
 	metadata map[string]interface{},
 	viewCount int64,
+	tabelog *TabelogProfile,
+	reviews ReviewSummary,
+	localizations map[string]LocalizedContent,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
@@ -153,13 +177,19 @@ func ReconstructRestaurant(
 		address:      address,
 		location:     location,
 		rating:       rating,
-		priceRange:   priceRange,
+		priceRange:   price.Symbols(),
+		price:        price,
 		cuisineType:  cuisineType,
+		cuisines:     cuisines,
 		phone:        phone,
 		website:      website,
 		openingHours: openingHours,
+		schedule:     schedule,
 		metadata:     metadata,
 		viewCount:    viewCount,
+		tabelog:      tabelog,
+		reviews:      reviews,
+		localized:    localizations,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
 		deletedAt:    deletedAt,
@@ -167,25 +197,30 @@ func ReconstructRestaurant(
 }
 
 // Getters
-func (r *Restaurant) ID() uuid.UUID                    { return r.id }
-func (r *Restaurant) Name() string                     { return r.name }
-func (r *Restaurant) NameJa() string                   { return r.nameJa }
-func (r *Restaurant) Area() string                     { return r.area }
-func (r *Restaurant) Source() RestaurantSource         { return r.source }
-func (r *Restaurant) ExternalID() string               { return r.externalID }
-func (r *Restaurant) Address() string                  { return r.address }
-func (r *Restaurant) Location() *Location              { return r.location }
-func (r *Restaurant) Rating() float64                  { return r.rating }
-func (r *Restaurant) PriceRange() string               { return r.priceRange }
-func (r *Restaurant) CuisineType() string              { return r.cuisineType }
-func (r *Restaurant) Phone() string                    { return r.phone }
-func (r *Restaurant) Website() string                  { return r.website }
-func (r *Restaurant) OpeningHours() map[string]string  { return r.openingHours }
-func (r *Restaurant) Metadata() map[string]interface{} { return r.metadata }
-func (r *Restaurant) ViewCount() int64                 { return r.viewCount }
-func (r *Restaurant) CreatedAt() time.Time             { return r.createdAt }
-func (r *Restaurant) UpdatedAt() time.Time             { return r.updatedAt }
-func (r *Restaurant) DeletedAt() *time.Time            { return r.deletedAt }
+func (r *Restaurant) ID() uuid.UUID                     { return r.id }
+func (r *Restaurant) Name() string                      { return r.name }
+func (r *Restaurant) NameJa() string                    { return r.nameJa }
+func (r *Restaurant) Area() string                      { return r.area }
+func (r *Restaurant) Source() RestaurantSource          { return r.source }
+func (r *Restaurant) ExternalID() string                { return r.externalID }
+func (r *Restaurant) Address() string                   { return r.address }
+func (r *Restaurant) Location() *Location               { return r.location }
+func (r *Restaurant) Rating() float64                   { return r.rating }
+func (r *Restaurant) PriceRange() string                { return r.priceRange }
+func (r *Restaurant) Price() *Price                     { return r.price }
+func (r *Restaurant) CuisineType() string               { return r.cuisineType }
+func (r *Restaurant) Cuisines() []string                { return r.cuisines }
+func (r *Restaurant) Phone() string                     { return r.phone }
+func (r *Restaurant) Website() string                   { return r.website }
+func (r *Restaurant) OpeningHours() map[string]string   { return r.openingHours }
+func (r *Restaurant) OpeningSchedule() *OpeningSchedule { return r.schedule }
+func (r *Restaurant) Metadata() map[string]interface{}  { return r.metadata }
+func (r *Restaurant) ViewCount() int64                  { return r.viewCount }
+func (r *Restaurant) Tabelog() *TabelogProfile          { return r.tabelog }
+func (r *Restaurant) Reviews() ReviewSummary            { return r.reviews }
+func (r *Restaurant) CreatedAt() time.Time              { return r.createdAt }
+func (r *Restaurant) UpdatedAt() time.Time              { return r.updatedAt }
+func (r *Restaurant) DeletedAt() *time.Time             { return r.deletedAt }
 
 // Domain Methods
 
@@ -225,18 +260,52 @@ func (r *Restaurant) UpdateArea(area string) {
 	r.updatedAt = time.Now()
 }
 
-// UpdatePriceRange updates the price range
+// UpdatePriceRange updates the price from free text, see ParsePrice
 func (r *Restaurant) UpdatePriceRange(priceRange string) {
-	r.priceRange = priceRange
+	r.UpdatePrice(ParsePrice(priceRange))
+}
+
+// UpdatePrice updates the price; the price range follows its level
+func (r *Restaurant) UpdatePrice(price *Price) {
+	r.price = price
+	r.priceRange = price.Symbols()
 	r.updatedAt = time.Now()
 }
 
-// UpdateCuisineType updates the cuisine type
+// UpdateCuisineType updates the cuisine type and adds the category it
+// names, if any
 func (r *Restaurant) UpdateCuisineType(cuisineType string) {
 	r.cuisineType = cuisineType
+	r.cuisines = matchCuisines(r.cuisines, cuisineType)
+	r.updatedAt = time.Now()
+}
+
+// SetCuisines replaces the cuisine categories. ids must be Cuisines
+// category IDs; duplicates are dropped.
+func (r *Restaurant) SetCuisines(ids []string) {
+	r.cuisines = []string{}
+	r.AddCuisines(ids...)
+}
+
+// AddCuisines adds cuisine categories the restaurant is not in yet
+func (r *Restaurant) AddCuisines(ids ...string) {
+	for _, id := range ids {
+		r.cuisines = appendCuisine(r.cuisines, id)
+	}
 	r.updatedAt = time.Now()
 }
 
+// matchCuisines adds the category cuisineType names to cuisines
+func matchCuisines(cuisines []string, cuisineType string) []string {
+	if cuisines == nil {
+		cuisines = []string{}
+	}
+	if category, ok := Cuisines.Match(cuisineType); ok {
+		cuisines = appendCuisine(cuisines, category.ID)
+	}
+	return cuisines
+}
+
 // UpdatePhone updates the phone number
 func (r *Restaurant) UpdatePhone(phone string) {
 	r.phone = phone
@@ -266,10 +335,12 @@ func (r *Restaurant) UpdateDetails(
 		r.address = address
 	}
 	if priceRange != "" {
-		r.priceRange = priceRange
+		r.price = ParsePrice(priceRange)
+		r.priceRange = r.price.Symbols()
 	}
 	if cuisineType != "" {
 		r.cuisineType = cuisineType
+		r.cuisines = matchCuisines(r.cuisines, cuisineType)
 	}
 	if phone != "" {
 		r.phone = phone
@@ -305,6 +376,23 @@ func (r *Restaurant) UpdateOpeningHours(openingHours map[string]string) {
 	}
 }
 
+// UpdateOpeningSchedule replaces the structured weekly opening hours
+func (r *Restaurant) UpdateOpeningSchedule(schedule *OpeningSchedule) {
+	if schedule != nil {
+		r.schedule = schedule
+		r.updatedAt = time.Now()
+	}
+}
+
+// IsOpenAt checks if the restaurant is open at the given instant. ok is
+// false when the restaurant has no structured opening hours.
+func (r *Restaurant) IsOpenAt(t time.Time) (open bool, ok bool) {
+	if r.schedule == nil {
+		return false, false
+	}
+	return r.schedule.IsOpenAt(t), true
+}
+
 // SetMetadata sets a metadata key-value pair
 func (r *Restaurant) SetMetadata(key string, value interface{}) {
 	if r.metadata == nil {
@@ -322,6 +410,75 @@ func (r *Restaurant) UpdateMetadata(metadata map[string]interface{}) {
 	}
 }
 
+// AttachTabelog links the restaurant to a Tabelog listing, replacing any previous one
+func (r *Restaurant) AttachTabelog(profile *TabelogProfile) {
+	r.tabelog = profile
+	r.updatedAt = time.Now()
+}
+
+// DetachTabelog removes the Tabelog listing
+func (r *Restaurant) DetachTabelog() {
+	r.tabelog = nil
+	r.updatedAt = time.Now()
+}
+
+// Localizations returns the stored text keyed by language
+func (r *Restaurant) Localizations() map[string]LocalizedContent {
+	return r.localized
+}
+
+// Localization returns the stored text in lang, without fallbacks
+func (r *Restaurant) Localization(lang string) LocalizedContent {
+	return r.localized[lang]
+}
+
+// SetLocalization replaces the text in lang; empty content removes it.
+// Callers check lang with IsLocalizedLanguage.
+func (r *Restaurant) SetLocalization(lang string, content LocalizedContent) {
+	if r.localized == nil {
+		r.localized = make(map[string]LocalizedContent)
+	}
+	if content.IsEmpty() {
+		delete(r.localized, lang)
+	} else {
+		r.localized[lang] = content
+	}
+	// The Japanese name doubles as the Tabelog search name
+	if lang == LanguageJapanese && r.nameJa == "" && content.Name != "" {
+		r.nameJa = content.Name
+	}
+	r.updatedAt = time.Now()
+}
+
+// MergeLocalization updates the text in lang with the non-empty fields of
+// content, keeping the others
+func (r *Restaurant) MergeLocalization(lang string, content LocalizedContent) {
+	r.SetLocalization(lang, content.merge(r.localized[lang]))
+}
+
+// Localize returns the restaurant's text in the first of langs that has
+// it, field by field, falling back to the DefaultLanguage fields
+func (r *Restaurant) Localize(langs ...string) LocalizedContent {
+	var content LocalizedContent
+	for _, lang := range langs {
+		if lang == DefaultLanguage {
+			break
+		}
+		content = content.merge(r.localized[lang])
+		if lang == LanguageJapanese {
+			content = content.merge(LocalizedContent{Name: r.nameJa})
+		}
+	}
+
+	summary, _ := r.metadata["editorial_summary"].(string)
+	return content.merge(LocalizedContent{
+		Name:         r.name,
+		Address:      r.address,
+		CuisineLabel: r.cuisineType,
+		Summary:      summary,
+	})
+}
+
 // SoftDelete marks the restaurant as deleted
 func (r *Restaurant) SoftDelete() {
 	now := time.Now()
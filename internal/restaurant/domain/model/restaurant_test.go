@@ -218,13 +218,18 @@ func TestReconstructRestaurant(t *testing.T) {
 		"Test Address",
 		location,
 		4.5,
-		"$$",
+		ParsePrice("$$"),
 		"Sushi",
+		[]string{"sushi"},
 		"03-1234-5678",
 		"https://example.com",
 		openingHours,
+		nil,
 		metadata,
 		100,
+		nil,
+		ReviewSummary{Average: 4.2, Count: 7},
+		map[string]LocalizedContent{LanguageJapanese: {Name: "テスト"}},
 		createdAt,
 		updatedAt,
 		&deletedAt,
@@ -239,17 +244,72 @@ func TestReconstructRestaurant(t *testing.T) {
 	assert.Equal(t, 4.5, restaurant.Rating())
 	assert.Equal(t, "$$", restaurant.PriceRange())
 	assert.Equal(t, "Sushi", restaurant.CuisineType())
+	assert.Equal(t, []string{"sushi"}, restaurant.Cuisines())
 	assert.Equal(t, "03-1234-5678", restaurant.Phone())
 	assert.Equal(t, "https://example.com", restaurant.Website())
 	assert.Equal(t, openingHours, restaurant.OpeningHours())
 	assert.Equal(t, metadata, restaurant.Metadata())
 	assert.Equal(t, int64(100), restaurant.ViewCount())
+	assert.Equal(t, ReviewSummary{Average: 4.2, Count: 7}, restaurant.Reviews())
+	assert.Equal(t, "テスト", restaurant.Localization(LanguageJapanese).Name)
 	assert.Equal(t, createdAt, restaurant.CreatedAt())
 	assert.Equal(t, updatedAt, restaurant.UpdatedAt())
 	assert.Equal(t, &deletedAt, restaurant.DeletedAt())
 	assert.True(t, restaurant.IsDeleted())
 }
 
+func TestRestaurant_Localize(t *testing.T) {
+	restaurant := createTestRestaurant(t)
+	restaurant.UpdateCuisineType("Ramen")
+	restaurant.SetMetadata("editorial_summary", "Rich tonkotsu ramen.")
+	restaurant.SetLocalization(LanguageJapanese, LocalizedContent{
+		Name:    "一蘭 渋谷店",
+		Address: "東京都渋谷区神南1-22-7",
+	})
+	restaurant.SetLocalization(LanguageTraditionalChinese, LocalizedContent{
+		Name: "一蘭 澀谷店",
+	})
+
+	t.Run("default language", func(t *testing.T) {
+		content := restaurant.Localize(LanguageEnglish)
+		assert.Equal(t, restaurant.Name(), content.Name)
+		assert.Equal(t, restaurant.Address(), content.Address)
+		assert.Equal(t, "Ramen", content.CuisineLabel)
+		assert.Equal(t, "Rich tonkotsu ramen.", content.Summary)
+	})
+
+	t.Run("falls back field by field", func(t *testing.T) {
+		content := restaurant.Localize(LanguageTraditionalChinese, LanguageJapanese)
+		assert.Equal(t, "一蘭 澀谷店", content.Name)
+		assert.Equal(t, "東京都渋谷区神南1-22-7", content.Address)
+		assert.Equal(t, "Ramen", content.CuisineLabel)
+	})
+
+	t.Run("default language ends the chain", func(t *testing.T) {
+		content := restaurant.Localize(LanguageEnglish, LanguageJapanese)
+		assert.Equal(t, restaurant.Name(), content.Name)
+	})
+
+	t.Run("no languages", func(t *testing.T) {
+		assert.Equal(t, restaurant.Name(), restaurant.Localize().Name)
+	})
+}
+
+func TestRestaurant_SetLocalization(t *testing.T) {
+	restaurant := createTestRestaurant(t)
+
+	restaurant.SetLocalization(LanguageJapanese, LocalizedContent{Name: "一蘭", CuisineLabel: "ラーメン"})
+	assert.Equal(t, "一蘭", restaurant.NameJa(), "the Japanese name fills an empty name_ja")
+
+	restaurant.MergeLocalization(LanguageJapanese, LocalizedContent{Name: "一蘭 渋谷店", Address: "渋谷区"})
+	assert.Equal(t, LocalizedContent{Name: "一蘭 渋谷店", Address: "渋谷区", CuisineLabel: "ラーメン"},
+		restaurant.Localization(LanguageJapanese))
+	assert.Equal(t, "一蘭", restaurant.NameJa(), "an existing name_ja is kept")
+
+	restaurant.SetLocalization(LanguageJapanese, LocalizedContent{})
+	assert.Empty(t, restaurant.Localizations())
+}
+
 // Helper function
 func createTestRestaurant(t *testing.T) *Restaurant {
 	location, err := NewLocation(35.6762, 139.6503)
@@ -0,0 +1,190 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limits of a review
+const (
+	MinReviewScore      = 1
+	MaxReviewScore      = 5
+	MaxReviewTextLength = 5000
+)
+
+// ReviewStatus is the moderation state of a review
+type ReviewStatus string
+
+const (
+	ReviewVisible ReviewStatus = "visible"
+	ReviewHidden  ReviewStatus = "hidden"
+)
+
+// IsValid checks if the status is a known review status
+func (s ReviewStatus) IsValid() bool {
+	return s == ReviewVisible || s == ReviewHidden
+}
+
+// ReviewSummary is the rating of a restaurant computed from the visible
+// reviews of our users, kept apart from the rating of the data source
+type ReviewSummary struct {
+	Average float64 // 0 when there are no reviews
+	Count   int
+}
+
+// Review is a user's review of a restaurant. A user writes at most one review
+// per restaurant and edits it afterwards; every edit keeps the previous
+// version as a ReviewEdit.
+type Review struct {
+	id             uuid.UUID
+	userID         uuid.UUID
+	restaurantID   uuid.UUID
+	score          int
+	text           string
+	language       string // BCP 47 tag such as "ja" or "en-US", empty when unknown
+	status         ReviewStatus
+	moderatorID    string
+	moderationNote string
+	moderatedAt    *time.Time
+	helpfulCount   int
+	createdAt      time.Time
+	updatedAt      time.Time
+	editedAt       *time.Time // nil until the author edits the review
+}
+
+// NewReview creates a visible review
+func NewReview(userID, restaurantID uuid.UUID, score int, text, language string) *Review {
+	now := time.Now()
+	return &Review{
+		id:           uuid.New(),
+		userID:       userID,
+		restaurantID: restaurantID,
+		score:        score,
+		text:         strings.TrimSpace(text),
+		language:     language,
+		status:       ReviewVisible,
+		createdAt:    now,
+		updatedAt:    now,
+	}
+}
+
+// ReconstructReview is used by repository to reconstruct the Review entity from persistence
+// This should NOT be used by application layer to create new reviews
+func ReconstructReview(
+	id uuid.UUID,
+	userID uuid.UUID,
+	restaurantID uuid.UUID,
+	score int,
+	text string,
+	language string,
+	status ReviewStatus,
+	moderatorID string,
+	moderationNote string,
+	moderatedAt *time.Time,
+	helpfulCount int,
+	createdAt time.Time,
+	updatedAt time.Time,
+	editedAt *time.Time,
+) *Review {
+	return &Review{
+		id:             id,
+		userID:         userID,
+		restaurantID:   restaurantID,
+		score:          score,
+		text:           text,
+		language:       language,
+		status:         status,
+		moderatorID:    moderatorID,
+		moderationNote: moderationNote,
+		moderatedAt:    moderatedAt,
+		helpfulCount:   helpfulCount,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		editedAt:       editedAt,
+	}
+}
+
+// Getters
+func (r *Review) ID() uuid.UUID           { return r.id }
+func (r *Review) UserID() uuid.UUID       { return r.userID }
+func (r *Review) RestaurantID() uuid.UUID { return r.restaurantID }
+func (r *Review) Score() int              { return r.score }
+func (r *Review) Text() string            { return r.text }
+func (r *Review) Language() string        { return r.language }
+func (r *Review) Status() ReviewStatus    { return r.status }
+func (r *Review) ModeratorID() string     { return r.moderatorID }
+func (r *Review) ModerationNote() string  { return r.moderationNote }
+func (r *Review) ModeratedAt() *time.Time { return r.moderatedAt }
+func (r *Review) HelpfulCount() int       { return r.helpfulCount }
+func (r *Review) CreatedAt() time.Time    { return r.createdAt }
+func (r *Review) UpdatedAt() time.Time    { return r.updatedAt }
+func (r *Review) EditedAt() *time.Time    { return r.editedAt }
+
+// Domain Methods
+
+// Edit replaces the score, text and language, returning the previous version.
+// It returns nil and leaves the review untouched when nothing changes.
+func (r *Review) Edit(score int, text, language string) *ReviewEdit {
+	text = strings.TrimSpace(text)
+	if score == r.score && text == r.text && language == r.language {
+		return nil
+	}
+
+	now := time.Now()
+	previous := &ReviewEdit{
+		ID:       uuid.New(),
+		ReviewID: r.id,
+		Score:    r.score,
+		Text:     r.text,
+		Language: r.language,
+		EditedAt: now,
+	}
+
+	r.score = score
+	r.text = text
+	r.language = language
+	r.editedAt = &now
+	r.updatedAt = now
+	return previous
+}
+
+// Hide takes the review out of listings and the restaurant's rating
+func (r *Review) Hide(moderatorID, note string) {
+	r.moderate(ReviewHidden, moderatorID, note)
+}
+
+// Restore makes a hidden review visible again
+func (r *Review) Restore(moderatorID, note string) {
+	r.moderate(ReviewVisible, moderatorID, note)
+}
+
+func (r *Review) moderate(status ReviewStatus, moderatorID, note string) {
+	now := time.Now()
+	r.status = status
+	r.moderatorID = moderatorID
+	r.moderationNote = note
+	r.moderatedAt = &now
+	r.updatedAt = now
+}
+
+// IsVisible checks if the review is shown to everyone
+func (r *Review) IsVisible() bool {
+	return r.status == ReviewVisible
+}
+
+// IsEdited checks if the author changed the review after writing it
+func (r *Review) IsEdited() bool {
+	return r.editedAt != nil
+}
+
+// ReviewEdit is a previous version of a review, replaced at EditedAt
+type ReviewEdit struct {
+	ID       uuid.UUID
+	ReviewID uuid.UUID
+	Score    int
+	Text     string
+	Language string
+	EditedAt time.Time
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReview(t *testing.T) {
+	userID := uuid.New()
+	restaurantID := uuid.New()
+
+	review := NewReview(userID, restaurantID, 4, "  Rich broth  ", "ja")
+
+	assert.Equal(t, userID, review.UserID())
+	assert.Equal(t, restaurantID, review.RestaurantID())
+	assert.Equal(t, 4, review.Score())
+	assert.Equal(t, "Rich broth", review.Text())
+	assert.Equal(t, "ja", review.Language())
+	assert.True(t, review.IsVisible())
+	assert.False(t, review.IsEdited())
+	assert.Zero(t, review.HelpfulCount())
+}
+
+func TestReview_Edit(t *testing.T) {
+	review := NewReview(uuid.New(), uuid.New(), 4, "Rich broth", "en")
+
+	edit := review.Edit(3, "Rich broth, but salty", "en")
+
+	require.NotNil(t, edit)
+	assert.Equal(t, review.ID(), edit.ReviewID)
+	assert.Equal(t, 4, edit.Score)
+	assert.Equal(t, "Rich broth", edit.Text)
+	assert.Equal(t, 3, review.Score())
+	assert.Equal(t, "Rich broth, but salty", review.Text())
+	assert.True(t, review.IsEdited())
+	assert.Equal(t, edit.EditedAt, *review.EditedAt())
+}
+
+func TestReview_Edit_Unchanged(t *testing.T) {
+	review := NewReview(uuid.New(), uuid.New(), 4, "Rich broth", "en")
+
+	assert.Nil(t, review.Edit(4, " Rich broth ", "en"))
+	assert.False(t, review.IsEdited())
+}
+
+func TestReview_HideAndRestore(t *testing.T) {
+	review := NewReview(uuid.New(), uuid.New(), 1, "spam", "en")
+
+	review.Hide("admin-1", "advertising")
+
+	assert.Equal(t, ReviewHidden, review.Status())
+	assert.False(t, review.IsVisible())
+	assert.Equal(t, "admin-1", review.ModeratorID())
+	assert.Equal(t, "advertising", review.ModerationNote())
+	assert.NotNil(t, review.ModeratedAt())
+
+	review.Restore("admin-2", "")
+
+	assert.True(t, review.IsVisible())
+	assert.Equal(t, "admin-2", review.ModeratorID())
+}
+
+func TestReviewStatus_IsValid(t *testing.T) {
+	assert.True(t, ReviewVisible.IsValid())
+	assert.True(t, ReviewHidden.IsValid())
+	assert.False(t, ReviewStatus("deleted").IsValid())
+}
@@ -0,0 +1,225 @@
+package model
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevisionSource is what caused a restaurant revision
+type RevisionSource string
+
+const (
+	RevisionSourceUserEdit   RevisionSource = "user_edit"
+	RevisionSourceMapRefresh RevisionSource = "map_refresh"
+	RevisionSourceScrape     RevisionSource = "scrape"
+	RevisionSourceRevert     RevisionSource = "revert"
+	RevisionSourceImport     RevisionSource = "import"
+)
+
+// Tracked restaurant fields, in the order changes are reported
+const (
+	FieldName          = "name"
+	FieldNameJa        = "name_ja"
+	FieldArea          = "area"
+	FieldAddress       = "address"
+	FieldRating        = "rating"
+	FieldPriceRange    = "price_range"
+	FieldCuisineType   = "cuisine_type"
+	FieldCuisines      = "cuisines"
+	FieldPhone         = "phone"
+	FieldWebsite       = "website"
+	FieldLatitude      = "latitude"
+	FieldLongitude     = "longitude"
+	FieldTabelogURL    = "tabelog_url"
+	FieldTabelogRating = "tabelog_rating"
+)
+
+var trackedFields = []string{
+	FieldName, FieldNameJa, FieldArea, FieldAddress, FieldRating, FieldPriceRange,
+	FieldCuisineType, FieldCuisines, FieldPhone, FieldWebsite, FieldLatitude, FieldLongitude,
+	FieldTabelogURL, FieldTabelogRating,
+}
+
+// ErrFieldNotRestorable is returned when restoring a field that is recorded
+// but cannot be set from its recorded value alone (the Tabelog listing)
+var ErrFieldNotRestorable = errors.New("field cannot be restored from a revision")
+
+// FieldChange is the before and after value of one field. Values are
+// formatted as strings; an empty string means unset.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// RestaurantRevision is an append-only record of changes made to a restaurant
+type RestaurantRevision struct {
+	id           uuid.UUID
+	restaurantID uuid.UUID
+	source       RevisionSource
+	actorID      string // user who made the change, empty for system changes
+	changes      []FieldChange
+	createdAt    time.Time
+}
+
+// NewRestaurantRevision creates a revision of the given changes
+func NewRestaurantRevision(restaurantID uuid.UUID, source RevisionSource, actorID string, changes []FieldChange) *RestaurantRevision {
+	return &RestaurantRevision{
+		id:           uuid.New(),
+		restaurantID: restaurantID,
+		source:       source,
+		actorID:      actorID,
+		changes:      changes,
+		createdAt:    time.Now(),
+	}
+}
+
+// ReconstructRestaurantRevision is used by repository to reconstruct the revision from persistence
+func ReconstructRestaurantRevision(
+	id uuid.UUID,
+	restaurantID uuid.UUID,
+	source RevisionSource,
+	actorID string,
+	changes []FieldChange,
+	createdAt time.Time,
+) *RestaurantRevision {
+	return &RestaurantRevision{
+		id:           id,
+		restaurantID: restaurantID,
+		source:       source,
+		actorID:      actorID,
+		changes:      changes,
+		createdAt:    createdAt,
+	}
+}
+
+// Getters
+func (r *RestaurantRevision) ID() uuid.UUID           { return r.id }
+func (r *RestaurantRevision) RestaurantID() uuid.UUID { return r.restaurantID }
+func (r *RestaurantRevision) Source() RevisionSource  { return r.source }
+func (r *RestaurantRevision) ActorID() string         { return r.actorID }
+func (r *RestaurantRevision) Changes() []FieldChange  { return r.changes }
+func (r *RestaurantRevision) CreatedAt() time.Time    { return r.createdAt }
+
+// TrackedFields returns the current values of the fields recorded in revisions
+func (r *Restaurant) TrackedFields() map[string]string {
+	fields := map[string]string{
+		FieldName:        r.name,
+		FieldNameJa:      r.nameJa,
+		FieldArea:        r.area,
+		FieldAddress:     r.address,
+		FieldRating:      formatFloat(r.rating),
+		FieldPriceRange:  r.priceRange,
+		FieldCuisineType: r.cuisineType,
+		FieldCuisines:    strings.Join(r.cuisines, ","),
+		FieldPhone:       r.phone,
+		FieldWebsite:     r.website,
+	}
+	if r.location != nil {
+		fields[FieldLatitude] = formatFloat(r.location.Latitude())
+		fields[FieldLongitude] = formatFloat(r.location.Longitude())
+	}
+	if r.tabelog != nil {
+		fields[FieldTabelogURL] = r.tabelog.URL()
+		fields[FieldTabelogRating] = formatFloat(r.tabelog.Rating())
+	}
+	return fields
+}
+
+// DiffFields returns the changes between two TrackedFields results
+func DiffFields(before, after map[string]string) []FieldChange {
+	var changes []FieldChange
+	for _, field := range trackedFields {
+		if before[field] != after[field] {
+			changes = append(changes, FieldChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+	return changes
+}
+
+// RestoreField sets a tracked field from its recorded value
+func (r *Restaurant) RestoreField(field, value string) error {
+	switch field {
+	case FieldName:
+		r.name = value
+	case FieldNameJa:
+		r.nameJa = value
+	case FieldArea:
+		r.area = value
+	case FieldAddress:
+		r.address = value
+	case FieldPriceRange:
+		// Revisions record the level only; bands of a different level are dropped
+		if r.price.Symbols() != value {
+			r.price = ParsePrice(value)
+			r.priceRange = r.price.Symbols()
+		}
+	case FieldCuisineType:
+		r.cuisineType = value
+	case FieldCuisines:
+		// Categories removed from the taxonomy since are dropped
+		r.cuisines = []string{}
+		for _, id := range strings.Split(value, ",") {
+			if _, ok := Cuisines.Category(id); ok {
+				r.cuisines = appendCuisine(r.cuisines, id)
+			}
+		}
+	case FieldPhone:
+		r.phone = value
+	case FieldWebsite:
+		r.website = value
+	case FieldRating:
+		rating, err := parseFloat(value)
+		if err != nil {
+			return err
+		}
+		r.rating = rating
+	case FieldLatitude, FieldLongitude:
+		return r.restoreCoordinate(field, value)
+	default:
+		return ErrFieldNotRestorable
+	}
+
+	r.updatedAt = time.Now()
+	return nil
+}
+
+func (r *Restaurant) restoreCoordinate(field, value string) error {
+	coordinate, err := parseFloat(value)
+	if err != nil {
+		return err
+	}
+
+	var lat, lng float64
+	if r.location != nil {
+		lat, lng = r.location.Latitude(), r.location.Longitude()
+	}
+	if field == FieldLatitude {
+		lat = coordinate
+	} else {
+		lng = coordinate
+	}
+
+	location, err := NewLocation(lat, lng)
+	if err != nil {
+		return err
+	}
+	r.location = location
+	r.updatedAt = time.Now()
+	return nil
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func parseFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffFields(t *testing.T) {
+	location, _ := NewLocation(35.6595, 139.7005)
+	r := NewRestaurant("Ichiran", "Shibuya", SourceGoogle, "g1", "", location)
+	before := r.TrackedFields()
+
+	r.UpdateDetails("Ichiran Shibuya", "", "", "", "03-3463-3667", "")
+	r.UpdateRating(4.2)
+	profile, _ := NewTabelogProfile("https://tabelog.com/tokyo/A1303/A130301/13001234/", 3.52, 1200, 0, nil, 0.9, false)
+	r.AttachTabelog(profile)
+
+	changes := DiffFields(before, r.TrackedFields())
+
+	assert.Equal(t, []FieldChange{
+		{Field: FieldName, Before: "Ichiran", After: "Ichiran Shibuya"},
+		{Field: FieldRating, Before: "0", After: "4.2"},
+		{Field: FieldPhone, Before: "", After: "03-3463-3667"},
+		{Field: FieldTabelogURL, Before: "", After: "https://tabelog.com/tokyo/A1303/A130301/13001234/"},
+		{Field: FieldTabelogRating, Before: "", After: "3.52"},
+	}, changes)
+	assert.Empty(t, DiffFields(before, before))
+}
+
+func TestRestaurant_RestoreField(t *testing.T) {
+	location, _ := NewLocation(35.6595, 139.7005)
+	r := NewRestaurant("Ichiran Shibuya", "Shibuya", SourceGoogle, "g1", "", location)
+
+	assert.NoError(t, r.RestoreField(FieldName, "Ichiran"))
+	assert.NoError(t, r.RestoreField(FieldRating, "4.2"))
+	assert.NoError(t, r.RestoreField(FieldLatitude, "35.66"))
+
+	assert.Equal(t, "Ichiran", r.Name())
+	assert.Equal(t, 4.2, r.Rating())
+	assert.Equal(t, 35.66, r.Location().Latitude())
+	assert.Equal(t, 139.7005, r.Location().Longitude())
+
+	assert.ErrorIs(t, r.RestoreField(FieldTabelogURL, ""), ErrFieldNotRestorable)
+	assert.Error(t, r.RestoreField(FieldRating, "abc"))
+	assert.Error(t, r.RestoreField(FieldLatitude, "120"))
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SuggestionStatus is the moderation state of an edit suggestion
+type SuggestionStatus string
+
+const (
+	SuggestionPending  SuggestionStatus = "pending"
+	SuggestionApproved SuggestionStatus = "approved"
+	SuggestionRejected SuggestionStatus = "rejected"
+)
+
+// RestaurantEdit holds the restaurant details proposed in an edit. Zero
+// values are left unchanged, as in a direct update.
+type RestaurantEdit struct {
+	Name        string  `json:"name,omitempty"`
+	NameJa      string  `json:"name_ja,omitempty"`
+	Area        string  `json:"area,omitempty"`
+	Address     string  `json:"address,omitempty"`
+	Rating      float64 `json:"rating,omitempty"`
+	PriceRange  string  `json:"price_range,omitempty"`
+	CuisineType string  `json:"cuisine_type,omitempty"`
+	Phone       string  `json:"phone,omitempty"`
+	Website     string  `json:"website,omitempty"`
+	Latitude    float64 `json:"latitude,omitempty"`
+	Longitude   float64 `json:"longitude,omitempty"`
+}
+
+// IsEmpty checks if the edit proposes no change
+func (e RestaurantEdit) IsEmpty() bool {
+	return e == RestaurantEdit{}
+}
+
+// EditSuggestion is an edit of a restaurant's details submitted by a regular
+// user. It is only applied to the restaurant once a moderator approves it.
+type EditSuggestion struct {
+	id           uuid.UUID
+	restaurantID uuid.UUID
+	authorID     string
+	edit         RestaurantEdit
+	status       SuggestionStatus
+	reviewerID   string
+	reviewNote   string
+	createdAt    time.Time
+	reviewedAt   *time.Time
+}
+
+// NewEditSuggestion creates a pending suggestion
+func NewEditSuggestion(restaurantID uuid.UUID, authorID string, edit RestaurantEdit) *EditSuggestion {
+	return &EditSuggestion{
+		id:           uuid.New(),
+		restaurantID: restaurantID,
+		authorID:     authorID,
+		edit:         edit,
+		status:       SuggestionPending,
+		createdAt:    time.Now(),
+		reviewedAt:   nil,
+	}
+}
+
+// ReconstructEditSuggestion is used by repository to reconstruct the EditSuggestion entity from persistence
+// This should NOT be used by application layer to create new suggestions
+func ReconstructEditSuggestion(
+	id uuid.UUID,
+	restaurantID uuid.UUID,
+	authorID string,
+	edit RestaurantEdit,
+	status SuggestionStatus,
+	reviewerID string,
+	reviewNote string,
+	createdAt time.Time,
+	reviewedAt *time.Time,
+) *EditSuggestion {
+	return &EditSuggestion{
+		id:           id,
+		restaurantID: restaurantID,
+		authorID:     authorID,
+		edit:         edit,
+		status:       status,
+		reviewerID:   reviewerID,
+		reviewNote:   reviewNote,
+		createdAt:    createdAt,
+		reviewedAt:   reviewedAt,
+	}
+}
+
+// Getters
+func (s *EditSuggestion) ID() uuid.UUID            { return s.id }
+func (s *EditSuggestion) RestaurantID() uuid.UUID  { return s.restaurantID }
+func (s *EditSuggestion) AuthorID() string         { return s.authorID }
+func (s *EditSuggestion) Edit() RestaurantEdit     { return s.edit }
+func (s *EditSuggestion) Status() SuggestionStatus { return s.status }
+func (s *EditSuggestion) ReviewerID() string       { return s.reviewerID }
+func (s *EditSuggestion) ReviewNote() string       { return s.reviewNote }
+func (s *EditSuggestion) CreatedAt() time.Time     { return s.createdAt }
+func (s *EditSuggestion) ReviewedAt() *time.Time   { return s.reviewedAt }
+
+// Domain Methods
+
+// Approve marks the suggestion as approved by the reviewer
+func (s *EditSuggestion) Approve(reviewerID, note string) {
+	s.review(SuggestionApproved, reviewerID, note)
+}
+
+// Reject marks the suggestion as rejected by the reviewer
+func (s *EditSuggestion) Reject(reviewerID, note string) {
+	s.review(SuggestionRejected, reviewerID, note)
+}
+
+func (s *EditSuggestion) review(status SuggestionStatus, reviewerID, note string) {
+	now := time.Now()
+	s.status = status
+	s.reviewerID = reviewerID
+	s.reviewNote = note
+	s.reviewedAt = &now
+}
+
+// IsPending checks if the suggestion is waiting for review
+func (s *EditSuggestion) IsPending() bool {
+	return s.status == SuggestionPending
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// TabelogProfile is the Tabelog listing matched to a restaurant, kept next to
+// the restaurant's own (e.g. Google) rating so both can be shown side by side
+type TabelogProfile struct {
+	url         string
+	rating      float64
+	reviewCount int
+	bookmarks   int
+	genres      []string
+	matchScore  float64 // confidence of the match, 0-1
+	confirmed   bool    // matched by a user rather than automatically
+	syncedAt    time.Time
+}
+
+// NewTabelogProfile creates a Tabelog profile from a scraped listing
+func NewTabelogProfile(
+	url string,
+	rating float64,
+	reviewCount int,
+	bookmarks int,
+	genres []string,
+	matchScore float64,
+	confirmed bool,
+) (*TabelogProfile, error) {
+	if url == "" {
+		return nil, errors.New("tabelog url is required")
+	}
+	if rating < 0 || rating > 5 {
+		return nil, errors.New("tabelog rating must be between 0 and 5")
+	}
+	if reviewCount < 0 || bookmarks < 0 {
+		return nil, errors.New("tabelog counts must not be negative")
+	}
+	if genres == nil {
+		genres = []string{}
+	}
+
+	return &TabelogProfile{
+		url:         url,
+		rating:      rating,
+		reviewCount: reviewCount,
+		bookmarks:   bookmarks,
+		genres:      genres,
+		matchScore:  matchScore,
+		confirmed:   confirmed,
+		syncedAt:    time.Now(),
+	}, nil
+}
+
+// ReconstructTabelogProfile is used by repository to reconstruct the profile from persistence
+func ReconstructTabelogProfile(
+	url string,
+	rating float64,
+	reviewCount int,
+	bookmarks int,
+	genres []string,
+	matchScore float64,
+	confirmed bool,
+	syncedAt time.Time,
+) *TabelogProfile {
+	return &TabelogProfile{
+		url:         url,
+		rating:      rating,
+		reviewCount: reviewCount,
+		bookmarks:   bookmarks,
+		genres:      genres,
+		matchScore:  matchScore,
+		confirmed:   confirmed,
+		syncedAt:    syncedAt,
+	}
+}
+
+// Getters
+func (p *TabelogProfile) URL() string         { return p.url }
+func (p *TabelogProfile) Rating() float64     { return p.rating }
+func (p *TabelogProfile) ReviewCount() int    { return p.reviewCount }
+func (p *TabelogProfile) Bookmarks() int      { return p.bookmarks }
+func (p *TabelogProfile) Genres() []string    { return p.genres }
+func (p *TabelogProfile) MatchScore() float64 { return p.matchScore }
+func (p *TabelogProfile) Confirmed() bool     { return p.confirmed }
+func (p *TabelogProfile) SyncedAt() time.Time { return p.syncedAt }
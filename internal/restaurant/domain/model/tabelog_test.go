@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTabelogProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		rating  float64
+		reviews int
+		wantErr bool
+	}{
+		{"valid", "https://tabelog.com/tokyo/A1303/A130301/13001234/", 3.52, 1200, false},
+		{"missing url", "", 3.52, 1200, true},
+		{"rating too high", "https://tabelog.com/tokyo/A1303/A130301/13001234/", 5.1, 1200, true},
+		{"negative reviews", "https://tabelog.com/tokyo/A1303/A130301/13001234/", 3.52, -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := NewTabelogProfile(tt.url, tt.rating, tt.reviews, 0, nil, 0.9, false)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, profile)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.url, profile.URL())
+			assert.Equal(t, []string{}, profile.Genres())
+			assert.False(t, profile.SyncedAt().IsZero())
+		})
+	}
+}
+
+func TestRestaurant_AttachDetachTabelog(t *testing.T) {
+	r := NewRestaurant("Ichiran", "Tokyo", SourceGoogle, "g1", "", nil)
+	profile, _ := NewTabelogProfile("https://tabelog.com/tokyo/A1303/A130301/13001234/", 3.52, 1200, 45000, []string{"ラーメン"}, 1, true)
+
+	r.AttachTabelog(profile)
+	assert.Equal(t, profile, r.Tabelog())
+
+	r.DetachTabelog()
+	assert.Nil(t, r.Tabelog())
+}
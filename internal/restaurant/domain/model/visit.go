@@ -0,0 +1,154 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limits of a visit's details
+const (
+	MinVisitRating     = 1
+	MaxVisitRating     = 5
+	MaxVisitPartySize  = 100
+	MaxVisitDishes     = 50
+	MaxVisitDishLength = 200
+	MaxVisitPhotos     = 10
+)
+
+// Money is an amount in an ISO 4217 currency, such as 3200 JPY
+type Money struct {
+	Amount   float64
+	Currency string
+}
+
+// NewMoney creates an amount with the currency code uppercased
+func NewMoney(amount float64, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(strings.TrimSpace(currency))}
+}
+
+// IsValid checks that the amount is not negative and the currency is a three
+// letter code
+func (m Money) IsValid() bool {
+	if m.Amount < 0 || len(m.Currency) != 3 {
+		return false
+	}
+	for _, r := range m.Currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// VisitDetails are what a user records about a visit
+type VisitDetails struct {
+	VisitedOn time.Time // the date of the visit; the time of day is dropped
+	PartySize int
+	Rating    int // personal rating from 1 to 5, 0 when not rated
+	Dishes    []string
+	Spend     *Money // nil when not recorded
+	PhotoURLs []string
+}
+
+// Visit is one visit of a user to a restaurant. Unlike the visit count of a
+// favorite, visits are kept as a history and any restaurant can be logged.
+type Visit struct {
+	id           uuid.UUID
+	userID       uuid.UUID
+	restaurantID uuid.UUID
+	details      VisitDetails
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewVisit creates a visit
+func NewVisit(userID, restaurantID uuid.UUID, details VisitDetails) *Visit {
+	now := time.Now()
+	return &Visit{
+		id:           uuid.New(),
+		userID:       userID,
+		restaurantID: restaurantID,
+		details:      normalizeVisitDetails(details),
+		createdAt:    now,
+		updatedAt:    now,
+	}
+}
+
+// ReconstructVisit is used by repository to reconstruct the Visit entity from persistence
+// This should NOT be used by application layer to create new visits
+func ReconstructVisit(
+	id uuid.UUID,
+	userID uuid.UUID,
+	restaurantID uuid.UUID,
+	details VisitDetails,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Visit {
+	return &Visit{
+		id:           id,
+		userID:       userID,
+		restaurantID: restaurantID,
+		details:      details,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// Getters
+func (v *Visit) ID() uuid.UUID           { return v.id }
+func (v *Visit) UserID() uuid.UUID       { return v.userID }
+func (v *Visit) RestaurantID() uuid.UUID { return v.restaurantID }
+func (v *Visit) VisitedOn() time.Time    { return v.details.VisitedOn }
+func (v *Visit) PartySize() int          { return v.details.PartySize }
+func (v *Visit) Rating() int             { return v.details.Rating }
+func (v *Visit) Dishes() []string        { return v.details.Dishes }
+func (v *Visit) Spend() *Money           { return v.details.Spend }
+func (v *Visit) PhotoURLs() []string     { return v.details.PhotoURLs }
+func (v *Visit) Details() VisitDetails   { return v.details }
+func (v *Visit) CreatedAt() time.Time    { return v.createdAt }
+func (v *Visit) UpdatedAt() time.Time    { return v.updatedAt }
+
+// IsRated reports whether the user rated the visit
+func (v *Visit) IsRated() bool {
+	return v.details.Rating != 0
+}
+
+// UpdateDetails replaces everything recorded about the visit
+func (v *Visit) UpdateDetails(details VisitDetails) {
+	v.details = normalizeVisitDetails(details)
+	v.updatedAt = time.Now()
+}
+
+// VisitDate drops the time of day, keeping the calendar date in t's location
+func VisitDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// normalizeVisitDetails trims the dishes, drops blank ones and defaults the
+// party size to one
+func normalizeVisitDetails(details VisitDetails) VisitDetails {
+	details.VisitedOn = VisitDate(details.VisitedOn)
+	if details.PartySize == 0 {
+		details.PartySize = 1
+	}
+
+	dishes := make([]string, 0, len(details.Dishes))
+	for _, dish := range details.Dishes {
+		if dish = strings.TrimSpace(dish); dish != "" {
+			dishes = append(dishes, dish)
+		}
+	}
+	details.Dishes = dishes
+
+	if details.PhotoURLs == nil {
+		details.PhotoURLs = []string{}
+	}
+	if details.Spend != nil {
+		spend := NewMoney(details.Spend.Amount, details.Spend.Currency)
+		details.Spend = &spend
+	}
+	return details
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVisit_NormalizesDetails(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	visit := NewVisit(uuid.New(), uuid.New(), VisitDetails{
+		VisitedOn: time.Date(2024, 5, 1, 1, 30, 0, 0, tokyo),
+		Dishes:    []string{" Tonkotsu ramen ", "", "Gyoza"},
+		Spend:     &Money{Amount: 3200, Currency: "jpy"},
+	})
+
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), visit.VisitedOn(), "the local date is kept")
+	assert.Equal(t, 1, visit.PartySize())
+	assert.Equal(t, []string{"Tonkotsu ramen", "Gyoza"}, visit.Dishes())
+	assert.Equal(t, "JPY", visit.Spend().Currency)
+	assert.False(t, visit.IsRated())
+}
+
+func TestMoney_IsValid(t *testing.T) {
+	assert.True(t, NewMoney(3200, "jpy").IsValid())
+	assert.True(t, NewMoney(0, "USD").IsValid())
+	assert.False(t, NewMoney(-1, "USD").IsValid())
+	assert.False(t, NewMoney(10, "US").IsValid())
+	assert.False(t, NewMoney(10, "U5D").IsValid())
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// CollectionRepository defines the interface for favorite collection persistence
+type CollectionRepository interface {
+	// Create stores a new collection; a user cannot have two collections
+	// with the same name (ignoring case)
+	Create(ctx context.Context, collection *model.Collection) error
+
+	// FindByID finds a collection by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Collection, error)
+
+	// FindByShareSlug finds a shared collection by its share slug
+	FindByShareSlug(ctx context.Context, slug string) (*model.Collection, error)
+
+	// FindByUserID lists a user's collections, oldest first
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Collection, error)
+
+	// Update updates the name, description and sharing of a collection
+	Update(ctx context.Context, collection *model.Collection) error
+
+	// Delete deletes a collection; its favorites are not affected
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListItems lists the favorites in a collection in their order. Removed
+	// favorites are left out.
+	ListItems(ctx context.Context, collectionID uuid.UUID) ([]*model.CollectionItem, error)
+
+	// AddItem appends a favorite to the end of a collection; adding a
+	// favorite already in the collection changes nothing
+	AddItem(ctx context.Context, collectionID, favoriteID uuid.UUID) error
+
+	// RemoveItem removes a favorite from a collection
+	RemoveItem(ctx context.Context, collectionID, favoriteID uuid.UUID) error
+
+	// ReorderItems sets the order of a collection's favorites
+	ReorderItems(ctx context.Context, collectionID uuid.UUID, favoriteIDs []uuid.UUID) error
+}
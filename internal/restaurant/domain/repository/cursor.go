@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/google/uuid"
+)
+
+// Cursor is a keyset pagination position: the sort key and id of the last row
+// of the previous page. Order names the ordering the key belongs to, so a
+// cursor cannot be replayed against a listing sorted differently.
+// Clients only see it as the opaque token produced by Encode.
+type Cursor struct {
+	Order string    `json:"o"`
+	Key   string    `json:"k"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// Encode returns the opaque token for the cursor
+func (c *Cursor) Encode() string {
+	if c == nil {
+		return ""
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a token produced by Cursor.Encode.
+// An empty token decodes to a nil cursor, which starts from the first page.
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domainerrors.ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Order == "" || c.ID == uuid.Nil {
+		return nil, domainerrors.ErrInvalidCursor
+	}
+	return &c, nil
+}
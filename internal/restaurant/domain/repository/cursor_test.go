@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := &Cursor{Order: "rating", Key: "4.5", ID: uuid.New()}
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestCursor_EncodeNil(t *testing.T) {
+	var cursor *Cursor
+	assert.Equal(t, "", cursor.Encode())
+}
+
+func TestDecodeCursor_Empty(t *testing.T) {
+	cursor, err := DecodeCursor("")
+
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "!!!"},
+		{"not json", "bm90IGpzb24"},
+		{"missing order", (&Cursor{Key: "1", ID: uuid.New()}).Encode()},
+		{"missing id", (&Cursor{Order: "newest", Key: "1"}).Encode()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeCursor(tt.token)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidCursor)
+			assert.Nil(t, cursor)
+		})
+	}
+}
@@ -21,6 +21,11 @@ type FavoriteRepository interface {
 	// FindByUserID finds all favorites for a user
 	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Favorite, error)
 
+	// FindByUserIDAfter returns one keyset page of a user's favorites, newest
+	// first, starting after the cursor (nil for the first page). The returned
+	// cursor positions the next page and is nil on the last page.
+	FindByUserIDAfter(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) ([]*model.Favorite, *Cursor, error)
+
 	// FindByRestaurantID finds all favorites for a restaurant
 	FindByRestaurantID(ctx context.Context, restaurantID uuid.UUID) ([]*model.Favorite, error)
 
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// DuplicateQuery selects the restaurant pairs to consider as duplicates
+type DuplicateQuery struct {
+	// RestaurantID restricts pairs to those including this restaurant; nil scans all restaurants
+	RestaurantID *uuid.UUID
+	// MaxDistanceKm is the radius within which two restaurants are paired
+	MaxDistanceKm float64
+	Limit         int
+}
+
+// RestaurantPair is two active restaurants from different sources that are
+// close to each other or share a phone number
+type RestaurantPair struct {
+	A *model.Restaurant
+	B *model.Restaurant
+}
+
+// MergeRepository defines the interface for restaurant merge persistence
+type MergeRepository interface {
+	// FindDuplicatePairs returns pairs of active restaurants from different
+	// sources that lie within MaxDistanceKm of each other or share a phone
+	// number, ranked by an estimate of their dedup score so that the limit
+	// keeps the likeliest duplicates. Pairs that were merged and later
+	// unmerged are skipped.
+	FindDuplicatePairs(ctx context.Context, query DuplicateQuery) ([]*RestaurantPair, error)
+
+	// Merge applies a merge atomically: it repoints the merged restaurant's
+	// favorites to the canonical restaurant (dropping those whose user already
+	// has the canonical one), soft-deletes the merged restaurant, relinks
+	// identities previously merged into it and stores the merge record with
+	// the favorites it moved and dropped.
+	Merge(ctx context.Context, merge *model.RestaurantMerge) error
+
+	// Unmerge reverts a merge atomically: it restores the merged restaurant,
+	// moves its favorites back and marks the merge record as undone.
+	Unmerge(ctx context.Context, merge *model.RestaurantMerge) error
+
+	// FindByID finds a merge by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantMerge, error)
+
+	// FindByCanonicalID finds the active merges into a restaurant, i.e. its linked identities
+	FindByCanonicalID(ctx context.Context, canonicalID uuid.UUID) ([]*model.RestaurantMerge, error)
+}
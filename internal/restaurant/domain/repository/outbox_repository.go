@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+)
+
+// OutboxHandler publishes a batch of pending events. An error leaves the
+// whole batch pending.
+type OutboxHandler func(ctx context.Context, events []*model.DomainEvent) error
+
+// OutboxRepository defines the interface for the transactional outbox. Events
+// are appended by the other repositories in the transaction of the change
+// they describe; this interface only reads and settles them.
+type OutboxRepository interface {
+	// ProcessPending passes up to limit unpublished events, oldest first, to
+	// handler and marks them published when it succeeds. When it fails the
+	// events stay pending with the error recorded, and the handler's error is
+	// returned. A failure is counted as an attempt only when the handler was
+	// given a single event, since a failed batch does not tell which of its
+	// events failed. Only one caller processes events at a time, so events
+	// are handed out in the order they were written; other callers get 0
+	// while a batch is in progress. Dead-lettered events are skipped.
+	ProcessPending(ctx context.Context, limit int, handler OutboxHandler) (int, error)
+
+	// DeadLetterPending moves the pending events that have failed at least
+	// maxAttempts times out of the pending events and returns them
+	DeadLetterPending(ctx context.Context, maxAttempts int) ([]*model.DomainEvent, error)
+
+	// CountPending counts the events not yet published, leaving out the
+	// dead-lettered ones
+	CountPending(ctx context.Context) (int64, error)
+
+	// DeletePublishedBefore deletes events published before the given time
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// PhotoRepository defines the interface for restaurant photo persistence
+type PhotoRepository interface {
+	// Create stores a new photo
+	Create(ctx context.Context, photo *model.Photo) error
+
+	// FindByID finds a photo by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Photo, error)
+
+	// ListByRestaurant lists the photos of a restaurant, oldest first
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*model.Photo, error)
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// PopularityCount is the number of events a restaurant received in one bucket
+type PopularityCount struct {
+	RestaurantID uuid.UUID
+	BucketStart  time.Time
+	Views        int64
+	Favorites    int64
+	Visits       int64
+}
+
+// Add adds n events to the count
+func (c *PopularityCount) Add(event model.PopularityEvent, n int64) {
+	switch event {
+	case model.PopularityEventView:
+		c.Views += n
+	case model.PopularityEventFavorite:
+		c.Favorites += n
+	case model.PopularityEventVisit:
+		c.Visits += n
+	}
+}
+
+// TrendingQuery selects the top trending restaurants. Each bucket's events
+// count for half as much every HalfLife; buckets that started before Since
+// are ignored.
+type TrendingQuery struct {
+	Area        string
+	CuisineType string
+	Now         time.Time
+	Since       time.Time
+	HalfLife    time.Duration
+	Limit       int
+}
+
+// TrendingRestaurant is a restaurant with its decayed trending score and the
+// raw event counts it was computed from
+type TrendingRestaurant struct {
+	Restaurant *model.Restaurant
+	Score      float64
+	Views      int64
+	Favorites  int64
+	Visits     int64
+}
+
+// PopularityCounter counts popularity events in time buckets until they are
+// rolled up into the PopularityRepository
+type PopularityCounter interface {
+	// Incr counts one event in the bucket of the given time
+	Incr(ctx context.Context, restaurantID uuid.UUID, event model.PopularityEvent, at time.Time) error
+
+	// Buckets returns the start of every bucket holding counts, oldest first
+	Buckets(ctx context.Context) ([]time.Time, error)
+
+	// Counts returns the counts of a bucket
+	Counts(ctx context.Context, bucket time.Time) ([]PopularityCount, error)
+
+	// DeleteBucket drops a bucket once it has been rolled up
+	DeleteBucket(ctx context.Context, bucket time.Time) error
+}
+
+// PopularityRepository defines the interface for rolled up popularity counts
+type PopularityRepository interface {
+	// SaveCounts stores bucket counts, replacing the earlier counts of the
+	// same restaurant and bucket, and adds the new views to the restaurants'
+	// lifetime view count. Saving the same counts twice changes nothing.
+	SaveCounts(ctx context.Context, counts []PopularityCount) error
+
+	// FindTrending returns the restaurants with the highest trending score
+	FindTrending(ctx context.Context, query TrendingQuery) ([]*TrendingRestaurant, error)
+}
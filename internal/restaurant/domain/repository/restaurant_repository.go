@@ -2,11 +2,121 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
 	"github.com/google/uuid"
 )
 
+// NearbyRestaurant is a restaurant returned by a radius search together with
+// its great-circle distance from the search origin
+type NearbyRestaurant struct {
+	Restaurant *model.Restaurant
+	DistanceKm float64
+}
+
+// RestaurantSearchHit is a restaurant matched by a text search together with
+// its relevance score (higher is more relevant)
+type RestaurantSearchHit struct {
+	Restaurant *model.Restaurant
+	Score      float64
+}
+
+// ExternalRef identifies a restaurant by its source and external ID
+type ExternalRef struct {
+	Source     model.RestaurantSource
+	ExternalID string
+}
+
+// RestaurantSort selects the ordering of a filtered restaurant listing
+type RestaurantSort string
+
+const (
+	// SortByRelevance orders by text search score (requires Text)
+	SortByRelevance RestaurantSort = "relevance"
+	// SortByDistance orders by distance from the search point (requires Near)
+	SortByDistance RestaurantSort = "distance"
+	// SortByRating orders by rating, highest first
+	SortByRating RestaurantSort = "rating"
+	// SortByViewCount orders by view count, most viewed first
+	SortByViewCount RestaurantSort = "view_count"
+	// SortByNewest orders by creation time, newest first
+	SortByNewest RestaurantSort = "newest"
+	// SortByName orders alphabetically by name
+	SortByName RestaurantSort = "name"
+)
+
+// NearPoint restricts a listing to a great-circle radius around a point
+type NearPoint struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+// RestaurantFilter holds the criteria of a filtered restaurant listing.
+// Zero-valued fields are not applied; all set criteria must match.
+type RestaurantFilter struct {
+	Area string
+	// CuisineType matches the cuisine type, or the cuisine category it
+	// names with its descendants
+	CuisineType string
+	// Cuisine is a model.Cuisines category ID; restaurants in it or any of
+	// its descendants match
+	Cuisine   string
+	Source    model.RestaurantSource
+	MinRating float64
+	// MinPriceLevel and MaxPriceLevel bound the price level ("$" is 1,
+	// "$$$$" is 4); 0 leaves the bound open
+	MinPriceLevel int
+	MaxPriceLevel int
+	// Budget keeps restaurants with a price band overlapping it
+	Budget *BudgetFilter
+	// OpenNow keeps restaurants whose opening schedule has them open at the
+	// time of the query, as OpenAt does for a given instant
+	OpenNow bool
+	// OpenAt keeps restaurants whose opening schedule has them open at this instant
+	OpenAt *time.Time
+	// UpdatedSince keeps restaurants updated at or after this instant
+	UpdatedSince *time.Time
+	Text         string
+	Near         *NearPoint
+	Sort         RestaurantSort
+}
+
+// BudgetFilter is a per-person budget in yen. It matches a restaurant whose
+// band for Meal overlaps it, or either band when Meal is empty; restaurants
+// without a band do not match. A zero Min or Max leaves that bound open.
+type BudgetFilter struct {
+	Meal model.Meal
+	Min  int
+	Max  int
+}
+
+// FilteredRestaurant is a restaurant returned by a filtered listing.
+// DistanceKm is set when the filter has a Near point and Score when it has Text.
+type FilteredRestaurant struct {
+	Restaurant *model.Restaurant
+	DistanceKm *float64
+	Score      *float64
+}
+
+// StaleQuery selects restaurants whose data is older than a freshness TTL
+type StaleQuery struct {
+	Source        model.RestaurantSource
+	UpdatedBefore time.Time
+	ExcludeIDs    []uuid.UUID
+	Limit         int
+}
+
+// DeletedQuery selects soft-deleted restaurants, most recently deleted first.
+// Restaurants merged into another one are left out: they are restored by
+// undoing the merge and never purged while it is active.
+type DeletedQuery struct {
+	DeletedBefore *time.Time // only restaurants deleted before this time
+	Limit         int
+	Offset        int
+}
+
 // RestaurantRepository defines the interface for restaurant persistence
 type RestaurantRepository interface {
 	// Create creates a new restaurant
@@ -15,30 +125,95 @@ type RestaurantRepository interface {
 	// FindByID finds a restaurant by ID
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Restaurant, error)
 
-	// FindByExternalID finds a restaurant by source and external ID
+	// FindByExternalID finds a restaurant by source and external ID. An identity
+	// that was merged into another restaurant resolves to the canonical restaurant.
 	FindByExternalID(ctx context.Context, source model.RestaurantSource, externalID string) (*model.Restaurant, error)
 
+	// FindByIDs finds the restaurants with the given IDs. IDs without a
+	// restaurant are left out; the result is in no particular order.
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Restaurant, error)
+
+	// FindByExternalIDs is the batch form of FindByExternalID. It maps every
+	// identity that resolves to a restaurant to that restaurant.
+	FindByExternalIDs(ctx context.Context, refs []ExternalRef) (map[ExternalRef]*model.Restaurant, error)
+
 	// Update updates an existing restaurant
 	Update(ctx context.Context, restaurant *model.Restaurant) error
 
 	// Delete soft-deletes a restaurant by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// Search searches restaurants by query string
-	Search(ctx context.Context, query string, limit, offset int) ([]*model.Restaurant, error)
+	// Search performs a ranked full-text and fuzzy search over names (including
+	// name_ja), cuisine, area and address. It returns one page of hits ordered by
+	// descending score and the total number of matches.
+	Search(ctx context.Context, query string, limit, offset int) ([]*RestaurantSearchHit, int64, error)
+
+	// SearchAfter is the keyset-paginated form of Search. It returns the page of
+	// hits after the cursor (nil for the first page) and the cursor of the next
+	// page, which is nil on the last page.
+	SearchAfter(ctx context.Context, query string, after *Cursor, limit int) ([]*RestaurantSearchHit, int64, *Cursor, error)
+
+	// FindByLocation finds restaurants within a great-circle radius from a location,
+	// ordered by ascending distance
+	FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*NearbyRestaurant, error)
+
+	// FindByFilter lists restaurants matching every criterion of the filter in
+	// the requested order. It returns one page and the total number of matches.
+	FindByFilter(ctx context.Context, filter RestaurantFilter, limit, offset int) ([]*FilteredRestaurant, int64, error)
+
+	// FindByFilterAfter is the keyset-paginated form of FindByFilter
+	FindByFilterAfter(ctx context.Context, filter RestaurantFilter, after *Cursor, limit int) ([]*FilteredRestaurant, int64, *Cursor, error)
 
-	// FindByLocation finds restaurants within a radius from a location
-	FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*model.Restaurant, error)
+	// FindPageByFilter is FindByFilterAfter without the total, for walking
+	// every match page by page
+	FindPageByFilter(ctx context.Context, filter RestaurantFilter, after *Cursor, limit int) ([]*FilteredRestaurant, *Cursor, error)
 
 	// List lists all restaurants with pagination
 	List(ctx context.Context, limit, offset int) ([]*model.Restaurant, error)
 
+	// ListAfter is the keyset-paginated form of List, newest first
+	ListAfter(ctx context.Context, after *Cursor, limit int) ([]*model.Restaurant, *Cursor, error)
+
 	// Count returns the total count of restaurants
 	Count(ctx context.Context) (int64, error)
 
-	// FindByCuisineType finds restaurants by cuisine type
+	// FindByCuisineType finds restaurants by cuisine type. When the text names
+	// a cuisine category, restaurants in it or its descendants match too.
 	FindByCuisineType(ctx context.Context, cuisineType string, limit, offset int) ([]*model.Restaurant, error)
 
+	// FindByCuisineTypeAfter is the keyset-paginated form of FindByCuisineType, newest first
+	FindByCuisineTypeAfter(ctx context.Context, cuisineType string, after *Cursor, limit int) ([]*model.Restaurant, *Cursor, error)
+
 	// FindBySource finds restaurants by source
 	FindBySource(ctx context.Context, source model.RestaurantSource, limit, offset int) ([]*model.Restaurant, error)
+
+	// FindBySourceAfter is the keyset-paginated form of FindBySource, newest first
+	FindBySourceAfter(ctx context.Context, source model.RestaurantSource, after *Cursor, limit int) ([]*model.Restaurant, *Cursor, error)
+
+	// FindStale finds restaurants last updated before query.UpdatedBefore, most
+	// viewed and most favorited first
+	FindStale(ctx context.Context, query StaleQuery) ([]*model.Restaurant, error)
+
+	// CountStale returns the number of restaurants FindStale would return without a limit
+	CountStale(ctx context.Context, query StaleQuery) (int64, error)
+
+	// FindDeleted lists soft-deleted restaurants and counts all matches
+	FindDeleted(ctx context.Context, query DeletedQuery) ([]*model.Restaurant, int64, error)
+
+	// Restore undoes the soft delete of a restaurant and of the favorites
+	// deleted with it. Favorites their users removed stay removed. It fails
+	// with ErrRestaurantReplaced when a live restaurant has taken its
+	// source and external ID.
+	Restore(ctx context.Context, id uuid.UUID) (*model.Restaurant, error)
+
+	// Purge permanently deletes the restaurants among ids that were
+	// soft-deleted before deletedBefore, with everything referencing them,
+	// and returns the IDs of the deleted restaurants
+	Purge(ctx context.Context, ids []uuid.UUID, deletedBefore time.Time) ([]uuid.UUID, error)
+
+	// Reindex rewrites the columns derived from the restaurants in the
+	// application, their cuisine categories and search text, without
+	// touching updated_at or publishing events. It backfills rows written
+	// before a derivation was added or changed.
+	Reindex(ctx context.Context, restaurants []*model.Restaurant) error
 }
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// ReviewSort selects the ordering of a review listing
+type ReviewSort string
+
+const (
+	// SortReviewsNewest orders by creation time, newest first
+	SortReviewsNewest ReviewSort = "newest"
+	// SortReviewsOldest orders by creation time, oldest first
+	SortReviewsOldest ReviewSort = "oldest"
+	// SortReviewsHighest orders by score, highest first
+	SortReviewsHighest ReviewSort = "highest"
+	// SortReviewsLowest orders by score, lowest first
+	SortReviewsLowest ReviewSort = "lowest"
+	// SortReviewsHelpful orders by helpful votes, most first
+	SortReviewsHelpful ReviewSort = "helpful"
+)
+
+// IsValid checks if the sort is a known review ordering
+func (s ReviewSort) IsValid() bool {
+	switch s {
+	case SortReviewsNewest, SortReviewsOldest, SortReviewsHighest, SortReviewsLowest, SortReviewsHelpful:
+		return true
+	}
+	return false
+}
+
+// ReviewQuery selects reviews; zero fields do not filter
+type ReviewQuery struct {
+	RestaurantID *uuid.UUID
+	UserID       *uuid.UUID
+	Status       model.ReviewStatus
+	Sort         ReviewSort // SortReviewsNewest when empty
+	Limit        int
+	Offset       int
+}
+
+// ReviewRepository defines the interface for review persistence. Every write
+// recomputes the review summary of the review's restaurant.
+type ReviewRepository interface {
+	// Create stores a new review; ErrReviewAlreadyExists when the user has
+	// already reviewed the restaurant
+	Create(ctx context.Context, review *model.Review) error
+
+	// FindByID finds a review by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Review, error)
+
+	// FindByUserAndRestaurant finds a user's review of a restaurant
+	FindByUserAndRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Review, error)
+
+	// List lists the reviews matching the query and returns the total
+	// number of matches
+	List(ctx context.Context, query ReviewQuery) ([]*model.Review, int64, error)
+
+	// Update stores a review, along with its previous version when edit is
+	// not nil
+	Update(ctx context.Context, review *model.Review, edit *model.ReviewEdit) error
+
+	// Delete deletes a review with its history and votes
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListEdits lists the previous versions of a review, newest first
+	ListEdits(ctx context.Context, reviewID uuid.UUID) ([]*model.ReviewEdit, error)
+
+	// AddHelpfulVote records that the user found the review helpful. Voting
+	// twice has no effect.
+	AddHelpfulVote(ctx context.Context, reviewID, userID uuid.UUID) error
+
+	// RemoveHelpfulVote withdraws the user's vote; ErrVoteNotFound when the
+	// user has not voted
+	RemoveHelpfulVote(ctx context.Context, reviewID, userID uuid.UUID) error
+}
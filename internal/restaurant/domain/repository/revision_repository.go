@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// RevisionRepository defines the interface for the append-only restaurant revision log
+type RevisionRepository interface {
+	// UpdateWithRevision updates the restaurant and appends the revision in one transaction
+	UpdateWithRevision(ctx context.Context, restaurant *model.Restaurant, revision *model.RestaurantRevision) error
+
+	// FindByID finds a revision by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantRevision, error)
+
+	// ListByRestaurant lists the revisions of a restaurant, newest first, and
+	// returns the total number of revisions
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, limit, offset int) ([]*model.RestaurantRevision, int64, error)
+
+	// ListNewerThan lists the revisions of the same restaurant recorded after
+	// the given one, oldest first
+	ListNewerThan(ctx context.Context, revision *model.RestaurantRevision) ([]*model.RestaurantRevision, error)
+}
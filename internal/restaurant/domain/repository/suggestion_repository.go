@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// SuggestionQuery selects edit suggestions; zero fields do not filter
+type SuggestionQuery struct {
+	Status       model.SuggestionStatus
+	RestaurantID *uuid.UUID
+	AuthorID     string
+	Limit        int
+	Offset       int
+}
+
+// SuggestionRepository defines the interface for edit suggestion persistence
+type SuggestionRepository interface {
+	// Create stores a new suggestion
+	Create(ctx context.Context, suggestion *model.EditSuggestion) error
+
+	// Update stores the review of a suggestion. It fails with
+	// ErrSuggestionNotPending when the suggestion was reviewed meanwhile, so
+	// of two concurrent reviews only one is stored.
+	Update(ctx context.Context, suggestion *model.EditSuggestion) error
+
+	// Reopen returns a reviewed suggestion to pending, undoing a review whose
+	// edit could not be applied
+	Reopen(ctx context.Context, id uuid.UUID) error
+
+	// FindByID finds a suggestion by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.EditSuggestion, error)
+
+	// List lists the suggestions matching the query, oldest first, and
+	// returns the total number of matches
+	List(ctx context.Context, query SuggestionQuery) ([]*model.EditSuggestion, int64, error)
+
+	// CountApprovedByAuthor counts the approved suggestions of a user
+	CountApprovedByAuthor(ctx context.Context, authorID string) (int64, error)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// VisitQuery selects a page of a user's visits, newest first
+type VisitQuery struct {
+	UserID       uuid.UUID
+	RestaurantID *uuid.UUID // only visits of this restaurant when set
+	From         *time.Time // visits on or after this date
+	To           *time.Time // visits on or before this date
+	Limit        int
+	Offset       int
+}
+
+// MonthlyVisits is how often a user went out in a month
+type MonthlyVisits struct {
+	Month       time.Time // first day of the month
+	Visits      int64
+	Restaurants int64 // distinct restaurants visited
+}
+
+// CuisineSpend is what a user spent on a cuisine in one currency. Amounts in
+// different currencies are never added together.
+type CuisineSpend struct {
+	CuisineType string
+	Currency    string
+	Visits      int64 // visits with a recorded spend
+	Total       float64
+}
+
+// RevisitedRestaurant is a restaurant a user went back to
+type RevisitedRestaurant struct {
+	RestaurantID  uuid.UUID
+	Visits        int64
+	LastVisitedOn time.Time
+	AverageRating *float64 // nil when no visit was rated
+}
+
+// RestaurantVisits is how often a user visited one restaurant
+type RestaurantVisits struct {
+	Visits        int64
+	LastVisitedOn *time.Time // nil without visits
+}
+
+// VisitRepository defines the interface for visit persistence
+type VisitRepository interface {
+	// Create stores a new visit
+	Create(ctx context.Context, visit *model.Visit) error
+
+	// FindByID finds a visit by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Visit, error)
+
+	// FindByUser lists a user's visits, newest first, and counts all matches
+	FindByUser(ctx context.Context, query VisitQuery) ([]*model.Visit, int64, error)
+
+	// Update updates a visit
+	Update(ctx context.Context, visit *model.Visit) error
+
+	// Delete deletes a visit
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// CountByMonth counts a user's visits per month since the given month,
+	// oldest first; months without visits are left out
+	CountByMonth(ctx context.Context, userID uuid.UUID, since time.Time) ([]*MonthlyVisits, error)
+
+	// SpendByCuisine sums a user's recorded spend per cuisine and currency,
+	// highest total first
+	SpendByCuisine(ctx context.Context, userID uuid.UUID) ([]*CuisineSpend, error)
+
+	// MostRevisited lists the restaurants a user visited more than once,
+	// most visits first
+	MostRevisited(ctx context.Context, userID uuid.UUID, limit int) ([]*RevisitedRestaurant, error)
+
+	// CountByRestaurant counts a user's visits of a restaurant
+	CountByRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*RestaurantVisits, error)
+}
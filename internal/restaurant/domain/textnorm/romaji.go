@@ -0,0 +1,180 @@
+package textnorm
+
+import (
+	"strings"
+)
+
+// digraphs are two-kana combinations (consonant + small ya/yu/yo or small vowel)
+var digraphs = map[string]string{
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo",
+	"しゃ": "sha", "しゅ": "shu", "しぇ": "she", "しょ": "sho",
+	"ちゃ": "cha", "ちゅ": "chu", "ちぇ": "che", "ちょ": "cho",
+	"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+	"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+	"みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+	"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+	"ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"じゃ": "ja", "じゅ": "ju", "じぇ": "je", "じょ": "jo",
+	"ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo",
+	"ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+	"ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo",
+	"てぃ": "ti", "でぃ": "di", "とぅ": "tu", "どぅ": "du",
+	"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+	"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo",
+}
+
+// monographs maps single kana to Hepburn romaji
+var monographs = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ゔ': "vu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa",
+}
+
+// ToRomaji converts hiragana and katakana to Hepburn romaji. Long vowel marks
+// are dropped ("らーめん" -> "ramen") and other characters pass through.
+func ToRomaji(s string) string {
+	runes := []rune(Normalize(s))
+	var b strings.Builder
+	geminate := false
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		var romaji string
+
+		switch {
+		case r == 'っ':
+			geminate = true
+			continue
+		case r == 'ー':
+			continue
+		case i+1 < len(runes) && digraphs[string(runes[i:i+2])] != "":
+			romaji = digraphs[string(runes[i:i+2])]
+			i++
+		case monographs[r] != "":
+			romaji = monographs[r]
+		default:
+			geminate = false
+			b.WriteRune(r)
+			continue
+		}
+
+		if geminate {
+			// Hepburn doubles the consonant, except "ch" which becomes "tch"
+			if strings.HasPrefix(romaji, "ch") {
+				b.WriteByte('t')
+			} else if !strings.ContainsRune("aiueon", rune(romaji[0])) {
+				b.WriteByte(romaji[0])
+			}
+			geminate = false
+		}
+		b.WriteString(romaji)
+	}
+
+	return b.String()
+}
+
+// romajiToKana is the reverse lookup used by FromRomaji, including common
+// Kunrei-shiki spellings ("si", "tu", "zya") as aliases.
+var romajiToKana = buildRomajiToKana()
+
+func buildRomajiToKana() map[string]string {
+	table := make(map[string]string)
+	for kana, romaji := range monographs {
+		// Small kana and obsolete characters should not win over their regular forms
+		if strings.ContainsRune("ぁぃぅぇぉゃゅょゎゐゑをぢづ", kana) {
+			continue
+		}
+		table[romaji] = string(kana)
+	}
+	for kana, romaji := range digraphs {
+		if strings.HasPrefix(kana, "ぢ") {
+			continue
+		}
+		table[romaji] = kana
+	}
+
+	aliases := map[string]string{
+		"si": "し", "ti": "ち", "tu": "つ", "hu": "ふ", "zi": "じ", "di": "ぢ", "du": "づ",
+		"sya": "しゃ", "syu": "しゅ", "syo": "しょ",
+		"tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ",
+		"zya": "じゃ", "zyu": "じゅ", "zyo": "じょ",
+		"jya": "じゃ", "jyu": "じゅ", "jyo": "じょ",
+		"wo": "を", "nn": "ん",
+	}
+	for romaji, kana := range aliases {
+		table[romaji] = kana
+	}
+
+	return table
+}
+
+// FromRomaji converts romaji to hiragana on a best-effort basis. Letters that
+// cannot be converted are kept as-is.
+func FromRomaji(s string) string {
+	s = strings.ToLower(s)
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		// Doubled consonant (not n) becomes a small tsu: "kitte" -> "きって"
+		if i+1 < len(s) && c == s[i+1] && c != 'n' && isConsonant(c) {
+			b.WriteRune('っ')
+			i++
+			continue
+		}
+		// "tch" -> small tsu + "ch"
+		if strings.HasPrefix(s[i:], "tch") {
+			b.WriteRune('っ')
+			i++
+			continue
+		}
+
+		matched := false
+		for size := 3; size >= 1; size-- {
+			if i+size > len(s) {
+				continue
+			}
+			chunk := s[i : i+size]
+			kana, ok := romajiToKana[chunk]
+			if !ok {
+				continue
+			}
+			// "n" is only ん when not followed by a vowel or y ("kanna" is かんな, not かんあ)
+			if (chunk == "n" || chunk == "nn") && i+size < len(s) && strings.ContainsRune("aiueoy", rune(s[i+size])) {
+				continue
+			}
+			b.WriteString(kana)
+			i += size
+			matched = true
+			break
+		}
+
+		if !matched {
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+func isConsonant(c byte) bool {
+	return c >= 'a' && c <= 'z' && !strings.ContainsRune("aiueo", rune(c))
+}
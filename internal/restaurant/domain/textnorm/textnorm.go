@@ -0,0 +1,184 @@
+// Package textnorm normalizes restaurant names and search queries so that
+// Japanese text can be matched regardless of width, kana script or romanization.
+//
+// Japanese has no word boundaries, so CJK runs are split into overlapping
+// character bigrams (the same approach as pg_bigm). Kana words additionally
+// get a Hepburn romaji reading so "ラーメン", "らーめん" and "ramen" all match.
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize applies NFKC (full-width ASCII and half-width kana folding),
+// lowercases, converts katakana to hiragana and collapses whitespace.
+func Normalize(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.ToLower(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		b.WriteRune(toHiragana(r))
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Tokens splits text into search tokens: latin words are kept whole and CJK
+// runs are split into overlapping bigrams. The input is normalized first.
+func Tokens(s string) []string {
+	var tokens []string
+	for _, word := range words(Normalize(s)) {
+		runes := []rune(word)
+		if !isCJK(runes[0]) || len(runes) == 1 {
+			tokens = append(tokens, word)
+			continue
+		}
+		for i := 0; i+1 < len(runes); i++ {
+			tokens = append(tokens, string(runes[i:i+2]))
+		}
+	}
+	return tokens
+}
+
+// IndexText builds the searchable text stored alongside a restaurant: the
+// tokens of every field followed by romaji readings of kana words.
+func IndexText(fields ...string) string {
+	seen := make(map[string]bool)
+	var out []string
+	add := func(token string) {
+		if token != "" && !seen[token] {
+			seen[token] = true
+			out = append(out, token)
+		}
+	}
+
+	for _, field := range fields {
+		for _, token := range Tokens(field) {
+			add(token)
+		}
+		for _, word := range words(Normalize(field)) {
+			for _, run := range kanaRuns(word) {
+				add(ToRomaji(run))
+			}
+		}
+	}
+
+	return strings.Join(out, " ")
+}
+
+// QueryVariants returns the normalized query plus its alternate readings:
+// romaji for kana input and hiragana for romaji input. Duplicates are removed.
+func QueryVariants(query string) []string {
+	normalized := Normalize(query)
+	if normalized == "" {
+		return nil
+	}
+
+	variants := []string{normalized}
+	add := func(v string) {
+		if v == "" {
+			return
+		}
+		for _, existing := range variants {
+			if existing == v {
+				return
+			}
+		}
+		variants = append(variants, v)
+	}
+
+	add(ToRomaji(normalized))
+	if isASCII(normalized) {
+		if kana := FromRomaji(normalized); kana != normalized && !containsASCIILetter(kana) {
+			add(kana)
+		}
+	}
+
+	return variants
+}
+
+// words splits normalized text into runs of letters/digits, breaking
+// between CJK and non-CJK characters.
+func words(s string) []string {
+	var out []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			out = append(out, string(current))
+			current = current[:0]
+		}
+	}
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != 'ー' {
+			flush()
+			continue
+		}
+		if len(current) > 0 && isCJK(current[len(current)-1]) != isCJK(r) {
+			flush()
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return out
+}
+
+// kanaRuns returns the maximal hiragana substrings of a word
+func kanaRuns(word string) []string {
+	var out []string
+	var current []rune
+	for _, r := range word {
+		if isHiragana(r) || r == 'ー' {
+			current = append(current, r)
+			continue
+		}
+		if len(current) > 0 {
+			out = append(out, string(current))
+			current = nil
+		}
+	}
+	if len(current) > 0 {
+		out = append(out, string(current))
+	}
+	return out
+}
+
+func toHiragana(r rune) rune {
+	// Katakana ァ (U+30A1) .. ヶ (U+30F6) map 1:1 onto hiragana ぁ (U+3041) .. ゖ (U+3096)
+	if r >= 0x30A1 && r <= 0x30F6 {
+		return r - 0x60
+	}
+	return r
+}
+
+func isHiragana(r rune) bool {
+	return r >= 0x3041 && r <= 0x3096
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー' || r == '々'
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+func containsASCIILetter(s string) bool {
+	for _, r := range s {
+		if r <= unicode.MaxASCII && unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
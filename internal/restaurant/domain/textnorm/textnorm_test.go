@@ -0,0 +1,103 @@
+package textnorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"full-width ascii", "ＳＵＳＨＩ　Bar", "sushi bar"},
+		{"katakana to hiragana", "ラーメン", "らーめん"},
+		{"half-width katakana", "ｽｼ", "すし"},
+		{"collapse whitespace", "  一蘭   渋谷店 ", "一蘭 渋谷店"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Normalize(tt.input))
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	assert.Equal(t, []string{"すし", "しざ", "ざん", "ginza"}, Tokens("スシザン GINZA"))
+	assert.Equal(t, []string{"寿司", "司屋"}, Tokens("寿司屋"))
+	assert.Equal(t, []string{"sushi", "bar"}, Tokens("Sushi-Bar"))
+	assert.Equal(t, []string{"店"}, Tokens("店"))
+}
+
+func TestToRomaji(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"らーめん", "ramen"},
+		{"ラーメン", "ramen"},
+		{"すし", "sushi"},
+		{"きょうと", "kyouto"},
+		{"まっちゃ", "matcha"},
+		{"きって", "kitte"},
+		{"ふぁみりー", "famiri"},
+		{"ちゃんぽん", "chanpon"},
+		{"ramen", "ramen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToRomaji(tt.input))
+		})
+	}
+}
+
+func TestFromRomaji(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"ramen", "らめん"},
+		{"sushi", "すし"},
+		{"susi", "すし"},
+		{"tonkatsu", "とんかつ"},
+		{"kitte", "きって"},
+		{"matcha", "まっちゃ"},
+		{"kanna", "かんな"},
+		{"shinbashi", "しんばし"},
+		{"pizza!", "ぴっざ!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, FromRomaji(tt.input))
+		})
+	}
+}
+
+func TestIndexText(t *testing.T) {
+	text := IndexText("Ichiran", "一蘭 ラーメン", "ramen")
+
+	assert.Equal(t, "ichiran 一蘭 らー ーめ めん ramen", text)
+}
+
+func TestQueryVariants(t *testing.T) {
+	t.Run("kana query adds romaji", func(t *testing.T) {
+		assert.Equal(t, []string{"らーめん", "ramen"}, QueryVariants("ラーメン"))
+	})
+
+	t.Run("romaji query adds kana", func(t *testing.T) {
+		assert.Equal(t, []string{"tonkatsu", "とんかつ"}, QueryVariants("Tonkatsu"))
+	})
+
+	t.Run("english word without kana reading", func(t *testing.T) {
+		assert.Equal(t, []string{"burger"}, QueryVariants("burger"))
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		assert.Nil(t, QueryVariants("   "))
+	})
+}
@@ -0,0 +1,114 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Envelope is the JSON value of a published event message. Consumers
+// deduplicate by ID and pick the payload schema by Type and Version.
+type Envelope struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          model.EventType `json:"type"`
+	Version       int             `json:"version"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   uuid.UUID       `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// Message headers, so consumers can filter without decoding the value
+const (
+	HeaderEventID      = "event-id"
+	HeaderEventType    = "event-type"
+	HeaderEventVersion = "event-version"
+)
+
+// KafkaPublisher publishes domain events to a Kafka topic. Messages are keyed
+// by aggregate ID, so the events of one aggregate land on one partition in
+// the order they were written.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+	logger *zap.Logger
+}
+
+// NewKafkaPublisher creates a publisher writing to topic. It connects on the
+// first publish.
+func NewKafkaPublisher(brokers []string, topic string, logger *zap.Logger) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			BatchTimeout:           10 * time.Millisecond,
+			AllowAutoTopicCreation: true,
+		},
+		logger: logger,
+	}
+}
+
+// Publish writes the events and waits until all of them are acknowledged
+func (p *KafkaPublisher) Publish(ctx context.Context, events []*model.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]kafka.Message, len(events))
+	for i, event := range events {
+		message, err := toMessage(event)
+		if err != nil {
+			return err
+		}
+		messages[i] = message
+	}
+
+	if err := p.writer.WriteMessages(ctx, messages...); err != nil {
+		p.logger.Warn("Failed to publish events to Kafka",
+			zap.String("topic", p.writer.Topic),
+			zap.Int("events", len(events)),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// Close flushes pending writes and closes the connections
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+// toMessage encodes an event as a Kafka message
+func toMessage(event *model.DomainEvent) (kafka.Message, error) {
+	value, err := json.Marshal(Envelope{
+		ID:            event.ID(),
+		Type:          event.Type(),
+		Version:       event.Version(),
+		AggregateType: event.AggregateType(),
+		AggregateID:   event.AggregateID(),
+		OccurredAt:    event.OccurredAt(),
+		Payload:       event.Payload(),
+	})
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Key:   []byte(event.AggregateID().String()),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: HeaderEventID, Value: []byte(event.ID().String())},
+			{Key: HeaderEventType, Value: []byte(event.Type())},
+			{Key: HeaderEventVersion, Value: []byte(strconv.Itoa(event.Version()))},
+		},
+		Time: event.OccurredAt(),
+	}, nil
+}
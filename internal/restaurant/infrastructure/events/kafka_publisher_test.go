@@ -0,0 +1,41 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToMessage(t *testing.T) {
+	favorite := model.NewFavorite(uuid.New(), uuid.New())
+	event := model.NewFavoriteAddedEvent(favorite)
+
+	message, err := toMessage(event)
+
+	require.NoError(t, err)
+	assert.Equal(t, favorite.ID().String(), string(message.Key))
+	assert.Equal(t, event.OccurredAt(), message.Time)
+
+	headers := make(map[string]string, len(message.Headers))
+	for _, header := range message.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	assert.Equal(t, map[string]string{
+		HeaderEventID:      event.ID().String(),
+		HeaderEventType:    "favorite.added",
+		HeaderEventVersion: "1",
+	}, headers)
+
+	var envelope Envelope
+	require.NoError(t, json.Unmarshal(message.Value, &envelope))
+	assert.Equal(t, event.ID(), envelope.ID)
+	assert.Equal(t, model.EventFavoriteAdded, envelope.Type)
+	assert.Equal(t, model.FavoriteEventVersion, envelope.Version)
+	assert.Equal(t, model.AggregateFavorite, envelope.AggregateType)
+	assert.Equal(t, favorite.ID(), envelope.AggregateID)
+	assert.JSONEq(t, string(event.Payload()), string(envelope.Payload))
+}
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+)
+
+// MemoryPublisher is an in-memory implementation of EventPublisher for tests.
+// It keeps every published event in order.
+type MemoryPublisher struct {
+	events   []*model.DomainEvent
+	err      error
+	rejected map[uuid.UUID]error
+	mu       sync.RWMutex
+}
+
+// NewMemoryPublisher creates a new in-memory publisher
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish records the events, or fails with the error set by FailWith or
+// Reject, publishing none of them
+func (p *MemoryPublisher) Publish(ctx context.Context, events []*model.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+	for _, event := range events {
+		if err, ok := p.rejected[event.ID()]; ok {
+			return err
+		}
+	}
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// FailWith makes every following Publish fail with err; nil lets it succeed again
+func (p *MemoryPublisher) FailWith(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
+
+// Reject makes every following Publish of event fail with err, like a
+// message the broker refuses
+func (p *MemoryPublisher) Reject(event *model.DomainEvent, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.rejected == nil {
+		p.rejected = make(map[uuid.UUID]error)
+	}
+	p.rejected[event.ID()] = err
+}
+
+// Events returns the published events, oldest first
+func (p *MemoryPublisher) Events() []*model.DomainEvent {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return append([]*model.DomainEvent{}, p.events...)
+}
+
+// EventsOfType returns the published events of one type, oldest first
+func (p *MemoryPublisher) EventsOfType(eventType model.EventType) []*model.DomainEvent {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var events []*model.DomainEvent
+	for _, event := range p.events {
+		if event.Type() == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Reset forgets the published events
+func (p *MemoryPublisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = nil
+}
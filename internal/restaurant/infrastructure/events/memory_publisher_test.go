@@ -0,0 +1,34 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryPublisher(t *testing.T) {
+	publisher := NewMemoryPublisher()
+	ctx := context.Background()
+	favorite := model.NewFavorite(uuid.New(), uuid.New())
+	added := model.NewFavoriteAddedEvent(favorite)
+	deleted := model.NewRestaurantDeletedEvent(favorite.RestaurantID(), nil)
+	removed := model.NewFavoriteRemovedEvent(favorite)
+
+	require.NoError(t, publisher.Publish(ctx, []*model.DomainEvent{added, deleted}))
+
+	publisher.FailWith(errors.New("broker down"))
+	assert.Error(t, publisher.Publish(ctx, []*model.DomainEvent{removed}))
+	publisher.FailWith(nil)
+	require.NoError(t, publisher.Publish(ctx, []*model.DomainEvent{removed}))
+
+	assert.Equal(t, []*model.DomainEvent{added, deleted, removed}, publisher.Events())
+	assert.Equal(t, []*model.DomainEvent{added}, publisher.EventsOfType(model.EventFavoriteAdded))
+
+	publisher.Reset()
+	assert.Empty(t, publisher.Events())
+}
@@ -21,7 +21,17 @@ type ConnectionConfig struct {
 
 // NewMapServiceConnection creates a new gRPC connection to Map Service
 func NewMapServiceConnection(cfg *ConnectionConfig, logger *zap.Logger) (*grpc.ClientConn, error) {
-	logger.Info("Connecting to Map Service",
+	return newConnection("Map Service", cfg, logger)
+}
+
+// NewSpiderServiceConnection creates a new gRPC connection to Spider Service
+func NewSpiderServiceConnection(cfg *ConnectionConfig, logger *zap.Logger) (*grpc.ClientConn, error) {
+	return newConnection("Spider Service", cfg, logger)
+}
+
+// newConnection creates a gRPC connection to the named service
+func newConnection(service string, cfg *ConnectionConfig, logger *zap.Logger) (*grpc.ClientConn, error) {
+	logger.Info("Connecting to "+service,
 		zap.String("address", cfg.Address),
 		zap.Duration("timeout", cfg.Timeout),
 	)
@@ -41,14 +51,14 @@ func NewMapServiceConnection(cfg *ConnectionConfig, logger *zap.Logger) (*grpc.C
 		grpc.WithUnaryInterceptor(loggingInterceptor(logger)),
 	)
 	if err != nil {
-		logger.Error("Failed to connect to Map Service",
+		logger.Error("Failed to connect to "+service,
 			zap.Error(err),
 			zap.String("address", cfg.Address),
 		)
 		return nil, err
 	}
 
-	logger.Info("Successfully connected to Map Service")
+	logger.Info("Successfully connected to " + service)
 	return conn, nil
 }
 
@@ -26,10 +26,16 @@ func NewMapServiceClient(conn *grpc.ClientConn, logger *zap.Logger, timeout time
 	}
 }
 
+// placeFieldMask is the Google Places field mask of every place lookup. It
+// must include addressComponents for area extraction and editorialSummary
+// for the localized summaries fetched per language.
+const placeFieldMask = "id,displayName,formattedAddress,location,rating,priceLevel,photos,currentOpeningHours,regularOpeningHours,addressComponents,editorialSummary"
+
 // QuickSearch calls Map Service QuickSearch RPC
-func (c *MapServiceClient) QuickSearch(ctx context.Context, placeID string) (*mapv1.Place, error) {
+func (c *MapServiceClient) QuickSearch(ctx context.Context, placeID, languageCode string) (*mapv1.Place, error) {
 	c.logger.Info("Calling Map Service QuickSearch",
 		zap.String("place_id", placeID),
+		zap.String("language", languageCode),
 	)
 
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
@@ -37,9 +43,8 @@ func (c *MapServiceClient) QuickSearch(ctx context.Context, placeID string) (*ma
 
 	req := &mapv1.QuickSearchRequest{
 		PlaceId:      placeID,
-		LanguageCode: "en", // Default to English for area extraction
-		// IMPORTANT: Must include addressComponents for area extraction
-		ApiMask: "id,displayName,formattedAddress,location,rating,priceLevel,photos,currentOpeningHours,addressComponents",
+		LanguageCode: languageCode,
+		ApiMask:      placeFieldMask,
 	}
 
 	resp, err := c.client.QuickSearch(ctx, req)
@@ -67,16 +72,19 @@ func (c *MapServiceClient) QuickSearch(ctx context.Context, placeID string) (*ma
 }
 
 // BatchGetPlaces calls Map Service BatchGetPlaces RPC
-func (c *MapServiceClient) BatchGetPlaces(ctx context.Context, placeIDs []string) ([]*mapv1.Place, error) {
+func (c *MapServiceClient) BatchGetPlaces(ctx context.Context, placeIDs []string, languageCode string) ([]*mapv1.Place, error) {
 	c.logger.Info("Calling Map Service BatchGetPlaces",
 		zap.Int("count", len(placeIDs)),
+		zap.String("language", languageCode),
 	)
 
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &mapv1.BatchGetPlacesRequest{
-		PlaceIds: placeIDs,
+		PlaceIds:     placeIDs,
+		LanguageCode: languageCode,
+		ApiMask:      placeFieldMask,
 	}
 
 	resp, err := c.client.BatchGetPlaces(ctx, req)
@@ -96,6 +104,33 @@ func (c *MapServiceClient) BatchGetPlaces(ctx context.Context, placeIDs []string
 	return resp.Places, nil
 }
 
+// GetPhotoMedia calls Map Service GetPhotoMedia RPC and returns the image
+// bytes and their content type
+func (c *MapServiceClient) GetPhotoMedia(ctx context.Context, photoName string, maxWidthPx int) ([]byte, string, error) {
+	c.logger.Info("Calling Map Service GetPhotoMedia",
+		zap.String("photo_name", photoName),
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &mapv1.GetPhotoMediaRequest{
+		PhotoName:  photoName,
+		MaxWidthPx: int32(maxWidthPx),
+	}
+
+	resp, err := c.client.GetPhotoMedia(ctx, req)
+	if err != nil {
+		c.logger.Error("Map Service GetPhotoMedia failed",
+			zap.String("photo_name", photoName),
+			zap.Error(err),
+		)
+		return nil, "", fmt.Errorf("map service get photo media failed: %w", err)
+	}
+
+	return resp.Data, resp.ContentType, nil
+}
+
 // AdvanceSearch calls Map Service AdvanceSearch RPC
 func (c *MapServiceClient) AdvanceSearch(ctx context.Context, req *mapv1.AdvanceSearchRequest) ([]*mapv1.Place, error) {
 	c.logger.Info("Calling Map Service AdvanceSearch",
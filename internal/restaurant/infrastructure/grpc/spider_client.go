@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	spiderv1 "github.com/Leon180/tabelogo-v2/api/gen/spider/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// SpiderServiceClient wraps the gRPC client for Spider Service
+type SpiderServiceClient struct {
+	client  spiderv1.SpiderServiceClient
+	logger  *zap.Logger
+	timeout time.Duration
+}
+
+// NewSpiderServiceClient creates a new Spider Service client
+func NewSpiderServiceClient(conn *grpc.ClientConn, logger *zap.Logger, timeout time.Duration) *SpiderServiceClient {
+	return &SpiderServiceClient{
+		client:  spiderv1.NewSpiderServiceClient(conn),
+		logger:  logger,
+		timeout: timeout,
+	}
+}
+
+// SearchSimilarRestaurants calls Spider Service SearchSimilarRestaurants RPC
+func (c *SpiderServiceClient) SearchSimilarRestaurants(ctx context.Context, req *spiderv1.SearchSimilarRestaurantsRequest) ([]*spiderv1.TabelogRestaurant, error) {
+	c.logger.Info("Calling Spider Service SearchSimilarRestaurants",
+		zap.String("google_id", req.GoogleId),
+		zap.String("place_name", req.PlaceName),
+		zap.String("area", req.Area),
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.SearchSimilarRestaurants(ctx, req)
+	if err != nil {
+		c.logger.Error("Spider Service SearchSimilarRestaurants failed",
+			zap.String("google_id", req.GoogleId),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("spider service search similar restaurants failed: %w", err)
+	}
+
+	c.logger.Info("Spider Service SearchSimilarRestaurants succeeded",
+		zap.String("google_id", req.GoogleId),
+		zap.Int("results", len(resp.Restaurants)),
+	)
+
+	return resp.Restaurants, nil
+}
@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure/events"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure/grpc"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure/photos"
 	restaurantpostgres "github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure/postgres"
+	restaurantredis "github.com/Leon180/tabelogo-v2/internal/restaurant/infrastructure/redis"
 	"github.com/Leon180/tabelogo-v2/pkg/config"
 	redisclient "github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
@@ -34,6 +37,54 @@ func NewMapServiceClient(conn *grpclib.ClientConn, cfg *config.Config, logger *z
 	return grpc.NewMapServiceClient(conn, logger, cfg.MapService.Timeout)
 }
 
+// NewSpiderServiceClient creates a Spider Service gRPC client over its own
+// connection, closed with the application
+func NewSpiderServiceClient(cfg *config.Config, lc fx.Lifecycle, logger *zap.Logger) (application.SpiderServiceClient, error) {
+	conn, err := grpc.NewSpiderServiceConnection(&grpc.ConnectionConfig{
+		Address:          cfg.SpiderService.GRPCAddr,
+		Timeout:          cfg.SpiderService.Timeout,
+		MaxRetries:       3,
+		KeepAliveTime:    60 * time.Second,
+		KeepAliveTimeout: 20 * time.Second,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return conn.Close()
+		},
+	})
+
+	return grpc.NewSpiderServiceClient(conn, logger, cfg.SpiderService.Timeout), nil
+}
+
+// NewPhotoBlobStore creates the blob store restaurant photos are kept in
+func NewPhotoBlobStore(cfg *config.Config) (application.BlobStore, error) {
+	return photos.NewLocalBlobStore(cfg.Photos.StorageDir)
+}
+
+// NewPhotoDownloader creates the downloader for Tabelog photos
+func NewPhotoDownloader() application.PhotoDownloader {
+	return photos.NewHTTPDownloader(15*time.Second, application.TabelogImageHosts)
+}
+
+// NewEventPublisher creates the Kafka publisher of domain events, closed with
+// the application
+func NewEventPublisher(cfg *config.Config, lc fx.Lifecycle, logger *zap.Logger) application.EventPublisher {
+	publisher := events.NewKafkaPublisher(cfg.GetKafkaBrokers(), cfg.Events.Topic, logger)
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Closing Kafka event publisher")
+			return publisher.Close()
+		},
+	})
+
+	return publisher
+}
+
 // Module provides infrastructure dependencies
 var Module = fx.Module("restaurant.infrastructure",
 	fx.Provide(
@@ -41,9 +92,27 @@ var Module = fx.Module("restaurant.infrastructure",
 		NewRedis,
 		restaurantpostgres.NewRestaurantRepository,
 		restaurantpostgres.NewFavoriteRepository,
+		restaurantpostgres.NewMergeRepository,
+		restaurantpostgres.NewRevisionRepository,
+		restaurantpostgres.NewSuggestionRepository,
+		restaurantpostgres.NewPhotoRepository,
+		restaurantpostgres.NewPopularityRepository,
+		restaurantpostgres.NewCollectionRepository,
+		restaurantpostgres.NewVisitRepository,
+		restaurantpostgres.NewReviewRepository,
+		restaurantpostgres.NewOutboxRepository,
+		// Popularity counters
+		restaurantredis.NewPopularityCounter,
+		// Photo storage
+		NewPhotoBlobStore,
+		NewPhotoDownloader,
+		// Domain events
+		NewEventPublisher,
 		// Map Service integration
 		NewMapServiceConnection,
 		NewMapServiceClient,
+		// Spider Service integration
+		NewSpiderServiceClient,
 	),
 )
 
@@ -0,0 +1,39 @@
+package photos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPDownloader_Redirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			_, _ = w.Write([]byte("jpeg"))
+		case "/moved":
+			http.Redirect(w, r, "/image.jpg", http.StatusFound)
+		case "/elsewhere":
+			// Same server under a host name that is not allowed
+			http.Redirect(w, r, "http://"+strings.Replace(r.Host, "127.0.0.1", "localhost", 1)+"/image.jpg", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+	downloader := NewHTTPDownloader(5*time.Second, []string{"127.0.0.1"})
+	ctx := context.Background()
+
+	data, contentType, err := downloader.Download(ctx, server.URL+"/moved")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("jpeg"), data)
+	assert.Equal(t, "image/jpeg", contentType)
+
+	_, _, err = downloader.Download(ctx, server.URL+"/elsewhere")
+	assert.ErrorContains(t, err, "is not allowed")
+}
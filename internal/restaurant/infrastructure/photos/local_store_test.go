@@ -0,0 +1,38 @@
+package photos
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+	key := "restaurants/r1/photos/p1_thumb.jpg"
+
+	require.NoError(t, store.Put(ctx, key, []byte("v1")))
+	require.NoError(t, store.Put(ctx, key, []byte("v2")))
+
+	data, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v2"), data)
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, domainerrors.ErrBlobNotFound)
+	assert.ErrorIs(t, store.Delete(ctx, key), domainerrors.ErrBlobNotFound)
+}
+
+func TestLocalBlobStore_RejectsKeysOutsideRoot(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "a/../../escape", "a//b"} {
+		assert.Error(t, store.Put(context.Background(), key, []byte("x")), key)
+	}
+}
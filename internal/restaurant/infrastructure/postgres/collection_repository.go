@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CollectionORM is the database model for Collection
+type CollectionORM struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Name        string     `gorm:"type:varchar(100);not null"`
+	Description string     `gorm:"type:text;not null"`
+	ShareSlug   *string    `gorm:"type:varchar(32);uniqueIndex"`
+	SharedAt    *time.Time `gorm:"type:timestamp"`
+	CreatedAt   time.Time  `gorm:"not null"`
+	UpdatedAt   time.Time  `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (CollectionORM) TableName() string {
+	return "favorite_collections"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (c *CollectionORM) ToDomain() *model.Collection {
+	var shareSlug string
+	if c.ShareSlug != nil {
+		shareSlug = *c.ShareSlug
+	}
+
+	return model.ReconstructCollection(
+		c.ID,
+		c.UserID,
+		c.Name,
+		c.Description,
+		shareSlug,
+		c.SharedAt,
+		c.CreatedAt,
+		c.UpdatedAt,
+	)
+}
+
+// FromDomainCollection converts Domain entity to ORM model
+func FromDomainCollection(c *model.Collection) *CollectionORM {
+	// Unshared collections store NULL, as the slug index is unique
+	var shareSlug *string
+	if c.IsShared() {
+		slug := c.ShareSlug()
+		shareSlug = &slug
+	}
+
+	return &CollectionORM{
+		ID:          c.ID(),
+		UserID:      c.UserID(),
+		Name:        c.Name(),
+		Description: c.Description(),
+		ShareSlug:   shareSlug,
+		SharedAt:    c.SharedAt(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}
+}
+
+// CollectionItemORM is the database model for a favorite in a collection
+type CollectionItemORM struct {
+	CollectionID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FavoriteID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Position     int       `gorm:"type:int;not null"`
+	AddedAt      time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (CollectionItemORM) TableName() string {
+	return "favorite_collection_items"
+}
+
+type collectionRepository struct {
+	db *gorm.DB
+}
+
+// NewCollectionRepository creates a new postgres collection repository
+func NewCollectionRepository(db *gorm.DB) repository.CollectionRepository {
+	return &collectionRepository{db: db}
+}
+
+// Create stores a new collection. The unique index on the user and the
+// lowercased name turns a second collection with the same name into a conflict.
+func (r *collectionRepository) Create(ctx context.Context, collection *model.Collection) error {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(FromDomainCollection(collection))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrCollectionAlreadyExists
+	}
+	return nil
+}
+
+// FindByID finds a collection by ID
+func (r *collectionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Collection, error) {
+	var orm CollectionORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrCollectionNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// FindByShareSlug finds a shared collection by its share slug
+func (r *collectionRepository) FindByShareSlug(ctx context.Context, slug string) (*model.Collection, error) {
+	var orm CollectionORM
+	if err := r.db.WithContext(ctx).Where("share_slug = ?", slug).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrSharedListNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// FindByUserID lists a user's collections, oldest first
+func (r *collectionRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Collection, error) {
+	var orms []CollectionORM
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&orms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]*model.Collection, len(orms))
+	for i := range orms {
+		collections[i] = orms[i].ToDomain()
+	}
+	return collections, nil
+}
+
+// Update updates the name, description and sharing of a collection
+func (r *collectionRepository) Update(ctx context.Context, collection *model.Collection) error {
+	var taken int64
+	err := r.db.WithContext(ctx).
+		Model(&CollectionORM{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", collection.UserID(), collection.Name(), collection.ID()).
+		Count(&taken).Error
+	if err != nil {
+		return err
+	}
+	if taken > 0 {
+		return domainerrors.ErrCollectionAlreadyExists
+	}
+
+	orm := FromDomainCollection(collection)
+	result := r.db.WithContext(ctx).
+		Model(&CollectionORM{}).
+		Where("id = ?", collection.ID()).
+		Updates(map[string]interface{}{
+			"name":        orm.Name,
+			"description": orm.Description,
+			"share_slug":  orm.ShareSlug,
+			"shared_at":   orm.SharedAt,
+			"updated_at":  orm.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrCollectionNotFound
+	}
+	return nil
+}
+
+// Delete deletes a collection; its items go with it through the cascade
+func (r *collectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&CollectionORM{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrCollectionNotFound
+	}
+	return nil
+}
+
+// collectionItemRow is a collection item joined with its favorite
+type collectionItemRow struct {
+	FavoriteORM
+	Position int
+	AddedAt  time.Time
+}
+
+// ListItems lists the favorites in a collection in their order. A removed
+// favorite keeps its row, as favorites are soft deleted, but is left out.
+func (r *collectionRepository) ListItems(ctx context.Context, collectionID uuid.UUID) ([]*model.CollectionItem, error) {
+	var rows []collectionItemRow
+	err := r.db.WithContext(ctx).
+		Table("favorite_collection_items AS i").
+		Select("f.*, i.position, i.added_at").
+		Joins("JOIN user_favorites AS f ON f.id = i.favorite_id").
+		Where("i.collection_id = ? AND f.deleted_at IS NULL", collectionID).
+		Order("i.position ASC, i.added_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.CollectionItem, len(rows))
+	for i := range rows {
+		items[i] = &model.CollectionItem{
+			Favorite: rows[i].FavoriteORM.ToDomain(),
+			Position: rows[i].Position,
+			AddedAt:  rows[i].AddedAt,
+		}
+	}
+	return items, nil
+}
+
+// AddItem appends a favorite to the end of a collection
+func (r *collectionRepository) AddItem(ctx context.Context, collectionID, favoriteID uuid.UUID) error {
+	return r.db.WithContext(ctx).Exec(`
+		INSERT INTO favorite_collection_items (collection_id, favorite_id, position, added_at)
+		SELECT ?, ?, COALESCE(MAX(position), -1) + 1, NOW()
+		FROM favorite_collection_items
+		WHERE collection_id = ?
+		ON CONFLICT (collection_id, favorite_id) DO NOTHING`,
+		collectionID, favoriteID, collectionID,
+	).Error
+}
+
+// RemoveItem removes a favorite from a collection
+func (r *collectionRepository) RemoveItem(ctx context.Context, collectionID, favoriteID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("collection_id = ? AND favorite_id = ?", collectionID, favoriteID).
+		Delete(&CollectionItemORM{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrNotInCollection
+	}
+	return nil
+}
+
+// ReorderItems sets each favorite's position to its index in favoriteIDs
+func (r *collectionRepository) ReorderItems(ctx context.Context, collectionID uuid.UUID, favoriteIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for position, favoriteID := range favoriteIDs {
+			result := tx.Model(&CollectionItemORM{}).
+				Where("collection_id = ? AND favorite_id = ?", collectionID, favoriteID).
+				Update("position", position)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return domainerrors.ErrNotInCollection
+			}
+		}
+		return nil
+	})
+}
@@ -96,11 +96,12 @@ func NewFavoriteRepository(db *gorm.DB) repository.FavoriteRepository {
 func (r *favoriteRepository) Create(ctx context.Context, favorite *model.Favorite) error {
 	orm := FromDomainFavorite(favorite)
 
-	if err := r.db.WithContext(ctx).Create(orm).Error; err != nil {
-		return err
-	}
-
-	return nil
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(orm).Error; err != nil {
+			return err
+		}
+		return appendEvents(tx, model.NewFavoriteAddedEvent(favorite))
+	})
 }
 
 // FindByID finds a favorite by ID
@@ -136,7 +137,7 @@ func (r *favoriteRepository) FindByUserID(ctx context.Context, userID uuid.UUID)
 	var orms []FavoriteORM
 	if err := r.db.WithContext(ctx).
 		Where("user_id = ?", userID).
-		Order("created_at DESC").
+		Order(orderByNewest.clause()).
 		Find(&orms).Error; err != nil {
 		return nil, err
 	}
@@ -149,6 +150,34 @@ func (r *favoriteRepository) FindByUserID(ctx context.Context, userID uuid.UUID)
 	return favorites, nil
 }
 
+// FindByUserIDAfter returns the page of a user's favorites after the cursor,
+// newest first, and the cursor of the next page (nil on the last page)
+func (r *favoriteRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, after *repository.Cursor, limit int) ([]*model.Favorite, *repository.Cursor, error) {
+	query, err := orderByNewest.page(r.db.WithContext(ctx).Where("user_id = ?", userID), after, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var orms []FavoriteORM
+	if err := query.Find(&orms).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var next *repository.Cursor
+	if len(orms) > limit {
+		orms = orms[:limit]
+		last := orms[limit-1]
+		next = orderByNewest.cursor(last.CreatedAt, last.ID)
+	}
+
+	favorites := make([]*model.Favorite, len(orms))
+	for i, orm := range orms {
+		favorites[i] = orm.ToDomain()
+	}
+
+	return favorites, next, nil
+}
+
 // FindByRestaurantID finds all favorites for a restaurant
 func (r *favoriteRepository) FindByRestaurantID(ctx context.Context, restaurantID uuid.UUID) ([]*model.Favorite, error) {
 	var orms []FavoriteORM
@@ -183,15 +212,25 @@ func (r *favoriteRepository) Update(ctx context.Context, favorite *model.Favorit
 
 // Delete soft-deletes a favorite by ID
 func (r *favoriteRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&FavoriteORM{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return domainerrors.ErrFavoriteNotFound
-	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var orm FavoriteORM
+		if err := tx.Where("id = ?", id).First(&orm).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domainerrors.ErrFavoriteNotFound
+			}
+			return err
+		}
 
-	return nil
+		result := tx.Where("id = ?", id).Delete(&FavoriteORM{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrFavoriteNotFound
+		}
+
+		return appendEvents(tx, model.NewFavoriteRemovedEvent(orm.ToDomain()))
+	})
 }
 
 // Exists checks if a favorite exists for a user and restaurant
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// filteredRow is a restaurant row with the optional distance and score columns
+// of a filtered listing
+type filteredRow struct {
+	RestaurantORM
+	DistanceKm *float64 `gorm:"column:distance_km"`
+	Score      *float64 `gorm:"column:score"`
+}
+
+// filterOrders maps each sort option to its keyset ordering
+var filterOrders = map[repository.RestaurantSort]keysetOrder{
+	repository.SortByRelevance: orderByScore,
+	repository.SortByDistance:  orderByDistance,
+	repository.SortByRating:    orderByRating,
+	repository.SortByViewCount: orderByViewCount,
+	repository.SortByNewest:    orderByNewest,
+	repository.SortByName:      orderByName,
+}
+
+// sortKey returns the value of the row's sort key for the given sort option
+func (r *filteredRow) sortKey(sort repository.RestaurantSort) interface{} {
+	switch sort {
+	case repository.SortByRelevance:
+		return derefFloat(r.Score)
+	case repository.SortByDistance:
+		return derefFloat(r.DistanceKm)
+	case repository.SortByRating:
+		return r.Rating
+	case repository.SortByViewCount:
+		return r.ViewCount
+	case repository.SortByName:
+		return r.Name
+	default:
+		return r.CreatedAt
+	}
+}
+
+func derefFloat(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// filterQuery builds the subquery of rows matching the filter. With a Near
+// point it starts from the radius query and exposes distance_km; the other
+// criteria are plain column predicates that apply to either source.
+func filterQuery(db *gorm.DB, backend spatialBackend, filter repository.RestaurantFilter, search *searchExprs) *gorm.DB {
+	var q *gorm.DB
+	if filter.Near != nil {
+		q = nearbyQuery(db, backend, filter.Near.Latitude, filter.Near.Longitude, filter.Near.RadiusKm)
+	} else {
+		q = db.Model(&RestaurantORM{})
+	}
+
+	if filter.Area != "" {
+		q = q.Where("area = ?", filter.Area)
+	}
+	if filter.CuisineType != "" {
+		q = q.Where(cuisineTypeCondition(filter.CuisineType))
+	}
+	if filter.Cuisine != "" {
+		q = q.Where(cuisineCondition(filter.Cuisine))
+	}
+	if filter.Source != "" {
+		q = q.Where("source = ?", string(filter.Source))
+	}
+	if filter.MinRating > 0 {
+		q = q.Where("rating >= ?", filter.MinRating)
+	}
+	if filter.MinPriceLevel > 0 {
+		q = q.Where("price_level >= ?", filter.MinPriceLevel)
+	}
+	if filter.MaxPriceLevel > 0 {
+		q = q.Where("price_level BETWEEN 1 AND ?", filter.MaxPriceLevel)
+	}
+	if filter.Budget != nil {
+		q = q.Where(budgetCondition(*filter.Budget))
+	}
+	if filter.OpenNow {
+		q = q.Where(openAtCondition(time.Now()))
+	}
+	if filter.OpenAt != nil {
+		q = q.Where(openAtCondition(*filter.OpenAt))
+	}
+	if filter.UpdatedSince != nil {
+		q = q.Where("updated_at >= ?", *filter.UpdatedSince)
+	}
+	if search != nil {
+		q = q.Where(search.match, search.matchArgs...)
+	}
+
+	return q
+}
+
+// cuisineCondition matches restaurants in a cuisine category or any of its
+// descendants
+func cuisineCondition(id string) clause.Expr {
+	return clause.Expr{SQL: "cuisines && ?", Vars: []interface{}{pq.StringArray(model.Cuisines.WithDescendants(id))}}
+}
+
+// cuisineTypeCondition matches restaurants whose cuisine type is text. When
+// text names a cuisine category, restaurants in it or its descendants match
+// too, so "ramen" and "ラーメン" find tsukemen shops.
+func cuisineTypeCondition(text string) clause.Expr {
+	category, ok := model.Cuisines.Match(text)
+	if !ok {
+		return clause.Expr{SQL: "cuisine_type = ?", Vars: []interface{}{text}}
+	}
+	return clause.Expr{
+		SQL:  "cuisine_type = ? OR cuisines && ?",
+		Vars: []interface{}{text, pq.StringArray(model.Cuisines.WithDescendants(category.ID))},
+	}
+}
+
+// openAtSQL matches restaurants with an opening period covering @minute, the
+// minute of the week in the schedule's timezone, that did not open on a
+// holiday. It mirrors model.OpeningSchedule.IsOpenAt: a period ends a week
+// after it opens when it has no close, and a close at or before the open
+// time wraps into the next day (or week).
+const openAtSQL = `opening_schedule IS NOT NULL AND EXISTS (
+	SELECT 1
+	FROM jsonb_array_elements(opening_schedule->'periods') AS p
+	CROSS JOIN LATERAL (SELECT
+		(p->'open'->>'day')::int * 1440 + (p->'open'->>'hour')::int * 60 + (p->'open'->>'minute')::int AS open_at,
+		(p->'close'->>'day')::int * 1440 + (p->'close'->>'hour')::int * 60 + (p->'close'->>'minute')::int AS close_at
+	) AS w
+	CROSS JOIN LATERAL (SELECT
+		CASE
+			WHEN w.close_at IS NULL THEN w.open_at + 10080
+			WHEN w.close_at <= w.open_at THEN w.close_at + 10080
+			ELSE w.close_at
+		END AS end_at,
+		CASE WHEN @minute >= w.open_at THEN @minute ELSE @minute + 10080 END AS at
+	) AS s
+	WHERE s.at < s.end_at
+	AND NOT COALESCE(opening_schedule->'holidays', '[]'::jsonb) @> to_jsonb(to_char(
+		CASE
+			WHEN w.close_at IS NULL THEN CAST(@local AS timestamp)
+			ELSE CAST(@local AS timestamp) - make_interval(mins => s.at - w.open_at)
+		END, 'YYYY-MM-DD'))
+)`
+
+// openAtCondition builds the open-at predicate for an instant
+func openAtCondition(t time.Time) clause.NamedExpr {
+	local := t.In(model.ScheduleLocation)
+	minute := int(local.Weekday())*24*60 + local.Hour()*60 + local.Minute()
+	return clause.NamedExpr{SQL: openAtSQL, Vars: []interface{}{
+		sql.Named("minute", minute),
+		sql.Named("local", local.Format("2006-01-02 15:04:05")),
+	}}
+}
+
+// budgetCondition builds the predicate of a budget filter: the band of the
+// meal, or of either meal, overlaps the budget
+func budgetCondition(budget repository.BudgetFilter) clause.Expr {
+	meals := []model.Meal{model.MealLunch, model.MealDinner}
+	if budget.Meal != "" {
+		meals = []model.Meal{budget.Meal}
+	}
+
+	var conds []string
+	var vars []interface{}
+	for _, meal := range meals {
+		min, max := "price_"+string(meal)+"_min", "price_"+string(meal)+"_max"
+		cond := min + " IS NOT NULL"
+		if budget.Max > 0 {
+			cond += " AND " + min + " <= ?"
+			vars = append(vars, budget.Max)
+		}
+		if budget.Min > 0 {
+			cond += " AND (" + max + " IS NULL OR " + max + " >= ?)"
+			vars = append(vars, budget.Min)
+		}
+		conds = append(conds, "("+cond+")")
+	}
+	return clause.Expr{SQL: "(" + strings.Join(conds, " OR ") + ")", Vars: vars}
+}
+
+// toFilteredRestaurants converts filtered rows into domain results, skipping invalid records
+func toFilteredRestaurants(rows []filteredRow) []*repository.FilteredRestaurant {
+	results := make([]*repository.FilteredRestaurant, 0, len(rows))
+	for i := range rows {
+		restaurant, err := rows[i].RestaurantORM.ToDomain()
+		if err != nil {
+			continue
+		}
+		results = append(results, &repository.FilteredRestaurant{
+			Restaurant: restaurant,
+			DistanceKm: rows[i].DistanceKm,
+			Score:      rows[i].Score,
+		})
+	}
+	return results
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"gorm.io/gorm"
+)
+
+// spatialBackend identifies which distance implementation the database supports
+type spatialBackend int
+
+const (
+	// spatialHaversine computes distances in plain SQL (no extension required)
+	spatialHaversine spatialBackend = iota
+	// spatialEarthDistance uses the cube/earthdistance extensions with a GiST index
+	spatialEarthDistance
+	// spatialPostGIS uses geography types with a GiST index
+	spatialPostGIS
+)
+
+func (b spatialBackend) String() string {
+	switch b {
+	case spatialEarthDistance:
+		return "earthdistance"
+	case spatialPostGIS:
+		return "postgis"
+	default:
+		return "haversine"
+	}
+}
+
+// detectSpatialBackend checks which spatial extensions are installed.
+// earthdistance is preferred because migration 000005 builds its index;
+// without either extension the pure-SQL haversine implementation is used.
+func detectSpatialBackend(ctx context.Context, db *gorm.DB) (spatialBackend, error) {
+	var extensions []string
+	if err := db.WithContext(ctx).
+		Raw("SELECT extname FROM pg_extension WHERE extname IN ('earthdistance', 'postgis')").
+		Scan(&extensions).Error; err != nil {
+		return spatialHaversine, err
+	}
+
+	backend := spatialHaversine
+	for _, ext := range extensions {
+		switch ext {
+		case "earthdistance":
+			return spatialEarthDistance, nil
+		case "postgis":
+			backend = spatialPostGIS
+		}
+	}
+	return backend, nil
+}
+
+// nearbyRow is a restaurant row with its computed distance
+type nearbyRow struct {
+	RestaurantORM
+	DistanceKm float64 `gorm:"column:distance_km"`
+}
+
+// Point expressions shared by the index definitions in migration 000005.
+// They must match the indexed expressions exactly for the planner to use the index.
+const (
+	earthPointExpr = "ll_to_earth(latitude::float8, longitude::float8)"
+	geogPointExpr  = "ST_SetSRID(ST_MakePoint(longitude::float8, latitude::float8), 4326)::geography"
+)
+
+// haversineExpr returns the great-circle distance (km) from the bound point (lat, lat, lng) to each row
+var haversineExpr = fmt.Sprintf(
+	"%f * 2 * ASIN(LEAST(1, SQRT("+
+		"POWER(SIN(RADIANS(latitude - ?) / 2), 2) + "+
+		"COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2))))",
+	model.EarthRadiusKm,
+)
+
+// nearbyQuery builds the distance-filtered subquery for the given backend.
+// The result exposes all restaurant columns plus distance_km.
+func nearbyQuery(db *gorm.DB, backend spatialBackend, lat, lng, radiusKm float64) *gorm.DB {
+	radiusM := radiusKm * 1000
+
+	switch backend {
+	case spatialEarthDistance:
+		distance := fmt.Sprintf("earth_distance(ll_to_earth(?, ?), %s)", earthPointExpr)
+		return db.Table("restaurants").
+			Select("restaurants.*, "+distance+" / 1000.0 AS distance_km", lat, lng).
+			Where("deleted_at IS NULL").
+			Where(fmt.Sprintf("earth_box(ll_to_earth(?, ?), ?) @> %s", earthPointExpr), lat, lng, radiusM).
+			Where(distance+" <= ?", lat, lng, radiusM)
+
+	case spatialPostGIS:
+		origin := "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+		return db.Table("restaurants").
+			Select(fmt.Sprintf("restaurants.*, ST_Distance(%s, %s) / 1000.0 AS distance_km", geogPointExpr, origin), lng, lat).
+			Where("deleted_at IS NULL").
+			Where(fmt.Sprintf("ST_DWithin(%s, %s, ?)", geogPointExpr, origin), lng, lat, radiusM)
+
+	default:
+		inner := db.Table("restaurants").
+			Select("restaurants.*, "+haversineExpr+" AS distance_km", lat, lat, lng).
+			Where("deleted_at IS NULL")
+		inner = applyBoundingBox(inner, lat, lng, radiusKm)
+		return db.Table("(?) AS nearby", inner).
+			Select("*").
+			Where("distance_km <= ?", radiusKm)
+	}
+}
+
+// kmPerDegree is the length of one degree of latitude (and of longitude at the equator)
+const kmPerDegree = math.Pi * model.EarthRadiusKm / 180
+
+// applyBoundingBox adds a latitude/longitude prefilter that fully contains the
+// search circle, so the btree index can narrow rows before computing distances.
+// Longitude bounds widen with latitude and wrap across the antimeridian.
+func applyBoundingBox(db *gorm.DB, lat, lng, radiusKm float64) *gorm.DB {
+	latDelta := radiusKm / kmPerDegree
+	minLat, maxLat := lat-latDelta, lat+latDelta
+	db = db.Where("latitude BETWEEN ? AND ?", math.Max(minLat, -90), math.Min(maxLat, 90))
+
+	// Circle covers a pole: every longitude is in range
+	if minLat <= -90 || maxLat >= 90 {
+		return db
+	}
+
+	// Angular radius / cos(lat) is the exact half-width of the circle's longitude span
+	angular := radiusKm / model.EarthRadiusKm
+	lngDelta := math.Asin(math.Min(1, math.Sin(angular)/math.Cos(lat*math.Pi/180))) * 180 / math.Pi
+	if lngDelta >= 180 {
+		return db
+	}
+
+	minLng, maxLng := lng-lngDelta, lng+lngDelta
+	switch {
+	case minLng < -180:
+		return db.Where("(longitude >= ? OR longitude <= ?)", minLng+360, maxLng)
+	case maxLng > 180:
+		return db.Where("(longitude >= ? OR longitude <= ?)", minLng, maxLng-360)
+	default:
+		return db.Where("longitude BETWEEN ? AND ?", minLng, maxLng)
+	}
+}
+
+// toNearbyRestaurants converts distance rows into domain results, skipping invalid records
+func toNearbyRestaurants(rows []nearbyRow) []*repository.NearbyRestaurant {
+	results := make([]*repository.NearbyRestaurant, 0, len(rows))
+	for i := range rows {
+		restaurant, err := rows[i].RestaurantORM.ToDomain()
+		if err != nil {
+			continue
+		}
+		results = append(results, &repository.NearbyRestaurant{
+			Restaurant: restaurant,
+			DistanceKm: rows[i].DistanceKm,
+		})
+	}
+	return results
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// keyKind is how a sort key is written into a cursor
+type keyKind int
+
+const (
+	keyTime keyKind = iota
+	keyFloat
+	keyInt
+	keyString
+)
+
+// keysetOrder is an ordering that supports keyset pagination: a sort key in
+// one direction, with id ASC breaking ties so every row has a unique position
+type keysetOrder struct {
+	name string // stored in cursors to reject cursors from another ordering
+	key  string // SQL expression of the sort key
+	desc bool
+	kind keyKind
+}
+
+var (
+	orderByNewest    = keysetOrder{name: "newest", key: "created_at", desc: true, kind: keyTime}
+	orderByScore     = keysetOrder{name: "relevance", key: "score", desc: true, kind: keyFloat}
+	orderByDistance  = keysetOrder{name: "distance", key: "distance_km", kind: keyFloat}
+	orderByRating    = keysetOrder{name: "rating", key: "COALESCE(rating, 0)", desc: true, kind: keyFloat}
+	orderByViewCount = keysetOrder{name: "view_count", key: "view_count", desc: true, kind: keyInt}
+	orderByName      = keysetOrder{name: "name", key: "name", kind: keyString}
+)
+
+// clause returns the ORDER BY clause of the ordering
+func (o keysetOrder) clause() string {
+	if o.desc {
+		return o.key + " DESC, id ASC"
+	}
+	return o.key + " ASC, id ASC"
+}
+
+// page orders the query, starts it after the cursor (nil for the first page)
+// and fetches one row past limit so callers can tell whether a next page exists
+func (o keysetOrder) page(db *gorm.DB, after *repository.Cursor, limit int) (*gorm.DB, error) {
+	db = db.Order(o.clause()).Limit(limit + 1)
+	if after == nil {
+		return db, nil
+	}
+	if after.Order != o.name {
+		return nil, domainerrors.ErrInvalidCursor
+	}
+
+	key, err := o.decodeKey(after.Key)
+	if err != nil {
+		return nil, domainerrors.ErrInvalidCursor
+	}
+
+	op := ">"
+	if o.desc {
+		op = "<"
+	}
+	return db.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND id > ?))", o.key, op, o.key), key, key, after.ID), nil
+}
+
+// cursor returns the cursor positioned at a row with the given sort key and id
+func (o keysetOrder) cursor(key interface{}, id uuid.UUID) *repository.Cursor {
+	var encoded string
+	switch v := key.(type) {
+	case time.Time:
+		encoded = v.Format(time.RFC3339Nano)
+	case float64:
+		encoded = strconv.FormatFloat(v, 'g', -1, 64)
+	case int64:
+		encoded = strconv.FormatInt(v, 10)
+	case string:
+		encoded = v
+	}
+	return &repository.Cursor{Order: o.name, Key: encoded, ID: id}
+}
+
+func (o keysetOrder) decodeKey(key string) (interface{}, error) {
+	switch o.kind {
+	case keyTime:
+		return time.Parse(time.RFC3339Nano, key)
+	case keyFloat:
+		return strconv.ParseFloat(key, 64)
+	case keyInt:
+		return strconv.ParseInt(key, 10, 64)
+	default:
+		return key, nil
+	}
+}
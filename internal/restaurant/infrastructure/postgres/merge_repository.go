@@ -0,0 +1,359 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/dedup"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// RestaurantMergeORM is the database model for RestaurantMerge
+type RestaurantMergeORM struct {
+	ID                 uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	CanonicalID        uuid.UUID      `gorm:"type:uuid;not null;index"`
+	MergedID           uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Source             string         `gorm:"type:varchar(50);not null"`
+	ExternalID         string         `gorm:"type:varchar(255);not null"`
+	Score              float64        `gorm:"type:decimal(4,3)"`
+	MergedBy           string         `gorm:"type:varchar(255)"`
+	MovedFavoriteIDs   pq.StringArray `gorm:"type:uuid[]"`
+	DroppedFavoriteIDs pq.StringArray `gorm:"type:uuid[]"`
+	CreatedAt          time.Time      `gorm:"not null"`
+	UnmergedAt         *time.Time     `gorm:"type:timestamp"`
+}
+
+// TableName overrides the table name
+func (RestaurantMergeORM) TableName() string {
+	return "restaurant_merges"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (m *RestaurantMergeORM) ToDomain() *model.RestaurantMerge {
+	return model.ReconstructRestaurantMerge(
+		m.ID,
+		m.CanonicalID,
+		m.MergedID,
+		model.RestaurantSource(m.Source),
+		m.ExternalID,
+		m.Score,
+		m.MergedBy,
+		parseUUIDs(m.MovedFavoriteIDs),
+		parseUUIDs(m.DroppedFavoriteIDs),
+		m.CreatedAt,
+		m.UnmergedAt,
+	)
+}
+
+// FromDomainRestaurantMerge converts Domain entity to ORM model
+func FromDomainRestaurantMerge(m *model.RestaurantMerge) *RestaurantMergeORM {
+	return &RestaurantMergeORM{
+		ID:                 m.ID(),
+		CanonicalID:        m.CanonicalID(),
+		MergedID:           m.MergedID(),
+		Source:             string(m.Source()),
+		ExternalID:         m.ExternalID(),
+		Score:              m.Score(),
+		MergedBy:           m.MergedBy(),
+		MovedFavoriteIDs:   formatUUIDs(m.MovedFavoriteIDs()),
+		DroppedFavoriteIDs: formatUUIDs(m.DroppedFavoriteIDs()),
+		CreatedAt:          m.CreatedAt(),
+		UnmergedAt:         m.UnmergedAt(),
+	}
+}
+
+func parseUUIDs(values pq.StringArray) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, v := range values {
+		if id, err := uuid.Parse(v); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func formatUUIDs(ids []uuid.UUID) pq.StringArray {
+	values := make(pq.StringArray, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}
+
+type mergeRepository struct {
+	db *gorm.DB
+}
+
+// NewMergeRepository creates a new postgres restaurant merge repository
+func NewMergeRepository(db *gorm.DB) repository.MergeRepository {
+	return &mergeRepository{db: db}
+}
+
+// pairIDs is one row of the duplicate pair query
+type pairIDs struct {
+	AID uuid.UUID `gorm:"column:a_id"`
+	BID uuid.UUID `gorm:"column:b_id"`
+}
+
+// pairScoreExpr estimates dedup.Compare for the restaurants a and b of a
+// pair, with the same weights and missing signals left out: trigram
+// similarity of the names stands in for the bigram similarity of their
+// normalized spellings, and the phone signal compares phone_key. The bound
+// value is the distance at which the location signal drops to zero.
+var pairScoreExpr = fmt.Sprintf(`(
+	%[1]f * GREATEST(
+		similarity(a.name, b.name),
+		similarity(a.name, COALESCE(b.name_ja, '')),
+		similarity(COALESCE(a.name_ja, ''), b.name),
+		similarity(COALESCE(a.name_ja, ''), COALESCE(b.name_ja, '')))
+	+ COALESCE(%[2]f * (a.phone_key = b.phone_key)::int, 0)
+	+ COALESCE(%[3]f * GREATEST(0, 1 - %[4]f * SQRT(
+		POWER(RADIANS(b.latitude - a.latitude), 2) +
+		POWER(RADIANS(b.longitude - a.longitude) * COS(RADIANS(a.latitude)), 2)) / ?), 0)
+) / (%[1]f
+	+ CASE WHEN a.phone_key IS NOT NULL AND b.phone_key IS NOT NULL THEN %[2]f ELSE 0 END
+	+ CASE WHEN a.latitude IS NOT NULL AND b.latitude IS NOT NULL THEN %[3]f ELSE 0 END)`,
+	dedup.NameWeight, dedup.PhoneWeight, dedup.LocationWeight, model.EarthRadiusKm,
+)
+
+// FindDuplicatePairs returns nearby or same-phone restaurant pairs across
+// sources, the likeliest duplicates first. Nearby pairs are found through a
+// latitude/longitude range on idx_restaurants_lat_lng and same-phone pairs
+// through the stored phone_key (the last nine digits, see migration 000026),
+// so neither side scans the whole catalog per restaurant. The pairs are
+// ranked by pairScoreExpr before the limit applies.
+func (r *mergeRepository) FindDuplicatePairs(ctx context.Context, query repository.DuplicateQuery) ([]*repository.RestaurantPair, error) {
+	latDelta := query.MaxDistanceKm / kmPerDegree
+	near := r.pairCandidates(ctx, query,
+		"b.latitude BETWEEN a.latitude - ? AND a.latitude + ? AND "+
+			"b.longitude BETWEEN a.longitude - ? / COS(RADIANS(a.latitude)) AND a.longitude + ? / COS(RADIANS(a.latitude))",
+		latDelta, latDelta, latDelta, latDelta)
+	samePhone := r.pairCandidates(ctx, query, "b.phone_key = a.phone_key")
+
+	q := r.db.WithContext(ctx).
+		Table("((?) UNION (?)) AS pairs", near, samePhone).
+		Joins("JOIN restaurants AS a ON a.id = pairs.a_id").
+		Joins("JOIN restaurants AS b ON b.id = pairs.b_id").
+		Select("pairs.a_id, pairs.b_id, "+pairScoreExpr+" AS score", query.MaxDistanceKm).
+		Where(`NOT EXISTS (SELECT 1 FROM restaurant_merges m
+			WHERE (m.canonical_id = pairs.a_id AND m.merged_id = pairs.b_id)
+			   OR (m.canonical_id = pairs.b_id AND m.merged_id = pairs.a_id))`)
+
+	var rows []pairIDs
+	if err := q.Order("score DESC, pairs.a_id, pairs.b_id").Limit(query.Limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*repository.RestaurantPair{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, 2*len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.AID, row.BID)
+	}
+	var orms []RestaurantORM
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&orms).Error; err != nil {
+		return nil, err
+	}
+	restaurants := make(map[uuid.UUID]*model.Restaurant, len(orms))
+	for i := range orms {
+		restaurant, err := orms[i].ToDomain()
+		if err != nil {
+			continue
+		}
+		restaurants[restaurant.ID()] = restaurant
+	}
+
+	pairs := make([]*repository.RestaurantPair, 0, len(rows))
+	for _, row := range rows {
+		a, b := restaurants[row.AID], restaurants[row.BID]
+		if a == nil || b == nil {
+			continue
+		}
+		pairs = append(pairs, &repository.RestaurantPair{A: a, B: b})
+	}
+	return pairs, nil
+}
+
+// pairCandidates selects the live restaurant pairs across sources joined on
+// match, optionally involving one restaurant
+func (r *mergeRepository) pairCandidates(ctx context.Context, query repository.DuplicateQuery, match string, args ...interface{}) *gorm.DB {
+	q := r.db.WithContext(ctx).
+		Table("restaurants AS a").
+		Select("a.id AS a_id, b.id AS b_id").
+		Joins("JOIN restaurants AS b ON a.source < b.source AND b.deleted_at IS NULL AND "+match, args...).
+		Where("a.deleted_at IS NULL")
+	if query.RestaurantID != nil {
+		q = q.Where("a.id = ? OR b.id = ?", *query.RestaurantID, *query.RestaurantID)
+	}
+	return q
+}
+
+// Merge merges a restaurant into its canonical restaurant in one transaction
+func (r *mergeRepository) Merge(ctx context.Context, merge *model.RestaurantMerge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var favorites []FavoriteORM
+		if err := tx.Where("restaurant_id = ?", merge.MergedID()).Find(&favorites).Error; err != nil {
+			return err
+		}
+
+		var canonicalUsers []uuid.UUID
+		if err := tx.Model(&FavoriteORM{}).
+			Where("restaurant_id = ?", merge.CanonicalID()).
+			Pluck("user_id", &canonicalUsers).Error; err != nil {
+			return err
+		}
+		hasCanonical := make(map[uuid.UUID]bool, len(canonicalUsers))
+		for _, userID := range canonicalUsers {
+			hasCanonical[userID] = true
+		}
+
+		moved := make([]uuid.UUID, 0, len(favorites))
+		dropped := make([]uuid.UUID, 0)
+		for _, favorite := range favorites {
+			if hasCanonical[favorite.UserID] {
+				dropped = append(dropped, favorite.ID)
+			} else {
+				moved = append(moved, favorite.ID)
+			}
+		}
+
+		if len(dropped) > 0 {
+			if err := tx.Where("id IN ?", dropped).Delete(&FavoriteORM{}).Error; err != nil {
+				return err
+			}
+		}
+		if len(moved) > 0 {
+			if err := tx.Model(&FavoriteORM{}).
+				Where("id IN ?", moved).
+				Update("restaurant_id", merge.CanonicalID()).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Where("id = ?", merge.MergedID()).Delete(&RestaurantORM{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrRestaurantNotFound
+		}
+
+		// Identities merged into the merged restaurant now belong to the canonical one
+		if err := tx.Model(&RestaurantMergeORM{}).
+			Where("canonical_id = ? AND unmerged_at IS NULL", merge.MergedID()).
+			Update("canonical_id", merge.CanonicalID()).Error; err != nil {
+			return err
+		}
+
+		merge.RecordFavorites(moved, dropped)
+		if err := tx.Create(FromDomainRestaurantMerge(merge)).Error; err != nil {
+			return err
+		}
+
+		canonicalID := merge.CanonicalID()
+		events := []*model.DomainEvent{model.NewRestaurantDeletedEvent(merge.MergedID(), &canonicalID)}
+		for _, favorite := range favorites {
+			if hasCanonical[favorite.UserID] {
+				events = append(events, model.NewFavoriteRemovedEvent(favorite.ToDomain()))
+			}
+		}
+		return appendEvents(tx, events...)
+	})
+}
+
+// Unmerge reverts a merge in one transaction
+func (r *mergeRepository) Unmerge(ctx context.Context, merge *model.RestaurantMerge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&RestaurantORM{}).
+			Where("id = ? AND deleted_at IS NOT NULL", merge.MergedID()).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrRestaurantNotFound
+		}
+
+		// Favorites the user removed since the merge stay removed
+		if moved := merge.MovedFavoriteIDs(); len(moved) > 0 {
+			if err := tx.Model(&FavoriteORM{}).
+				Where("id IN ?", moved).
+				Update("restaurant_id", merge.MergedID()).Error; err != nil {
+				return err
+			}
+		}
+		if dropped := merge.DroppedFavoriteIDs(); len(dropped) > 0 {
+			if err := tx.Unscoped().Model(&FavoriteORM{}).
+				Where("id IN ?", dropped).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		merge.Unmerge()
+		if err := tx.Model(&RestaurantMergeORM{}).
+			Where("id = ?", merge.ID()).
+			Update("unmerged_at", merge.UnmergedAt()).Error; err != nil {
+			return err
+		}
+
+		// Subscribers dropped the merged restaurant, so it is announced again
+		var restored RestaurantORM
+		if err := tx.Where("id = ?", merge.MergedID()).First(&restored).Error; err != nil {
+			return err
+		}
+		restaurant, err := restored.ToDomain()
+		if err != nil {
+			return err
+		}
+		events := []*model.DomainEvent{model.NewRestaurantCreatedEvent(restaurant)}
+		if dropped := merge.DroppedFavoriteIDs(); len(dropped) > 0 {
+			var favorites []FavoriteORM
+			if err := tx.Where("id IN ?", dropped).Find(&favorites).Error; err != nil {
+				return err
+			}
+			for _, favorite := range favorites {
+				events = append(events, model.NewFavoriteAddedEvent(favorite.ToDomain()))
+			}
+		}
+		return appendEvents(tx, events...)
+	})
+}
+
+// FindByID finds a merge by ID
+func (r *mergeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantMerge, error) {
+	var orm RestaurantMergeORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrMergeNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// FindByCanonicalID finds the active merges into a restaurant
+func (r *mergeRepository) FindByCanonicalID(ctx context.Context, canonicalID uuid.UUID) ([]*model.RestaurantMerge, error) {
+	var orms []RestaurantMergeORM
+	if err := r.db.WithContext(ctx).
+		Where("canonical_id = ? AND unmerged_at IS NULL", canonicalID).
+		Order("created_at ASC").
+		Find(&orms).Error; err != nil {
+		return nil, err
+	}
+
+	merges := make([]*model.RestaurantMerge, 0, len(orms))
+	for i := range orms {
+		merges = append(merges, orms[i].ToDomain())
+	}
+	return merges, nil
+}
@@ -0,0 +1,42 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/dedup"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeRepository_FindDuplicatePairs_LikeliestFirst(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	restaurantRepo := NewRestaurantRepository(db)
+
+	create := func(name, phone string, source model.RestaurantSource, lat, lng float64) *model.Restaurant {
+		location, err := model.NewLocation(lat, lng)
+		require.NoError(t, err)
+		restaurant := model.NewRestaurantWithDetails(name, "Tokyo", source, uuid.NewString(), "", location, 0, "", "", phone, "", nil, nil)
+		require.NoError(t, restaurantRepo.Create(ctx, restaurant))
+		return restaurant
+	}
+	google := create("Ichiran Shibuya", "+81 3-3463-3667", model.SourceGoogle, 35.6595, 139.7005)
+	tabelog := create("ICHIRAN Shibuya", "03-3463-3667", model.SourceTabelog, 35.6596, 139.7005)
+	// Nearby, but a different shop: it pairs with google without being a duplicate
+	create("Tempura Kondo", "03-1111-2222", model.SourceTabelog, 35.6597, 139.7006)
+
+	pairs, err := NewMergeRepository(db).FindDuplicatePairs(ctx, repository.DuplicateQuery{
+		MaxDistanceKm: dedup.MaxDistanceKm,
+		Limit:         1,
+	})
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	assert.ElementsMatch(t, []uuid.UUID{google.ID(), tabelog.ID()}, []uuid.UUID{pairs[0].A.ID(), pairs[0].B.ID()})
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// outboxLockKey is the advisory lock held while a batch is processed, so only
+// one relay publishes at a time and events keep their order
+const outboxLockKey = 0x7265737462 // "restb"
+
+// OutboxEventORM is the database model for a DomainEvent waiting in the outbox
+type OutboxEventORM struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Seq            int64     `gorm:"->;autoIncrement"` // write order, assigned by the database
+	EventType      string    `gorm:"type:varchar(100);not null"`
+	EventVersion   int       `gorm:"not null"`
+	AggregateType  string    `gorm:"type:varchar(50);not null"`
+	AggregateID    uuid.UUID `gorm:"type:uuid;not null"`
+	Payload        string    `gorm:"type:jsonb;not null"`
+	OccurredAt     time.Time `gorm:"not null"`
+	PublishedAt    *time.Time
+	Attempts       int        `gorm:"not null;default:0"` // failures of the event published on its own
+	LastError      *string    `gorm:"type:text"`
+	DeadLetteredAt *time.Time // set once the event is given up on
+}
+
+// TableName overrides the table name
+func (OutboxEventORM) TableName() string {
+	return "restaurant_outbox"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (o *OutboxEventORM) ToDomain() *model.DomainEvent {
+	return model.ReconstructDomainEvent(
+		o.ID,
+		model.EventType(o.EventType),
+		o.EventVersion,
+		o.AggregateType,
+		o.AggregateID,
+		json.RawMessage(o.Payload),
+		o.OccurredAt,
+	)
+}
+
+// FromDomainEvent converts Domain entity to ORM model
+func FromDomainEvent(e *model.DomainEvent) *OutboxEventORM {
+	return &OutboxEventORM{
+		ID:            e.ID(),
+		EventType:     string(e.Type()),
+		EventVersion:  e.Version(),
+		AggregateType: e.AggregateType(),
+		AggregateID:   e.AggregateID(),
+		Payload:       string(e.Payload()),
+		OccurredAt:    e.OccurredAt(),
+	}
+}
+
+// appendEvents writes events to the outbox with tx, which should be the
+// transaction of the change they describe
+func appendEvents(tx *gorm.DB, events ...*model.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	orms := make([]*OutboxEventORM, len(events))
+	for i, event := range events {
+		orms[i] = FromDomainEvent(event)
+	}
+	return tx.Create(orms).Error
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository creates a new postgres outbox repository
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// ProcessPending hands the oldest unpublished events to handler while holding
+// the outbox lock and settles them in the same transaction
+func (r *outboxRepository) ProcessPending(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error) {
+	published := 0
+	var handlerErr error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", outboxLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		var orms []OutboxEventORM
+		if err := pendingQuery(tx).Order("seq").Limit(limit).Find(&orms).Error; err != nil {
+			return err
+		}
+		if len(orms) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(orms))
+		events := make([]*model.DomainEvent, len(orms))
+		for i := range orms {
+			ids[i] = orms[i].ID
+			events[i] = orms[i].ToDomain()
+		}
+
+		batch := tx.Model(&OutboxEventORM{}).Where("id IN ?", ids)
+		if handlerErr = handler(ctx, events); handlerErr != nil {
+			failure := map[string]interface{}{"last_error": handlerErr.Error()}
+			if len(orms) == 1 {
+				failure["attempts"] = gorm.Expr("attempts + 1")
+			}
+			return batch.Updates(failure).Error
+		}
+
+		published = len(orms)
+		return batch.Updates(map[string]interface{}{
+			"published_at": time.Now(),
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   nil,
+		}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, handlerErr
+}
+
+// DeadLetterPending marks the pending events that failed maxAttempts times as
+// dead-lettered
+func (r *outboxRepository) DeadLetterPending(ctx context.Context, maxAttempts int) ([]*model.DomainEvent, error) {
+	var orms []OutboxEventORM
+	if err := pendingQuery(r.db.WithContext(ctx).Model(&orms)).
+		Clauses(clause.Returning{}).
+		Where("attempts >= ?", maxAttempts).
+		Update("dead_lettered_at", time.Now()).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]*model.DomainEvent, len(orms))
+	for i := range orms {
+		events[i] = orms[i].ToDomain()
+	}
+	return events, nil
+}
+
+// CountPending counts the events not yet published
+func (r *outboxRepository) CountPending(ctx context.Context) (int64, error) {
+	var count int64
+	if err := pendingQuery(r.db.WithContext(ctx).Model(&OutboxEventORM{})).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// pendingQuery restricts tx to the events waiting to be published
+func pendingQuery(tx *gorm.DB) *gorm.DB {
+	return tx.Where("published_at IS NULL AND dead_lettered_at IS NULL")
+}
+
+// DeletePublishedBefore deletes events published before the given time
+func (r *outboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("published_at < ?", before).Delete(&OutboxEventORM{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,57 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_DeadLetterPending_SkipsFailingEvent(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	first := model.NewFavoriteAddedEvent(model.NewFavorite(uuid.New(), uuid.New()))
+	second := model.NewFavoriteAddedEvent(model.NewFavorite(uuid.New(), uuid.New()))
+	require.NoError(t, appendEvents(db, first, second))
+
+	repo := NewOutboxRepository(db)
+	rejected := errors.New("message too large")
+	rejectFirst := func(ctx context.Context, events []*model.DomainEvent) error {
+		for _, event := range events {
+			if event.ID() == first.ID() {
+				return rejected
+			}
+		}
+		return nil
+	}
+
+	// A failed batch does not count against its events
+	_, err := repo.ProcessPending(ctx, 2, rejectFirst)
+	require.ErrorIs(t, err, rejected)
+	dead, err := repo.DeadLetterPending(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, dead)
+
+	// A failure of the event on its own does
+	_, err = repo.ProcessPending(ctx, 1, rejectFirst)
+	require.ErrorIs(t, err, rejected)
+	dead, err = repo.DeadLetterPending(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, first.ID(), dead[0].ID())
+
+	pending, err := repo.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pending)
+
+	published, err := repo.ProcessPending(ctx, 2, rejectFirst)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PhotoORM is the database model for Photo
+type PhotoORM struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RestaurantID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_restaurant_photos_source_ref"`
+	Source       string    `gorm:"type:varchar(50);not null"`
+	SourceRef    string    `gorm:"type:text;not null;uniqueIndex:idx_restaurant_photos_source_ref"`
+	ContentType  string    `gorm:"type:varchar(100);not null"`
+	Width        int       `gorm:"not null"`
+	Height       int       `gorm:"not null"`
+	BlobKey      string    `gorm:"type:varchar(255);not null"`
+	ThumbnailKey string    `gorm:"type:varchar(255);not null"`
+	CreatedAt    time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (PhotoORM) TableName() string {
+	return "restaurant_photos"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (p *PhotoORM) ToDomain() *model.Photo {
+	return model.ReconstructPhoto(
+		p.ID,
+		p.RestaurantID,
+		model.RestaurantSource(p.Source),
+		p.SourceRef,
+		p.ContentType,
+		p.Width,
+		p.Height,
+		p.BlobKey,
+		p.ThumbnailKey,
+		p.CreatedAt,
+	)
+}
+
+// FromDomainPhoto converts Domain entity to ORM model
+func FromDomainPhoto(p *model.Photo) *PhotoORM {
+	return &PhotoORM{
+		ID:           p.ID(),
+		RestaurantID: p.RestaurantID(),
+		Source:       string(p.Source()),
+		SourceRef:    p.SourceRef(),
+		ContentType:  p.ContentType(),
+		Width:        p.Width(),
+		Height:       p.Height(),
+		BlobKey:      p.BlobKey(),
+		ThumbnailKey: p.ThumbnailKey(),
+		CreatedAt:    p.CreatedAt(),
+	}
+}
+
+type photoRepository struct {
+	db *gorm.DB
+}
+
+// NewPhotoRepository creates a new postgres photo repository
+func NewPhotoRepository(db *gorm.DB) repository.PhotoRepository {
+	return &photoRepository{db: db}
+}
+
+// Create stores a new photo. A photo already ingested from the same source
+// reference for the restaurant is left as is and reported as a conflict.
+func (r *photoRepository) Create(ctx context.Context, photo *model.Photo) error {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(FromDomainPhoto(photo))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrPhotoAlreadyExists
+	}
+	return nil
+}
+
+// FindByID finds a photo by ID
+func (r *photoRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Photo, error) {
+	var orm PhotoORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrPhotoNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// ListByRestaurant lists the photos of a restaurant, oldest first
+func (r *photoRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*model.Photo, error) {
+	var orms []PhotoORM
+	err := r.db.WithContext(ctx).
+		Where("restaurant_id = ?", restaurantID).
+		Order("created_at ASC, id ASC").
+		Find(&orms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	photos := make([]*model.Photo, len(orms))
+	for i := range orms {
+		photos[i] = orms[i].ToDomain()
+	}
+	return photos, nil
+}
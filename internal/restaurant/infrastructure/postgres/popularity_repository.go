@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PopularityORM is the database model for a restaurant's counts in one bucket
+type PopularityORM struct {
+	RestaurantID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	BucketStart  time.Time `gorm:"primaryKey"`
+	Views        int64     `gorm:"not null;default:0"`
+	Favorites    int64     `gorm:"not null;default:0"`
+	Visits       int64     `gorm:"not null;default:0"`
+}
+
+// TableName overrides the table name
+func (PopularityORM) TableName() string {
+	return "restaurant_popularity"
+}
+
+// trendingRow is a restaurant row with its trending score and event totals
+type trendingRow struct {
+	RestaurantORM
+	TrendingScore     float64 `gorm:"column:trending_score"`
+	TrendingViews     int64   `gorm:"column:trending_views"`
+	TrendingFavorites int64   `gorm:"column:trending_favorites"`
+	TrendingVisits    int64   `gorm:"column:trending_visits"`
+}
+
+// popularityLockKey serializes rollups across service instances, so two of
+// them cannot both add the same views to view_count
+const popularityLockKey = "restaurant_popularity_rollup"
+
+type popularityRepository struct {
+	db *gorm.DB
+}
+
+// NewPopularityRepository creates a new postgres popularity repository
+func NewPopularityRepository(db *gorm.DB) repository.PopularityRepository {
+	return &popularityRepository{db: db}
+}
+
+// SaveCounts upserts the bucket counts. Redis holds the running total of a
+// bucket, so rows are replaced rather than incremented, and view_count only
+// receives the views added since the bucket was last saved.
+func (r *popularityRepository) SaveCounts(ctx context.Context, counts []repository.PopularityCount) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", popularityLockKey).Error; err != nil {
+			return err
+		}
+
+		// Counts of restaurants that no longer exist are dropped
+		ids := make([]uuid.UUID, len(counts))
+		for i, c := range counts {
+			ids[i] = c.RestaurantID
+		}
+		var existingIDs []uuid.UUID
+		if err := tx.Unscoped().Model(&RestaurantORM{}).Where("id IN ?", ids).Pluck("id", &existingIDs).Error; err != nil {
+			return err
+		}
+		existing := make(map[uuid.UUID]bool, len(existingIDs))
+		for _, id := range existingIDs {
+			existing[id] = true
+		}
+
+		rows := make([]PopularityORM, 0, len(counts))
+		keys := make([][]interface{}, 0, len(counts))
+		for _, c := range counts {
+			if !existing[c.RestaurantID] {
+				continue
+			}
+			rows = append(rows, PopularityORM{
+				RestaurantID: c.RestaurantID,
+				BucketStart:  c.BucketStart.UTC(),
+				Views:        c.Views,
+				Favorites:    c.Favorites,
+				Visits:       c.Visits,
+			})
+			keys = append(keys, []interface{}{c.RestaurantID, c.BucketStart.UTC()})
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		var previous []PopularityORM
+		if err := tx.Where("(restaurant_id, bucket_start) IN ?", keys).Find(&previous).Error; err != nil {
+			return err
+		}
+		previousViews := make(map[uuid.UUID]int64, len(previous))
+		for _, p := range previous {
+			previousViews[p.RestaurantID] += p.Views
+		}
+
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "restaurant_id"}, {Name: "bucket_start"}},
+			DoUpdates: clause.AssignmentColumns([]string{"views", "favorites", "visits"}),
+		}).Create(&rows).Error; err != nil {
+			return err
+		}
+
+		return addViewCounts(tx, rows, previousViews)
+	})
+}
+
+// addViewCounts adds the views saved since the previous rollup to the
+// restaurants' lifetime view_count in one statement. A view is not a change
+// to the restaurant: the updated_at trigger ignores view_count (migration
+// 000027).
+func addViewCounts(tx *gorm.DB, rows []PopularityORM, previousViews map[uuid.UUID]int64) error {
+	deltas := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		deltas[row.RestaurantID] += row.Views
+	}
+
+	values := make([]string, 0, len(deltas))
+	args := make([]interface{}, 0, 2*len(deltas))
+	for id, views := range deltas {
+		delta := views - previousViews[id]
+		if delta == 0 {
+			continue
+		}
+		values = append(values, "(?::uuid, ?::bigint)")
+		args = append(args, id, delta)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	return tx.Exec(
+		"UPDATE restaurants SET view_count = view_count + d.delta FROM (VALUES "+strings.Join(values, ", ")+
+			") AS d(id, delta) WHERE restaurants.id = d.id",
+		args...,
+	).Error
+}
+
+// trendingScoreExpr sums the weighted events of each bucket, halving their
+// weight every half-life of bucket age. The bound values are the view,
+// favorite and visit weights, the current Unix time and the half-life in seconds.
+const trendingScoreExpr = `SUM((views * ? + favorites * ? + visits * ?) *
+	POWER(0.5, GREATEST(? - EXTRACT(EPOCH FROM bucket_start), 0) / ?))`
+
+// FindTrending ranks restaurants by their decayed score over the buckets since
+// query.Since
+func (r *popularityRepository) FindTrending(ctx context.Context, query repository.TrendingQuery) ([]*repository.TrendingRestaurant, error) {
+	scores := r.db.Model(&PopularityORM{}).
+		Select("restaurant_id, "+trendingScoreExpr+" AS score, "+
+			"SUM(views) AS views, SUM(favorites) AS favorites, SUM(visits) AS visits",
+			model.PopularityEventView.Weight(),
+			model.PopularityEventFavorite.Weight(),
+			model.PopularityEventVisit.Weight(),
+			float64(query.Now.Unix()),
+			query.HalfLife.Seconds(),
+		).
+		Where("bucket_start >= ?", query.Since.UTC()).
+		Group("restaurant_id")
+
+	q := r.db.WithContext(ctx).Model(&RestaurantORM{}).
+		Select("restaurants.*, t.score AS trending_score, t.views AS trending_views, "+
+			"t.favorites AS trending_favorites, t.visits AS trending_visits").
+		Joins("JOIN (?) AS t ON t.restaurant_id = restaurants.id", scores)
+	if query.Area != "" {
+		q = q.Where("restaurants.area = ?", query.Area)
+	}
+	if query.CuisineType != "" {
+		q = q.Where(cuisineTypeCondition(query.CuisineType))
+	}
+
+	var rows []trendingRow
+	if err := q.Order("t.score DESC").Order("restaurants.id").Limit(query.Limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	// Invalid records are skipped, as in the other listings
+	results := make([]*repository.TrendingRestaurant, 0, len(rows))
+	for i := range rows {
+		restaurant, err := rows[i].RestaurantORM.ToDomain()
+		if err != nil {
+			continue
+		}
+		results = append(results, &repository.TrendingRestaurant{
+			Restaurant: restaurant,
+			Score:      rows[i].TrendingScore,
+			Views:      rows[i].TrendingViews,
+			Favorites:  rows[i].TrendingFavorites,
+			Visits:     rows[i].TrendingVisits,
+		})
+	}
+	return results, nil
+}
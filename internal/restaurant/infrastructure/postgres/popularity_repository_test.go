@@ -0,0 +1,34 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopularityRepository_SaveCounts_KeepsUpdatedAt(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	restaurant := createTestRestaurant(t, db, "Ramen Ichiran")
+	before := updatedAt(t, db, restaurant.ID())
+
+	repo := NewPopularityRepository(db)
+	err := repo.SaveCounts(ctx, []repository.PopularityCount{{
+		RestaurantID: restaurant.ID(),
+		BucketStart:  time.Now().Truncate(time.Hour),
+		Views:        5,
+	}})
+	require.NoError(t, err)
+
+	saved, err := NewRestaurantRepository(db).FindByID(ctx, restaurant.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), saved.ViewCount())
+	assert.True(t, before.Equal(updatedAt(t, db, restaurant.ID())), "a rollup must not change updated_at")
+}
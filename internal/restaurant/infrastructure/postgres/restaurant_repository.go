@@ -4,34 +4,67 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sync"
 	"time"
 
 	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RestaurantORM is the database model for Restaurant
 type RestaurantORM struct {
-	ID           uuid.UUID      `gorm:"type:uuid;primaryKey"`
-	Name         string         `gorm:"type:varchar(255);not null"`
-	NameJa       string         `gorm:"type:varchar(255)"`
-	Area         string         `gorm:"type:varchar(100)"`
-	Source       string         `gorm:"type:varchar(50);not null"`
-	ExternalID   string         `gorm:"type:varchar(255);not null"`
-	Address      string         `gorm:"type:text"`
-	Latitude     float64        `gorm:"type:decimal(10,8)"`
-	Longitude    float64        `gorm:"type:decimal(11,8)"`
-	Rating       float64        `gorm:"type:decimal(3,2)"`
-	PriceRange   string         `gorm:"type:varchar(10)"`
-	CuisineType  string         `gorm:"type:varchar(50)"`
-	Phone        string         `gorm:"type:varchar(20)"`
-	Website      string         `gorm:"type:varchar(500)"`
-	OpeningHours string         `gorm:"type:jsonb"` // JSON string
-	Metadata     string         `gorm:"type:jsonb"` // JSON string
-	ViewCount    int64          `gorm:"type:bigint;default:0"`
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name         string    `gorm:"type:varchar(255);not null"`
+	NameJa       string    `gorm:"type:varchar(255)"`
+	Area         string    `gorm:"type:varchar(100)"`
+	Source       string    `gorm:"type:varchar(50);not null"`
+	ExternalID   string    `gorm:"type:varchar(255);not null"`
+	Address      string    `gorm:"type:text"`
+	Latitude     float64   `gorm:"type:decimal(10,8)"`
+	Longitude    float64   `gorm:"type:decimal(11,8)"`
+	Rating       float64   `gorm:"type:decimal(3,2)"`
+	PriceRange   string    `gorm:"type:varchar(10)"`
+	CuisineType  string    `gorm:"type:varchar(50)"`
+	Phone        string    `gorm:"type:varchar(20)"`
+	Website      string    `gorm:"type:varchar(500)"`
+	OpeningHours string    `gorm:"type:jsonb"` // JSON string
+	Metadata     string    `gorm:"type:jsonb"` // JSON string
+	// Read-only here; the popularity rollup adds to it, so writing a loaded
+	// value back would drop the views rolled up since
+	ViewCount  int64  `gorm:"type:bigint;default:0;->"`
+	SearchText string `gorm:"type:text"` // Normalized search tokens, see textnorm.IndexText
+	// Cuisine category IDs, see model.Cuisines
+	Cuisines pq.StringArray `gorm:"type:varchar(50)[];not null;default:'{}'"`
+	// Normalized price, see model.Price. A band is NULL when unknown, and
+	// its max is NULL when it has no upper bound.
+	PriceLevel     int     `gorm:"type:smallint;not null;default:0"`
+	PriceLunchMin  *int    `gorm:"type:int"`
+	PriceLunchMax  *int    `gorm:"type:int"`
+	PriceDinnerMin *int    `gorm:"type:int"`
+	PriceDinnerMax *int    `gorm:"type:int"`
+	PriceText      *string `gorm:"type:varchar(100)"`
+	// Structured weekly schedule, see openingScheduleJSON; NULL when unknown
+	OpeningSchedule *string `gorm:"type:jsonb"`
+	// Tabelog listing matched to the restaurant; all NULL when none is attached
+	TabelogURL         *string        `gorm:"column:tabelog_url;type:varchar(500)"`
+	TabelogRating      *float64       `gorm:"column:tabelog_rating;type:decimal(3,2)"`
+	TabelogReviewCount *int           `gorm:"column:tabelog_review_count;type:int"`
+	TabelogBookmarks   *int           `gorm:"column:tabelog_bookmarks;type:int"`
+	TabelogGenres      pq.StringArray `gorm:"column:tabelog_genres;type:varchar(100)[]"`
+	TabelogMatchScore  *float64       `gorm:"column:tabelog_match_score;type:decimal(4,3)"`
+	TabelogConfirmed   *bool          `gorm:"column:tabelog_confirmed"`
+	TabelogSyncedAt    *time.Time     `gorm:"column:tabelog_synced_at;type:timestamp"`
+	// Display text per language, see localizationJSON
+	Localizations string `gorm:"type:jsonb"`
+	// Rating from our users' reviews; read-only here, the review repository
+	// recomputes it whenever a review changes
+	ReviewRating float64        `gorm:"column:review_rating;type:decimal(3,2);->"`
+	ReviewCount  int            `gorm:"column:review_count;type:int;->"`
 	CreatedAt    time.Time      `gorm:"not null"`
 	UpdatedAt    time.Time      `gorm:"not null"`
 	DeletedAt    gorm.DeletedAt `gorm:"index"`
@@ -86,19 +119,247 @@ func (r *RestaurantORM) ToDomain() (*model.Restaurant, error) {
 		r.Address,
 		location,
 		r.Rating,
-		r.PriceRange,
+		r.price(),
 		r.CuisineType,
+		[]string(r.Cuisines),
 		r.Phone,
 		r.Website,
 		openingHours,
+		r.openingSchedule(),
 		metadata,
 		r.ViewCount,
+		r.tabelogProfile(),
+		model.ReviewSummary{Average: r.ReviewRating, Count: r.ReviewCount},
+		r.localizations(),
 		r.CreatedAt,
 		r.UpdatedAt,
 		deletedAt,
 	), nil
 }
 
+// openingScheduleJSON is the stored form of model.OpeningSchedule
+type openingScheduleJSON struct {
+	Periods  []model.OpeningPeriod `json:"periods"`
+	Holidays []string              `json:"holidays"`
+}
+
+// openingSchedule returns the structured schedule, nil when none is stored or it is invalid
+func (r *RestaurantORM) openingSchedule() *model.OpeningSchedule {
+	if r.OpeningSchedule == nil {
+		return nil
+	}
+
+	var stored openingScheduleJSON
+	if err := json.Unmarshal([]byte(*r.OpeningSchedule), &stored); err != nil {
+		return nil
+	}
+	schedule, err := model.NewOpeningSchedule(stored.Periods, stored.Holidays)
+	if err != nil {
+		return nil
+	}
+	return schedule
+}
+
+// setOpeningSchedule stores the structured schedule
+func (r *RestaurantORM) setOpeningSchedule(schedule *model.OpeningSchedule) error {
+	if schedule == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(openingScheduleJSON{
+		Periods:  schedule.Periods(),
+		Holidays: schedule.Holidays(),
+	})
+	if err != nil {
+		return err
+	}
+	stored := string(data)
+	r.OpeningSchedule = &stored
+	return nil
+}
+
+// localizationJSON is the stored form of model.LocalizedContent
+type localizationJSON struct {
+	Name         string `json:"name,omitempty"`
+	Address      string `json:"address,omitempty"`
+	CuisineLabel string `json:"cuisine_label,omitempty"`
+	Summary      string `json:"summary,omitempty"`
+}
+
+// localizations returns the stored localized content, empty when none is
+// stored or it is invalid
+func (r *RestaurantORM) localizations() map[string]model.LocalizedContent {
+	localizations := make(map[string]model.LocalizedContent)
+	if r.Localizations == "" {
+		return localizations
+	}
+
+	var stored map[string]localizationJSON
+	if err := json.Unmarshal([]byte(r.Localizations), &stored); err != nil {
+		return localizations
+	}
+	for lang, l := range stored {
+		localizations[lang] = model.LocalizedContent{
+			Name:         l.Name,
+			Address:      l.Address,
+			CuisineLabel: l.CuisineLabel,
+			Summary:      l.Summary,
+		}
+	}
+	return localizations
+}
+
+// setLocalizations stores the localized content
+func (r *RestaurantORM) setLocalizations(localizations map[string]model.LocalizedContent) error {
+	stored := make(map[string]localizationJSON, len(localizations))
+	for lang, l := range localizations {
+		stored[lang] = localizationJSON{
+			Name:         l.Name,
+			Address:      l.Address,
+			CuisineLabel: l.CuisineLabel,
+			Summary:      l.Summary,
+		}
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	r.Localizations = string(data)
+	return nil
+}
+
+// tabelogProfile returns the attached Tabelog profile, nil when none is attached
+func (r *RestaurantORM) tabelogProfile() *model.TabelogProfile {
+	if r.TabelogURL == nil {
+		return nil
+	}
+
+	genres := make([]string, len(r.TabelogGenres))
+	copy(genres, r.TabelogGenres)
+
+	var syncedAt time.Time
+	if r.TabelogSyncedAt != nil {
+		syncedAt = *r.TabelogSyncedAt
+	}
+
+	return model.ReconstructTabelogProfile(
+		*r.TabelogURL,
+		derefFloat(r.TabelogRating),
+		derefInt(r.TabelogReviewCount),
+		derefInt(r.TabelogBookmarks),
+		genres,
+		derefFloat(r.TabelogMatchScore),
+		r.TabelogConfirmed != nil && *r.TabelogConfirmed,
+		syncedAt,
+	)
+}
+
+// setTabelogProfile copies a Tabelog profile into the ORM columns
+func (r *RestaurantORM) setTabelogProfile(p *model.TabelogProfile) {
+	if p == nil {
+		return
+	}
+
+	url, rating, reviewCount, bookmarks := p.URL(), p.Rating(), p.ReviewCount(), p.Bookmarks()
+	matchScore, confirmed, syncedAt := p.MatchScore(), p.Confirmed(), p.SyncedAt()
+	r.TabelogURL = &url
+	r.TabelogRating = &rating
+	r.TabelogReviewCount = &reviewCount
+	r.TabelogBookmarks = &bookmarks
+	r.TabelogGenres = pq.StringArray(p.Genres())
+	r.TabelogMatchScore = &matchScore
+	r.TabelogConfirmed = &confirmed
+	r.TabelogSyncedAt = &syncedAt
+}
+
+// price returns the normalized price, nil when the restaurant has none
+func (r *RestaurantORM) price() *model.Price {
+	lunch := priceBand(r.PriceLunchMin, r.PriceLunchMax)
+	dinner := priceBand(r.PriceDinnerMin, r.PriceDinnerMax)
+	if r.PriceLevel == 0 && lunch == nil && dinner == nil && r.PriceText == nil {
+		return nil
+	}
+	return model.ReconstructPrice(r.PriceLevel, lunch, dinner, derefString(r.PriceText))
+}
+
+// setPrice copies a price into the ORM columns
+func (r *RestaurantORM) setPrice(p *model.Price) {
+	if p == nil {
+		return
+	}
+
+	r.PriceLevel = p.Level()
+	r.PriceLunchMin, r.PriceLunchMax = priceBandColumns(p.Lunch())
+	r.PriceDinnerMin, r.PriceDinnerMax = priceBandColumns(p.Dinner())
+	if text := p.Text(); text != "" {
+		r.PriceText = &text
+	}
+}
+
+// detailColumns returns the columns of the restaurant details an edit or a
+// revert can change, for updates that must clear them
+func (r *RestaurantORM) detailColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         r.Name,
+		"name_ja":      r.NameJa,
+		"area":         r.Area,
+		"address":      r.Address,
+		"latitude":     r.Latitude,
+		"longitude":    r.Longitude,
+		"rating":       r.Rating,
+		"cuisine_type": r.CuisineType,
+		"phone":        r.Phone,
+		"website":      r.Website,
+		"search_text":  r.SearchText,
+	}
+}
+
+// priceColumns returns every price column, for updates that must clear them
+func (r *RestaurantORM) priceColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"price_range":      r.PriceRange,
+		"price_level":      r.PriceLevel,
+		"price_lunch_min":  r.PriceLunchMin,
+		"price_lunch_max":  r.PriceLunchMax,
+		"price_dinner_min": r.PriceDinnerMin,
+		"price_dinner_max": r.PriceDinnerMax,
+		"price_text":       r.PriceText,
+	}
+}
+
+func priceBand(min, max *int) *model.PriceBand {
+	if min == nil {
+		return nil
+	}
+	return &model.PriceBand{Min: *min, Max: derefInt(max)}
+}
+
+func priceBandColumns(b *model.PriceBand) (min, max *int) {
+	if b == nil {
+		return nil, nil
+	}
+	lower, upper := b.Min, b.Max
+	if upper > 0 {
+		max = &upper
+	}
+	return &lower, max
+}
+
+func derefString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
 // FromDomain converts Domain entity to ORM model
 func FromDomain(r *model.Restaurant) (*RestaurantORM, error) {
 	// Marshal OpeningHours
@@ -130,27 +391,59 @@ func FromDomain(r *model.Restaurant) (*RestaurantORM, error) {
 		Rating:       r.Rating(),
 		PriceRange:   r.PriceRange(),
 		CuisineType:  r.CuisineType(),
+		Cuisines:     pq.StringArray(r.Cuisines()),
 		Phone:        r.Phone(),
 		Website:      r.Website(),
 		OpeningHours: string(openingHoursJSON),
 		Metadata:     string(metadataJSON),
 		ViewCount:    r.ViewCount(),
+		SearchText:   searchIndexText(r),
 		CreatedAt:    r.CreatedAt(),
 		UpdatedAt:    r.UpdatedAt(),
 		DeletedAt:    deletedAt,
 	}
 
+	if orm.Cuisines == nil {
+		orm.Cuisines = pq.StringArray{}
+	}
+
 	// Handle Location
 	if r.Location() != nil {
 		orm.Latitude = r.Location().Latitude()
 		orm.Longitude = r.Location().Longitude()
 	}
 
+	if err := orm.setOpeningSchedule(r.OpeningSchedule()); err != nil {
+		return nil, err
+	}
+	orm.setTabelogProfile(r.Tabelog())
+	orm.setPrice(r.Price())
+	if err := orm.setLocalizations(r.Localizations()); err != nil {
+		return nil, err
+	}
+
 	return orm, nil
 }
 
+// clearTabelogColumns resets every Tabelog column
+var clearTabelogColumns = map[string]interface{}{
+	"tabelog_url":          nil,
+	"tabelog_rating":       nil,
+	"tabelog_review_count": nil,
+	"tabelog_bookmarks":    nil,
+	"tabelog_genres":       nil,
+	"tabelog_match_score":  nil,
+	"tabelog_confirmed":    nil,
+	"tabelog_synced_at":    nil,
+}
+
 type restaurantRepository struct {
 	db *gorm.DB
+
+	// spatial is nil until the spatial backend has been detected, see
+	// spatialBackendFor
+	spatialMu sync.Mutex
+	spatial   *spatialBackend
 }
 
 // NewRestaurantRepository creates a new postgres restaurant repository
@@ -165,11 +458,12 @@ func (r *restaurantRepository) Create(ctx context.Context, restaurant *model.Res
 		return err
 	}
 
-	if err := r.db.WithContext(ctx).Create(orm).Error; err != nil {
-		return err
-	}
-
-	return nil
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(orm).Error; err != nil {
+			return err
+		}
+		return appendEvents(tx, model.NewRestaurantCreatedEvent(restaurant))
+	})
 }
 
 // FindByID finds a restaurant by ID
@@ -185,12 +479,22 @@ func (r *restaurantRepository) FindByID(ctx context.Context, id uuid.UUID) (*mod
 	return orm.ToDomain()
 }
 
-// FindByExternalID finds a restaurant by source and external ID
+// FindByExternalID finds a restaurant by source and external ID.
+// The identity of a restaurant merged into another resolves to the canonical restaurant.
 func (r *restaurantRepository) FindByExternalID(ctx context.Context, source model.RestaurantSource, externalID string) (*model.Restaurant, error) {
 	var orm RestaurantORM
-	if err := r.db.WithContext(ctx).
+	err := r.db.WithContext(ctx).
 		Where("source = ? AND external_id = ?", string(source), externalID).
-		First(&orm).Error; err != nil {
+		First(&orm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = r.db.WithContext(ctx).
+			Where("id = (?)", r.db.Model(&RestaurantMergeORM{}).
+				Select("canonical_id").
+				Where("source = ? AND external_id = ? AND unmerged_at IS NULL", string(source), externalID).
+				Limit(1)).
+			First(&orm).Error
+	}
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domainerrors.ErrRestaurantNotFound
 		}
@@ -200,14 +504,115 @@ func (r *restaurantRepository) FindByExternalID(ctx context.Context, source mode
 	return orm.ToDomain()
 }
 
+// FindByIDs finds the restaurants with the given IDs
+func (r *restaurantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Restaurant, error) {
+	if len(ids) == 0 {
+		return []*model.Restaurant{}, nil
+	}
+
+	var orms []RestaurantORM
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&orms).Error; err != nil {
+		return nil, err
+	}
+
+	restaurants := make([]*model.Restaurant, 0, len(orms))
+	for i := range orms {
+		restaurant, err := orms[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		restaurants = append(restaurants, restaurant)
+	}
+	return restaurants, nil
+}
+
+// FindByExternalIDs finds restaurants by source and external ID in two
+// queries: one for the restaurants' own identities and one for the
+// identities of merged restaurants
+func (r *restaurantRepository) FindByExternalIDs(ctx context.Context, refs []repository.ExternalRef) (map[repository.ExternalRef]*model.Restaurant, error) {
+	found := make(map[repository.ExternalRef]*model.Restaurant, len(refs))
+	if len(refs) == 0 {
+		return found, nil
+	}
+
+	var orms []RestaurantORM
+	if err := r.db.WithContext(ctx).Where("(source, external_id) IN ?", externalRefTuples(refs)).Find(&orms).Error; err != nil {
+		return nil, err
+	}
+	for i := range orms {
+		restaurant, err := orms[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		found[repository.ExternalRef{Source: restaurant.Source(), ExternalID: restaurant.ExternalID()}] = restaurant
+	}
+
+	var missing []repository.ExternalRef
+	for _, ref := range refs {
+		if found[ref] == nil {
+			missing = append(missing, ref)
+		}
+	}
+	if len(missing) == 0 {
+		return found, nil
+	}
+
+	var merges []RestaurantMergeORM
+	err := r.db.WithContext(ctx).
+		Where("(source, external_id) IN ? AND unmerged_at IS NULL", externalRefTuples(missing)).
+		Find(&merges).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(merges) == 0 {
+		return found, nil
+	}
+
+	canonicalIDs := make([]uuid.UUID, len(merges))
+	for i, m := range merges {
+		canonicalIDs[i] = m.CanonicalID
+	}
+	canonical, err := r.FindByIDs(ctx, canonicalIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*model.Restaurant, len(canonical))
+	for _, restaurant := range canonical {
+		byID[restaurant.ID()] = restaurant
+	}
+	for _, m := range merges {
+		if restaurant := byID[m.CanonicalID]; restaurant != nil {
+			found[repository.ExternalRef{Source: model.RestaurantSource(m.Source), ExternalID: m.ExternalID}] = restaurant
+		}
+	}
+	return found, nil
+}
+
+// externalRefTuples converts identities to (source, external_id) tuples for an IN condition
+func externalRefTuples(refs []repository.ExternalRef) [][]interface{} {
+	tuples := make([][]interface{}, len(refs))
+	for i, ref := range refs {
+		tuples[i] = []interface{}{string(ref.Source), ref.ExternalID}
+	}
+	return tuples
+}
+
 // Update updates an existing restaurant
 func (r *restaurantRepository) Update(ctx context.Context, restaurant *model.Restaurant) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateRestaurant(tx, restaurant)
+	})
+}
+
+// updateRestaurant writes a restaurant and its restaurant.updated event with
+// tx, which must be a transaction
+func updateRestaurant(tx *gorm.DB, restaurant *model.Restaurant) error {
 	orm, err := FromDomain(restaurant)
 	if err != nil {
 		return err
 	}
 
-	result := r.db.WithContext(ctx).Model(&RestaurantORM{}).Where("id = ?", orm.ID).Updates(orm)
+	result := tx.Model(&RestaurantORM{}).Where("id = ?", orm.ID).Updates(orm)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -215,79 +620,498 @@ func (r *restaurantRepository) Update(ctx context.Context, restaurant *model.Res
 		return domainerrors.ErrRestaurantNotFound
 	}
 
-	return nil
+	// Updates skips zero fields, so details set back to empty (as a revert
+	// does), a cleared price, emptied cuisines and a detached Tabelog profile
+	// are written explicitly
+	columns := orm.detailColumns()
+	for column, value := range orm.priceColumns() {
+		columns[column] = value
+	}
+	columns["cuisines"] = orm.Cuisines
+	if err := tx.Model(&RestaurantORM{}).Where("id = ?", orm.ID).
+		UpdateColumns(columns).Error; err != nil {
+		return err
+	}
+	if restaurant.Tabelog() == nil {
+		if err := tx.Model(&RestaurantORM{}).
+			Where("id = ? AND tabelog_url IS NOT NULL", orm.ID).
+			Updates(clearTabelogColumns).Error; err != nil {
+			return err
+		}
+	}
+
+	return appendEvents(tx, model.NewRestaurantUpdatedEvent(restaurant))
+}
+
+// Reindex rewrites the derived columns of restaurants
+func (r *restaurantRepository) Reindex(ctx context.Context, restaurants []*model.Restaurant) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, restaurant := range restaurants {
+			orm, err := FromDomain(restaurant)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&RestaurantORM{}).Where("id = ?", orm.ID).
+				UpdateColumns(map[string]interface{}{
+					"cuisines":    orm.Cuisines,
+					"search_text": orm.SearchText,
+				}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
-// Delete soft-deletes a restaurant by ID
+// Delete soft-deletes a restaurant by ID with its favorites. The favorites
+// get the restaurant's deletion time, which tells them apart from favorites
+// their users removed when the restaurant is restored.
 func (r *restaurantRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&RestaurantORM{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return domainerrors.ErrRestaurantNotFound
-	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&RestaurantORM{}).Where("id = ?", id).Update("deleted_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrRestaurantNotFound
+		}
 
-	return nil
+		var favorites []FavoriteORM
+		if err := tx.Where("restaurant_id = ?", id).Find(&favorites).Error; err != nil {
+			return err
+		}
+		events := []*model.DomainEvent{model.NewRestaurantDeletedEvent(id, nil)}
+		if len(favorites) > 0 {
+			if err := tx.Model(&FavoriteORM{}).
+				Where("restaurant_id = ?", id).
+				Update("deleted_at", now).Error; err != nil {
+				return err
+			}
+			for i := range favorites {
+				events = append(events, model.NewFavoriteRemovedEvent(favorites[i].ToDomain()))
+			}
+		}
+
+		return appendEvents(tx, events...)
+	})
 }
 
-// Search searches restaurants by query string
-func (r *restaurantRepository) Search(ctx context.Context, query string, limit, offset int) ([]*model.Restaurant, error) {
+// FindDeleted lists soft-deleted restaurants that are not merged, most
+// recently deleted first, and counts all matches
+func (r *restaurantRepository) FindDeleted(ctx context.Context, query repository.DeletedQuery) ([]*model.Restaurant, int64, error) {
+	tx := r.deletedQuery(r.db.WithContext(ctx).Model(&RestaurantORM{}))
+	if query.DeletedBefore != nil {
+		tx = tx.Where("deleted_at < ?", *query.DeletedBefore)
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
 	var orms []RestaurantORM
-	if err := r.db.WithContext(ctx).
-		Where("name ILIKE ? OR address ILIKE ? OR cuisine_type ILIKE ?", "%"+query+"%", "%"+query+"%", "%"+query+"%").
-		Limit(limit).
-		Offset(offset).
-		Find(&orms).Error; err != nil {
-		return nil, err
+	if err := tx.Order("deleted_at DESC, id").Limit(query.Limit).Offset(query.Offset).Find(&orms).Error; err != nil {
+		return nil, 0, err
 	}
 
 	restaurants := make([]*model.Restaurant, 0, len(orms))
-	for _, orm := range orms {
-		restaurant, err := orm.ToDomain()
+	for i := range orms {
+		restaurant, err := orms[i].ToDomain()
 		if err != nil {
-			continue // Skip invalid records
+			return nil, 0, err
 		}
 		restaurants = append(restaurants, restaurant)
 	}
+	return restaurants, total, nil
+}
+
+// Restore undoes the soft delete of a restaurant and its cascaded favorites in one transaction
+func (r *restaurantRepository) Restore(ctx context.Context, id uuid.UUID) (*model.Restaurant, error) {
+	var restored *model.Restaurant
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var orm RestaurantORM
+		if err := tx.Unscoped().Where("id = ?", id).First(&orm).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domainerrors.ErrRestaurantNotFound
+			}
+			return err
+		}
+		if !orm.DeletedAt.Valid {
+			return domainerrors.ErrRestaurantNotDeleted
+		}
+
+		var merges int64
+		if err := tx.Model(&RestaurantMergeORM{}).
+			Where("merged_id = ? AND unmerged_at IS NULL", id).
+			Count(&merges).Error; err != nil {
+			return err
+		}
+		if merges > 0 {
+			return domainerrors.ErrRestaurantMerged
+		}
+
+		// The unique index on the external identity only covers live rows, so
+		// the identity may have been recreated since the delete
+		var replacements int64
+		if err := tx.Model(&RestaurantORM{}).
+			Where("source = ? AND external_id = ? AND id <> ?", orm.Source, orm.ExternalID, id).
+			Count(&replacements).Error; err != nil {
+			return err
+		}
+		if replacements > 0 {
+			return domainerrors.ErrRestaurantReplaced
+		}
+
+		// The deletion time is compared in SQL, as a round trip through Go may change its precision
+		var favorites []FavoriteORM
+		if err := tx.Unscoped().
+			Where("restaurant_id = ? AND deleted_at = (?)", id,
+				tx.Unscoped().Model(&RestaurantORM{}).Select("deleted_at").Where("id = ?", id)).
+			Find(&favorites).Error; err != nil {
+			return err
+		}
+		if len(favorites) > 0 {
+			ids := make([]uuid.UUID, len(favorites))
+			for i := range favorites {
+				ids[i] = favorites[i].ID
+			}
+			if err := tx.Unscoped().Model(&FavoriteORM{}).
+				Where("id IN ?", ids).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Unscoped().Model(&RestaurantORM{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		orm.DeletedAt = gorm.DeletedAt{}
+		restaurant, err := orm.ToDomain()
+		if err != nil {
+			return err
+		}
+		restored = restaurant
+
+		// Subscribers dropped the deleted restaurant, so it is announced again
+		events := []*model.DomainEvent{model.NewRestaurantCreatedEvent(restaurant)}
+		for i := range favorites {
+			favorites[i].DeletedAt = gorm.DeletedAt{}
+			events = append(events, model.NewFavoriteAddedEvent(favorites[i].ToDomain()))
+		}
+		return appendEvents(tx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
 
-	return restaurants, nil
+// Purge permanently deletes soft-deleted, unmerged restaurants. Favorites,
+// photos, reviews and the other rows referencing them are removed by the
+// database's ON DELETE CASCADE.
+func (r *restaurantRepository) Purge(ctx context.Context, ids []uuid.UUID, deletedBefore time.Time) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	var purged []RestaurantORM
+	if err := r.deletedQuery(r.db.WithContext(ctx)).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("id IN ? AND deleted_at < ?", ids, deletedBefore).
+		Delete(&purged).Error; err != nil {
+		return nil, err
+	}
+
+	purgedIDs := make([]uuid.UUID, len(purged))
+	for i := range purged {
+		purgedIDs[i] = purged[i].ID
+	}
+	return purgedIDs, nil
 }
 
-// FindByLocation finds restaurants within a radius from a location
-// Note: This is a simplified implementation. In production, use PostGIS for better performance
-func (r *restaurantRepository) FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*model.Restaurant, error) {
-	var orms []RestaurantORM
+// deletedQuery restricts tx to the soft-deleted restaurants that are not
+// merged into another one
+func (r *restaurantRepository) deletedQuery(tx *gorm.DB) *gorm.DB {
+	return tx.Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Where("NOT EXISTS (?)", r.db.Model(&RestaurantMergeORM{}).
+			Select("1").
+			Where("restaurant_merges.merged_id = restaurants.id AND restaurant_merges.unmerged_at IS NULL"))
+}
 
-	// Simple bounding box query (should use PostGIS in production)
-	// Approximate: 1 degree latitude ≈ 111 km
-	latDelta := radiusKm / 111.0
-	lngDelta := radiusKm / (111.0 * 0.9) // Approximate for mid-latitudes
+// Search performs a ranked full-text and fuzzy search. Each query variant
+// (normalized, romaji, kana) is matched against the search_vector column and,
+// for typo tolerance, against search_text with pg_trgm word similarity.
+func (r *restaurantRepository) Search(ctx context.Context, query string, limit, offset int) ([]*repository.RestaurantSearchHit, int64, error) {
+	exprs, ok := buildSearchExprs(query)
+	if !ok {
+		return []*repository.RestaurantSearchHit{}, 0, nil
+	}
 
-	if err := r.db.WithContext(ctx).
-		Where("latitude BETWEEN ? AND ?", lat-latDelta, lat+latDelta).
-		Where("longitude BETWEEN ? AND ?", lng-lngDelta, lng+lngDelta).
+	var rows []searchRow
+	var total int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := setSearchThreshold(tx); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&RestaurantORM{}).
+			Where(exprs.match, exprs.matchArgs...).
+			Count(&total).Error; err != nil {
+			return err
+		}
+
+		return searchListing(tx, exprs).
+			Order(orderByScore.clause()).
+			Limit(limit).
+			Offset(offset).
+			Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toSearchHits(rows), total, nil
+}
+
+// SearchAfter is the keyset-paginated form of Search
+func (r *restaurantRepository) SearchAfter(ctx context.Context, query string, after *repository.Cursor, limit int) ([]*repository.RestaurantSearchHit, int64, *repository.Cursor, error) {
+	exprs, ok := buildSearchExprs(query)
+	if !ok {
+		return []*repository.RestaurantSearchHit{}, 0, nil, nil
+	}
+
+	var rows []searchRow
+	var total int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := setSearchThreshold(tx); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&RestaurantORM{}).
+			Where(exprs.match, exprs.matchArgs...).
+			Count(&total).Error; err != nil {
+			return err
+		}
+
+		page, err := orderByScore.page(tx.Table("(?) AS hits", searchListing(tx, exprs)), after, limit)
+		if err != nil {
+			return err
+		}
+		return page.Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	var next *repository.Cursor
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		next = orderByScore.cursor(last.Score, last.ID)
+	}
+
+	return toSearchHits(rows), total, next, nil
+}
+
+// FindByLocation finds restaurants within a great-circle radius from a location,
+// ordered by ascending distance. It uses an index-backed earthdistance or PostGIS
+// query when the extension is installed and a haversine query otherwise.
+func (r *restaurantRepository) FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*repository.NearbyRestaurant, error) {
+	var rows []nearbyRow
+	if err := nearbyQuery(r.db.WithContext(ctx), r.spatialBackendFor(ctx), lat, lng, radiusKm).
+		Order("distance_km ASC").
 		Limit(limit).
-		Find(&orms).Error; err != nil {
+		Scan(&rows).Error; err != nil {
 		return nil, err
 	}
 
-	restaurants := make([]*model.Restaurant, 0, len(orms))
-	for _, orm := range orms {
-		restaurant, err := orm.ToDomain()
+	return toNearbyRestaurants(rows), nil
+}
+
+// FindByFilter lists restaurants matching every criterion of the filter.
+// Text and Near reuse the search and radius queries, so a filtered listing
+// matches exactly what Search and FindByLocation would return for them.
+func (r *restaurantRepository) FindByFilter(ctx context.Context, filter repository.RestaurantFilter, limit, offset int) ([]*repository.FilteredRestaurant, int64, error) {
+	search, spatial, ok := r.prepareFilter(ctx, filter)
+	if !ok {
+		return []*repository.FilteredRestaurant{}, 0, nil
+	}
+	order, ok := filterOrders[filter.Sort]
+	if !ok {
+		return nil, 0, domainerrors.ErrInvalidSort
+	}
+
+	var rows []filteredRow
+	var total int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if total, err = r.countFiltered(tx, filter, search, spatial); err != nil {
+			return err
+		}
+
+		return r.filteredListing(tx, filter, search, spatial).
+			Order(order.clause()).
+			Limit(limit).
+			Offset(offset).
+			Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toFilteredRestaurants(rows), total, nil
+}
+
+// FindByFilterAfter is the keyset-paginated form of FindByFilter
+func (r *restaurantRepository) FindByFilterAfter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, int64, *repository.Cursor, error) {
+	search, spatial, ok := r.prepareFilter(ctx, filter)
+	if !ok {
+		return []*repository.FilteredRestaurant{}, 0, nil, nil
+	}
+	order, ok := filterOrders[filter.Sort]
+	if !ok {
+		return nil, 0, nil, domainerrors.ErrInvalidSort
+	}
+
+	var rows []filteredRow
+	var total int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if total, err = r.countFiltered(tx, filter, search, spatial); err != nil {
+			return err
+		}
+		rows, err = r.filteredPage(tx, filter, search, spatial, order, after, limit)
+		return err
+	})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	results, next := toFilteredPage(rows, filter.Sort, order, limit)
+	return results, total, next, nil
+}
+
+// FindPageByFilter is FindByFilterAfter without counting the matches
+func (r *restaurantRepository) FindPageByFilter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, *repository.Cursor, error) {
+	search, spatial, ok := r.prepareFilter(ctx, filter)
+	if !ok {
+		return []*repository.FilteredRestaurant{}, nil, nil
+	}
+	order, ok := filterOrders[filter.Sort]
+	if !ok {
+		return nil, nil, domainerrors.ErrInvalidSort
+	}
+
+	var rows []filteredRow
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if search != nil {
+			if err := setSearchThreshold(tx); err != nil {
+				return err
+			}
+		}
+		var err error
+		rows, err = r.filteredPage(tx, filter, search, spatial, order, after, limit)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, next := toFilteredPage(rows, filter.Sort, order, limit)
+	return results, next, nil
+}
+
+// filteredPage scans the keyset page after the cursor, plus one row telling
+// whether another page follows
+func (r *restaurantRepository) filteredPage(tx *gorm.DB, filter repository.RestaurantFilter, search *searchExprs, spatial spatialBackend, order keysetOrder, after *repository.Cursor, limit int) ([]filteredRow, error) {
+	page, err := order.page(tx.Table("(?) AS listing", r.filteredListing(tx, filter, search, spatial)), after, limit)
+	if err != nil {
+		return nil, err
+	}
+	var rows []filteredRow
+	err = page.Scan(&rows).Error
+	return rows, err
+}
+
+// toFilteredPage converts a scanned keyset page and returns the cursor of the
+// next page, nil on the last page
+func toFilteredPage(rows []filteredRow, sort repository.RestaurantSort, order keysetOrder, limit int) ([]*repository.FilteredRestaurant, *repository.Cursor) {
+	var next *repository.Cursor
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		next = order.cursor(last.sortKey(sort), last.ID)
+	}
+	return toFilteredRestaurants(rows), next
+}
+
+// prepareFilter builds the text search of a filter and returns the spatial
+// backend when the filter has a Near point. ok is false when the filter's
+// text has no searchable tokens, so nothing can match.
+func (r *restaurantRepository) prepareFilter(ctx context.Context, filter repository.RestaurantFilter) (search *searchExprs, spatial spatialBackend, ok bool) {
+	if filter.Text != "" {
+		exprs, ok := buildSearchExprs(filter.Text)
+		if !ok {
+			return nil, spatialHaversine, false
+		}
+		search = &exprs
+	}
+	if filter.Near != nil {
+		spatial = r.spatialBackendFor(ctx)
+	}
+	return search, spatial, true
+}
+
+// spatialBackendFor returns the spatial backend of the database, detecting it
+// on first use. A failed detection is not kept: the query falls back to
+// haversine and the next one detects again.
+func (r *restaurantRepository) spatialBackendFor(ctx context.Context) spatialBackend {
+	r.spatialMu.Lock()
+	defer r.spatialMu.Unlock()
+
+	if r.spatial == nil {
+		backend, err := detectSpatialBackend(ctx, r.db)
 		if err != nil {
-			continue
+			return spatialHaversine
 		}
-		restaurants = append(restaurants, restaurant)
+		r.spatial = &backend
 	}
+	return *r.spatial
+}
 
-	return restaurants, nil
+// countFiltered counts all rows matching the filter. With a text search it
+// also sets the similarity threshold for the rest of the transaction.
+func (r *restaurantRepository) countFiltered(tx *gorm.DB, filter repository.RestaurantFilter, search *searchExprs, spatial spatialBackend) (int64, error) {
+	if search != nil {
+		if err := setSearchThreshold(tx); err != nil {
+			return 0, err
+		}
+	}
+
+	var total int64
+	err := tx.Table("(?) AS filtered", filterQuery(tx, spatial, filter, search)).Count(&total).Error
+	return total, err
+}
+
+// filteredListing selects the rows matching the filter, adding the score column for text searches
+func (r *restaurantRepository) filteredListing(tx *gorm.DB, filter repository.RestaurantFilter, search *searchExprs, spatial spatialBackend) *gorm.DB {
+	query := tx.Table("(?) AS filtered", filterQuery(tx, spatial, filter, search))
+	if search != nil {
+		query = query.Select("filtered.*, "+search.score+" AS score", search.scoreArgs...)
+	}
+	return query
 }
 
 // List lists all restaurants with pagination
 func (r *restaurantRepository) List(ctx context.Context, limit, offset int) ([]*model.Restaurant, error) {
 	var orms []RestaurantORM
 	if err := r.db.WithContext(ctx).
+		Order(orderByNewest.clause()).
 		Limit(limit).
 		Offset(offset).
 		Find(&orms).Error; err != nil {
@@ -306,6 +1130,11 @@ func (r *restaurantRepository) List(ctx context.Context, limit, offset int) ([]*
 	return restaurants, nil
 }
 
+// ListAfter is the keyset-paginated form of List
+func (r *restaurantRepository) ListAfter(ctx context.Context, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	return findNewestAfter(r.db.WithContext(ctx).Model(&RestaurantORM{}), after, limit)
+}
+
 // Count returns the total count of restaurants
 func (r *restaurantRepository) Count(ctx context.Context) (int64, error) {
 	var count int64
@@ -315,11 +1144,12 @@ func (r *restaurantRepository) Count(ctx context.Context) (int64, error) {
 	return count, nil
 }
 
-// FindByCuisineType finds restaurants by cuisine type
+// FindByCuisineType finds restaurants by cuisine type, see cuisineTypeCondition
 func (r *restaurantRepository) FindByCuisineType(ctx context.Context, cuisineType string, limit, offset int) ([]*model.Restaurant, error) {
 	var orms []RestaurantORM
 	if err := r.db.WithContext(ctx).
-		Where("cuisine_type = ?", cuisineType).
+		Where(cuisineTypeCondition(cuisineType)).
+		Order(orderByNewest.clause()).
 		Limit(limit).
 		Offset(offset).
 		Find(&orms).Error; err != nil {
@@ -338,11 +1168,17 @@ func (r *restaurantRepository) FindByCuisineType(ctx context.Context, cuisineTyp
 	return restaurants, nil
 }
 
+// FindByCuisineTypeAfter is the keyset-paginated form of FindByCuisineType
+func (r *restaurantRepository) FindByCuisineTypeAfter(ctx context.Context, cuisineType string, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	return findNewestAfter(r.db.WithContext(ctx).Where(cuisineTypeCondition(cuisineType)), after, limit)
+}
+
 // FindBySource finds restaurants by source
 func (r *restaurantRepository) FindBySource(ctx context.Context, source model.RestaurantSource, limit, offset int) ([]*model.Restaurant, error) {
 	var orms []RestaurantORM
 	if err := r.db.WithContext(ctx).
 		Where("source = ?", string(source)).
+		Order(orderByNewest.clause()).
 		Limit(limit).
 		Offset(offset).
 		Find(&orms).Error; err != nil {
@@ -360,3 +1196,85 @@ func (r *restaurantRepository) FindBySource(ctx context.Context, source model.Re
 
 	return restaurants, nil
 }
+
+// FindBySourceAfter is the keyset-paginated form of FindBySource
+func (r *restaurantRepository) FindBySourceAfter(ctx context.Context, source model.RestaurantSource, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	return findNewestAfter(r.db.WithContext(ctx).Where("source = ?", string(source)), after, limit)
+}
+
+// findNewestAfter returns the page of restaurants after the cursor, newest
+// first, and the cursor of the next page (nil on the last page)
+func findNewestAfter(query *gorm.DB, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	query, err := orderByNewest.page(query, after, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var orms []RestaurantORM
+	if err := query.Find(&orms).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var next *repository.Cursor
+	if len(orms) > limit {
+		orms = orms[:limit]
+		last := orms[limit-1]
+		next = orderByNewest.cursor(last.CreatedAt, last.ID)
+	}
+
+	restaurants := make([]*model.Restaurant, 0, len(orms))
+	for _, orm := range orms {
+		restaurant, err := orm.ToDomain()
+		if err != nil {
+			continue
+		}
+		restaurants = append(restaurants, restaurant)
+	}
+
+	return restaurants, next, nil
+}
+
+// FindStale finds restaurants last updated before query.UpdatedBefore, ordered
+// by view count, then favorite count, then oldest first
+func (r *restaurantRepository) FindStale(ctx context.Context, query repository.StaleQuery) ([]*model.Restaurant, error) {
+	var orms []RestaurantORM
+	if err := r.staleQuery(ctx, query).
+		Order("view_count DESC").
+		Order("(SELECT COUNT(*) FROM user_favorites f WHERE f.restaurant_id = restaurants.id AND f.deleted_at IS NULL) DESC").
+		Order("updated_at ASC").
+		Limit(query.Limit).
+		Find(&orms).Error; err != nil {
+		return nil, err
+	}
+
+	restaurants := make([]*model.Restaurant, 0, len(orms))
+	for _, orm := range orms {
+		restaurant, err := orm.ToDomain()
+		if err != nil {
+			continue
+		}
+		restaurants = append(restaurants, restaurant)
+	}
+
+	return restaurants, nil
+}
+
+// CountStale returns the number of restaurants matching the stale query
+func (r *restaurantRepository) CountStale(ctx context.Context, query repository.StaleQuery) (int64, error) {
+	var count int64
+	if err := r.staleQuery(ctx, query).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *restaurantRepository) staleQuery(ctx context.Context, query repository.StaleQuery) *gorm.DB {
+	tx := r.db.WithContext(ctx).Model(&RestaurantORM{}).Where("updated_at < ?", query.UpdatedBefore)
+	if query.Source != "" {
+		tx = tx.Where("source = ?", string(query.Source))
+	}
+	if len(query.ExcludeIDs) > 0 {
+		tx = tx.Where("id NOT IN ?", query.ExcludeIDs)
+	}
+	return tx
+}
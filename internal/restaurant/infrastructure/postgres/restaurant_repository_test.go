@@ -0,0 +1,86 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestaurantRepository_Update_RevertsFieldsToEmpty(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewRestaurantRepository(db)
+	restaurant := createTestRestaurant(t, db, "Tempura Kondo")
+
+	restaurant.UpdatePhone("03-1234-5678")
+	restaurant.UpdateRating(4.2)
+	require.NoError(t, repo.Update(ctx, restaurant))
+
+	// A revert to the revision before the edit restores the empty values
+	require.NoError(t, restaurant.RestoreField(model.FieldPhone, ""))
+	require.NoError(t, restaurant.RestoreField(model.FieldRating, "0"))
+	require.NoError(t, repo.Update(ctx, restaurant))
+
+	saved, err := repo.FindByID(ctx, restaurant.ID())
+	require.NoError(t, err)
+	assert.Empty(t, saved.Phone())
+	assert.Zero(t, saved.Rating())
+}
+
+func TestRestaurantRepository_FindStale_IgnoresRemovedFavorites(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	unfavorited := createTestRestaurant(t, db, "Sushi Saito")
+	favorited := createTestRestaurant(t, db, "Ramen Ichiran")
+
+	favorites := NewFavoriteRepository(db)
+	for i := 0; i < 2; i++ {
+		favorite := model.NewFavorite(uuid.New(), unfavorited.ID())
+		require.NoError(t, favorites.Create(ctx, favorite))
+		require.NoError(t, favorites.Delete(ctx, favorite.ID()))
+	}
+	require.NoError(t, favorites.Create(ctx, model.NewFavorite(uuid.New(), favorited.ID())))
+
+	stale, err := NewRestaurantRepository(db).FindStale(ctx, repository.StaleQuery{
+		Source:        model.SourceGoogle,
+		UpdatedBefore: time.Now().Add(time.Hour),
+		Limit:         2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, stale, 2)
+	assert.Equal(t, favorited.ID(), stale[0].ID())
+	assert.Equal(t, unfavorited.ID(), stale[1].ID())
+}
+
+func TestRestaurantRepository_FindByLocation_DetectsSpatialBackendAgainAfterFailure(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	restaurant := createTestRestaurant(t, db, "Tonkatsu Maisen")
+	repo := NewRestaurantRepository(db).(*restaurantRepository)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err := repo.FindByLocation(canceled, 35.6762, 139.6503, 1, 10)
+	require.Error(t, err)
+	assert.Nil(t, repo.spatial, "a failed detection must not be kept")
+
+	nearby, err := repo.FindByLocation(ctx, 35.6762, 139.6503, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, nearby, 1)
+	assert.Equal(t, restaurant.ID(), nearby[0].Restaurant.ID())
+
+	expected, err := detectSpatialBackend(ctx, db)
+	require.NoError(t, err)
+	require.NotNil(t, repo.spatial)
+	assert.Equal(t, expected, *repo.spatial)
+}
@@ -0,0 +1,341 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReviewORM is the database model for Review
+type ReviewORM struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null;index"`
+	RestaurantID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Score          int        `gorm:"type:smallint;not null"`
+	Text           string     `gorm:"type:text;not null"`
+	Language       string     `gorm:"type:varchar(35);not null"`
+	Status         string     `gorm:"type:varchar(20);not null;index"`
+	ModeratorID    string     `gorm:"type:varchar(255);not null"`
+	ModerationNote string     `gorm:"type:text;not null"`
+	ModeratedAt    *time.Time `gorm:"type:timestamp"`
+	HelpfulCount   int        `gorm:"type:int;not null;default:0"`
+	CreatedAt      time.Time  `gorm:"not null"`
+	UpdatedAt      time.Time  `gorm:"not null"`
+	EditedAt       *time.Time `gorm:"type:timestamp"`
+}
+
+// TableName overrides the table name
+func (ReviewORM) TableName() string {
+	return "restaurant_reviews"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (r *ReviewORM) ToDomain() *model.Review {
+	return model.ReconstructReview(
+		r.ID,
+		r.UserID,
+		r.RestaurantID,
+		r.Score,
+		r.Text,
+		r.Language,
+		model.ReviewStatus(r.Status),
+		r.ModeratorID,
+		r.ModerationNote,
+		r.ModeratedAt,
+		r.HelpfulCount,
+		r.CreatedAt,
+		r.UpdatedAt,
+		r.EditedAt,
+	)
+}
+
+// FromDomainReview converts Domain entity to ORM model
+func FromDomainReview(r *model.Review) *ReviewORM {
+	return &ReviewORM{
+		ID:             r.ID(),
+		UserID:         r.UserID(),
+		RestaurantID:   r.RestaurantID(),
+		Score:          r.Score(),
+		Text:           r.Text(),
+		Language:       r.Language(),
+		Status:         string(r.Status()),
+		ModeratorID:    r.ModeratorID(),
+		ModerationNote: r.ModerationNote(),
+		ModeratedAt:    r.ModeratedAt(),
+		HelpfulCount:   r.HelpfulCount(),
+		CreatedAt:      r.CreatedAt(),
+		UpdatedAt:      r.UpdatedAt(),
+		EditedAt:       r.EditedAt(),
+	}
+}
+
+// ReviewEditORM is the database model for ReviewEdit
+type ReviewEditORM struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ReviewID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Score    int       `gorm:"type:smallint;not null"`
+	Text     string    `gorm:"type:text;not null"`
+	Language string    `gorm:"type:varchar(35);not null"`
+	EditedAt time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (ReviewEditORM) TableName() string {
+	return "restaurant_review_edits"
+}
+
+// ReviewVoteORM is a user's helpful vote on a review
+type ReviewVoteORM struct {
+	ReviewID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (ReviewVoteORM) TableName() string {
+	return "restaurant_review_votes"
+}
+
+// reviewSortOrders maps each review sort to its ORDER BY clause
+var reviewSortOrders = map[repository.ReviewSort]string{
+	repository.SortReviewsNewest:  "created_at DESC, id",
+	repository.SortReviewsOldest:  "created_at ASC, id",
+	repository.SortReviewsHighest: "score DESC, created_at DESC, id",
+	repository.SortReviewsLowest:  "score ASC, created_at DESC, id",
+	repository.SortReviewsHelpful: "helpful_count DESC, created_at DESC, id",
+}
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewRepository creates a new postgres review repository
+func NewReviewRepository(db *gorm.DB) repository.ReviewRepository {
+	return &reviewRepository{db: db}
+}
+
+// Create stores a new review. The unique index on user and restaurant turns a
+// second review of the same restaurant into a conflict.
+func (r *reviewRepository) Create(ctx context.Context, review *model.Review) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(FromDomainReview(review))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrReviewAlreadyExists
+		}
+		return refreshReviewSummary(tx, review.RestaurantID())
+	})
+}
+
+// FindByID finds a review by ID
+func (r *reviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Review, error) {
+	var orm ReviewORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// FindByUserAndRestaurant finds a user's review of a restaurant
+func (r *reviewRepository) FindByUserAndRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Review, error) {
+	var orm ReviewORM
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND restaurant_id = ?", userID, restaurantID).
+		First(&orm).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// List lists the reviews matching the query in the query's order
+func (r *reviewRepository) List(ctx context.Context, query repository.ReviewQuery) ([]*model.Review, int64, error) {
+	order, ok := reviewSortOrders[query.Sort]
+	if !ok {
+		order = reviewSortOrders[repository.SortReviewsNewest]
+	}
+
+	tx := r.db.WithContext(ctx).Model(&ReviewORM{})
+	if query.RestaurantID != nil {
+		tx = tx.Where("restaurant_id = ?", *query.RestaurantID)
+	}
+	if query.UserID != nil {
+		tx = tx.Where("user_id = ?", *query.UserID)
+	}
+	if query.Status != "" {
+		tx = tx.Where("status = ?", string(query.Status))
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orms []ReviewORM
+	if err := tx.Order(order).Limit(query.Limit).Offset(query.Offset).Find(&orms).Error; err != nil {
+		return nil, 0, err
+	}
+
+	reviews := make([]*model.Review, len(orms))
+	for i := range orms {
+		reviews[i] = orms[i].ToDomain()
+	}
+	return reviews, total, nil
+}
+
+// Update stores a review and, when edit is not nil, its previous version.
+// The helpful count is kept by the votes and is not written here.
+func (r *reviewRepository) Update(ctx context.Context, review *model.Review, edit *model.ReviewEdit) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&ReviewORM{}).
+			Where("id = ?", review.ID()).
+			Updates(map[string]interface{}{
+				"score":           review.Score(),
+				"text":            review.Text(),
+				"language":        review.Language(),
+				"status":          string(review.Status()),
+				"moderator_id":    review.ModeratorID(),
+				"moderation_note": review.ModerationNote(),
+				"moderated_at":    review.ModeratedAt(),
+				"updated_at":      review.UpdatedAt(),
+				"edited_at":       review.EditedAt(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrReviewNotFound
+		}
+
+		if edit != nil {
+			if err := tx.Create(&ReviewEditORM{
+				ID:       edit.ID,
+				ReviewID: edit.ReviewID,
+				Score:    edit.Score,
+				Text:     edit.Text,
+				Language: edit.Language,
+				EditedAt: edit.EditedAt,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return refreshReviewSummary(tx, review.RestaurantID())
+	})
+}
+
+// Delete deletes a review; its history and votes are removed by the foreign
+// keys
+func (r *reviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var orm ReviewORM
+		result := tx.Clauses(clause.Returning{Columns: []clause.Column{{Name: "restaurant_id"}}}).
+			Where("id = ?", id).
+			Delete(&orm)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrReviewNotFound
+		}
+		return refreshReviewSummary(tx, orm.RestaurantID)
+	})
+}
+
+// ListEdits lists the previous versions of a review, newest first
+func (r *reviewRepository) ListEdits(ctx context.Context, reviewID uuid.UUID) ([]*model.ReviewEdit, error) {
+	var orms []ReviewEditORM
+	err := r.db.WithContext(ctx).
+		Where("review_id = ?", reviewID).
+		Order("edited_at DESC, id").
+		Find(&orms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	edits := make([]*model.ReviewEdit, len(orms))
+	for i, orm := range orms {
+		edits[i] = &model.ReviewEdit{
+			ID:       orm.ID,
+			ReviewID: orm.ReviewID,
+			Score:    orm.Score,
+			Text:     orm.Text,
+			Language: orm.Language,
+			EditedAt: orm.EditedAt,
+		}
+	}
+	return edits, nil
+}
+
+// AddHelpfulVote records the user's vote and counts it on the review
+func (r *reviewRepository) AddHelpfulVote(ctx context.Context, reviewID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&ReviewVoteORM{
+			ReviewID:  reviewID,
+			UserID:    userID,
+			CreatedAt: time.Now(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Model(&ReviewORM{}).
+			Where("id = ?", reviewID).
+			UpdateColumn("helpful_count", gorm.Expr("helpful_count + 1")).Error
+	})
+}
+
+// RemoveHelpfulVote withdraws the user's vote and uncounts it on the review
+func (r *reviewRepository) RemoveHelpfulVote(ctx context.Context, reviewID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("review_id = ? AND user_id = ?", reviewID, userID).Delete(&ReviewVoteORM{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerrors.ErrVoteNotFound
+		}
+
+		return tx.Model(&ReviewORM{}).
+			Where("id = ? AND helpful_count > 0", reviewID).
+			UpdateColumn("helpful_count", gorm.Expr("helpful_count - 1")).Error
+	})
+}
+
+// refreshReviewSummary recomputes the review rating of a restaurant from its
+// visible reviews. The updated_at trigger ignores the summary columns
+// (migration 000028): a review is not a change to the restaurant.
+func refreshReviewSummary(tx *gorm.DB, restaurantID uuid.UUID) error {
+	return tx.Exec(`
+		UPDATE restaurants SET
+			review_rating = COALESCE(s.average, 0),
+			review_count = s.count
+		FROM (
+			SELECT ROUND(AVG(score), 2) AS average, COUNT(*) AS count
+			FROM restaurant_reviews
+			WHERE restaurant_id = ? AND status = ?
+		) s
+		WHERE restaurants.id = ?`,
+		restaurantID, string(model.ReviewVisible), restaurantID,
+	).Error
+}
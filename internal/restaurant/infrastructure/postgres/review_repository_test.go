@@ -0,0 +1,29 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewRepository_Create_KeepsRestaurantUpdatedAt(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	restaurant := createTestRestaurant(t, db, "Sushi Dai")
+	before := updatedAt(t, db, restaurant.ID())
+
+	review := model.NewReview(uuid.New(), restaurant.ID(), 4, "Fresh fish", "en")
+	require.NoError(t, NewReviewRepository(db).Create(ctx, review))
+
+	saved, err := NewRestaurantRepository(db).FindByID(ctx, restaurant.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, saved.Reviews().Count)
+	assert.True(t, before.Equal(updatedAt(t, db, restaurant.ID())), "a review summary must not change updated_at")
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RestaurantRevisionORM is the database model for RestaurantRevision
+type RestaurantRevisionORM struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Seq          int64     `gorm:"->;type:bigserial"` // insertion order, assigned by the database
+	RestaurantID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Source       string    `gorm:"type:varchar(20);not null"`
+	ActorID      string    `gorm:"type:varchar(255)"`
+	Changes      string    `gorm:"type:jsonb;not null"` // JSON array of model.FieldChange
+	CreatedAt    time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (RestaurantRevisionORM) TableName() string {
+	return "restaurant_revisions"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (r *RestaurantRevisionORM) ToDomain() (*model.RestaurantRevision, error) {
+	var changes []model.FieldChange
+	if err := json.Unmarshal([]byte(r.Changes), &changes); err != nil {
+		return nil, err
+	}
+
+	return model.ReconstructRestaurantRevision(
+		r.ID,
+		r.RestaurantID,
+		model.RevisionSource(r.Source),
+		r.ActorID,
+		changes,
+		r.CreatedAt,
+	), nil
+}
+
+// FromDomainRestaurantRevision converts Domain entity to ORM model
+func FromDomainRestaurantRevision(r *model.RestaurantRevision) (*RestaurantRevisionORM, error) {
+	changes, err := json.Marshal(r.Changes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestaurantRevisionORM{
+		ID:           r.ID(),
+		RestaurantID: r.RestaurantID(),
+		Source:       string(r.Source()),
+		ActorID:      r.ActorID(),
+		Changes:      string(changes),
+		CreatedAt:    r.CreatedAt(),
+	}, nil
+}
+
+type revisionRepository struct {
+	db *gorm.DB
+}
+
+// NewRevisionRepository creates a new postgres restaurant revision repository
+func NewRevisionRepository(db *gorm.DB) repository.RevisionRepository {
+	return &revisionRepository{db: db}
+}
+
+// UpdateWithRevision updates the restaurant and appends the revision in one transaction
+func (r *revisionRepository) UpdateWithRevision(ctx context.Context, restaurant *model.Restaurant, revision *model.RestaurantRevision) error {
+	orm, err := FromDomainRestaurantRevision(revision)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateRestaurant(tx, restaurant); err != nil {
+			return err
+		}
+		return tx.Create(orm).Error
+	})
+}
+
+// FindByID finds a revision by ID
+func (r *revisionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantRevision, error) {
+	var orm RestaurantRevisionORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain()
+}
+
+// ListByRestaurant lists the revisions of a restaurant, newest first
+func (r *revisionRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, limit, offset int) ([]*model.RestaurantRevision, int64, error) {
+	tx := r.db.WithContext(ctx).Model(&RestaurantRevisionORM{}).Where("restaurant_id = ?", restaurantID)
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orms []RestaurantRevisionORM
+	if err := tx.Order("seq DESC").Limit(limit).Offset(offset).Find(&orms).Error; err != nil {
+		return nil, 0, err
+	}
+
+	revisions, err := toDomainRevisions(orms)
+	if err != nil {
+		return nil, 0, err
+	}
+	return revisions, total, nil
+}
+
+// ListNewerThan lists the revisions of the same restaurant recorded after the given one, oldest first
+func (r *revisionRepository) ListNewerThan(ctx context.Context, revision *model.RestaurantRevision) ([]*model.RestaurantRevision, error) {
+	var orms []RestaurantRevisionORM
+	if err := r.db.WithContext(ctx).
+		Where("restaurant_id = ?", revision.RestaurantID()).
+		Where("seq > (SELECT seq FROM restaurant_revisions WHERE id = ?)", revision.ID()).
+		Order("seq ASC").
+		Find(&orms).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainRevisions(orms)
+}
+
+func toDomainRevisions(orms []RestaurantRevisionORM) ([]*model.RestaurantRevision, error) {
+	revisions := make([]*model.RestaurantRevision, 0, len(orms))
+	for i := range orms {
+		revision, err := orms[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
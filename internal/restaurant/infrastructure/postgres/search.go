@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/textnorm"
+	"gorm.io/gorm"
+)
+
+// searchSimilarityThreshold is the pg_trgm word_similarity cutoff for fuzzy
+// matches. The extension default (0.6) rejects most single-letter typos in
+// short names such as "ichrian" for "ichiran".
+const searchSimilarityThreshold = 0.4
+
+// searchRow is a restaurant row with its relevance score
+type searchRow struct {
+	RestaurantORM
+	Score float64 `gorm:"column:score"`
+}
+
+// searchIndexText builds the search_text column for a restaurant, including
+// its localized names so they can be searched in every language
+func searchIndexText(r *model.Restaurant) string {
+	fields := []string{r.Name(), r.NameJa(), r.CuisineType(), r.Area(), r.Address()}
+	for _, lang := range model.LocalizedLanguages {
+		l := r.Localization(lang)
+		fields = append(fields, l.Name, l.CuisineLabel)
+	}
+	for _, id := range r.Cuisines() {
+		if category, ok := model.Cuisines.Category(id); ok {
+			for _, lang := range model.SupportedLanguages {
+				fields = append(fields, category.Labels[lang])
+			}
+		}
+	}
+	return textnorm.IndexText(fields...)
+}
+
+// searchExprs holds the SQL fragments and bind values for a ranked search
+type searchExprs struct {
+	match     string
+	matchArgs []interface{}
+	score     string
+	scoreArgs []interface{}
+}
+
+// buildSearchExprs expands the query into its normalized, romaji and kana
+// variants. A row matches when any variant hits the tsvector or is a fuzzy
+// trigram match of search_text. The score adds the full-text rank to the
+// best trigram similarity, so exact token hits rank above fuzzy-only hits.
+// ok is false when the query has no searchable tokens.
+func buildSearchExprs(query string) (exprs searchExprs, ok bool) {
+	var variants []string
+	for _, v := range textnorm.QueryVariants(query) {
+		if tokens := textnorm.Tokens(v); len(tokens) > 0 {
+			variants = append(variants, strings.Join(tokens, " "))
+		}
+	}
+	if len(variants) == 0 {
+		return searchExprs{}, false
+	}
+
+	tsqueries := make([]string, len(variants))
+	fuzzy := make([]string, len(variants))
+	similarities := make([]string, len(variants))
+	var tsArgs, variantArgs []interface{}
+	for i, v := range variants {
+		tsqueries[i] = "plainto_tsquery('simple', ?)"
+		fuzzy[i] = "? <% search_text"
+		similarities[i] = "word_similarity(?, search_text)"
+		tsArgs = append(tsArgs, v)
+		variantArgs = append(variantArgs, v)
+	}
+	tsquery := "(" + strings.Join(tsqueries, " || ") + ")"
+
+	exprs.match = fmt.Sprintf("(search_vector @@ %s OR %s)", tsquery, strings.Join(fuzzy, " OR "))
+	exprs.matchArgs = append(append(exprs.matchArgs, tsArgs...), variantArgs...)
+
+	exprs.score = fmt.Sprintf("(ts_rank(search_vector, %s) + GREATEST(%s))", tsquery, strings.Join(similarities, ", "))
+	exprs.scoreArgs = append(append(exprs.scoreArgs, tsArgs...), variantArgs...)
+
+	return exprs, true
+}
+
+// searchListing selects the rows matching the search with their score column
+func searchListing(tx *gorm.DB, exprs searchExprs) *gorm.DB {
+	return tx.Model(&RestaurantORM{}).
+		Select("restaurants.*, "+exprs.score+" AS score", exprs.scoreArgs...).
+		Where(exprs.match, exprs.matchArgs...)
+}
+
+// setSearchThreshold lowers the word_similarity threshold for the current transaction
+func setSearchThreshold(tx *gorm.DB) error {
+	return tx.Exec("SELECT set_config('pg_trgm.word_similarity_threshold', ?, true)",
+		fmt.Sprintf("%.2f", searchSimilarityThreshold)).Error
+}
+
+// toSearchHits converts scored rows into domain results, skipping invalid records
+func toSearchHits(rows []searchRow) []*repository.RestaurantSearchHit {
+	hits := make([]*repository.RestaurantSearchHit, 0, len(rows))
+	for i := range rows {
+		restaurant, err := rows[i].RestaurantORM.ToDomain()
+		if err != nil {
+			continue
+		}
+		hits = append(hits, &repository.RestaurantSearchHit{
+			Restaurant: restaurant,
+			Score:      rows[i].Score,
+		})
+	}
+	return hits
+}
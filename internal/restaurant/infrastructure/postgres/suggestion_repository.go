@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EditSuggestionORM is the database model for EditSuggestion
+type EditSuggestionORM struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	RestaurantID uuid.UUID  `gorm:"type:uuid;not null;index"`
+	AuthorID     string     `gorm:"type:varchar(255);not null;index"`
+	Edit         string     `gorm:"type:jsonb;not null"` // model.RestaurantEdit
+	Status       string     `gorm:"type:varchar(20);not null;index"`
+	ReviewerID   string     `gorm:"type:varchar(255)"`
+	ReviewNote   string     `gorm:"type:text"`
+	CreatedAt    time.Time  `gorm:"not null"`
+	ReviewedAt   *time.Time `gorm:"type:timestamp"`
+}
+
+// TableName overrides the table name
+func (EditSuggestionORM) TableName() string {
+	return "restaurant_edit_suggestions"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (s *EditSuggestionORM) ToDomain() (*model.EditSuggestion, error) {
+	var edit model.RestaurantEdit
+	if err := json.Unmarshal([]byte(s.Edit), &edit); err != nil {
+		return nil, err
+	}
+
+	return model.ReconstructEditSuggestion(
+		s.ID,
+		s.RestaurantID,
+		s.AuthorID,
+		edit,
+		model.SuggestionStatus(s.Status),
+		s.ReviewerID,
+		s.ReviewNote,
+		s.CreatedAt,
+		s.ReviewedAt,
+	), nil
+}
+
+// FromDomainEditSuggestion converts Domain entity to ORM model
+func FromDomainEditSuggestion(s *model.EditSuggestion) (*EditSuggestionORM, error) {
+	edit, err := json.Marshal(s.Edit())
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditSuggestionORM{
+		ID:           s.ID(),
+		RestaurantID: s.RestaurantID(),
+		AuthorID:     s.AuthorID(),
+		Edit:         string(edit),
+		Status:       string(s.Status()),
+		ReviewerID:   s.ReviewerID(),
+		ReviewNote:   s.ReviewNote(),
+		CreatedAt:    s.CreatedAt(),
+		ReviewedAt:   s.ReviewedAt(),
+	}, nil
+}
+
+type suggestionRepository struct {
+	db *gorm.DB
+}
+
+// NewSuggestionRepository creates a new postgres edit suggestion repository
+func NewSuggestionRepository(db *gorm.DB) repository.SuggestionRepository {
+	return &suggestionRepository{db: db}
+}
+
+// Create stores a new suggestion
+func (r *suggestionRepository) Create(ctx context.Context, suggestion *model.EditSuggestion) error {
+	orm, err := FromDomainEditSuggestion(suggestion)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Create(orm).Error
+}
+
+// Update stores the review of a suggestion. Only pending suggestions can be
+// reviewed, so a concurrent review of the same suggestion fails.
+func (r *suggestionRepository) Update(ctx context.Context, suggestion *model.EditSuggestion) error {
+	result := r.db.WithContext(ctx).
+		Model(&EditSuggestionORM{}).
+		Where("id = ? AND status = ?", suggestion.ID(), model.SuggestionPending).
+		Updates(map[string]interface{}{
+			"status":      string(suggestion.Status()),
+			"reviewer_id": suggestion.ReviewerID(),
+			"review_note": suggestion.ReviewNote(),
+			"reviewed_at": suggestion.ReviewedAt(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrSuggestionNotPending
+	}
+	return nil
+}
+
+// Reopen returns a reviewed suggestion to pending
+func (r *suggestionRepository) Reopen(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&EditSuggestionORM{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":      string(model.SuggestionPending),
+			"reviewer_id": "",
+			"review_note": "",
+			"reviewed_at": nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrSuggestionNotFound
+	}
+	return nil
+}
+
+// FindByID finds a suggestion by ID
+func (r *suggestionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.EditSuggestion, error) {
+	var orm EditSuggestionORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrSuggestionNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain()
+}
+
+// List lists the suggestions matching the query, oldest first
+func (r *suggestionRepository) List(ctx context.Context, query repository.SuggestionQuery) ([]*model.EditSuggestion, int64, error) {
+	tx := r.db.WithContext(ctx).Model(&EditSuggestionORM{})
+	if query.Status != "" {
+		tx = tx.Where("status = ?", string(query.Status))
+	}
+	if query.RestaurantID != nil {
+		tx = tx.Where("restaurant_id = ?", *query.RestaurantID)
+	}
+	if query.AuthorID != "" {
+		tx = tx.Where("author_id = ?", query.AuthorID)
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orms []EditSuggestionORM
+	if err := tx.Order("created_at ASC, id ASC").Limit(query.Limit).Offset(query.Offset).Find(&orms).Error; err != nil {
+		return nil, 0, err
+	}
+
+	suggestions := make([]*model.EditSuggestion, 0, len(orms))
+	for i := range orms {
+		suggestion, err := orms[i].ToDomain()
+		if err != nil {
+			return nil, 0, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, total, nil
+}
+
+// CountApprovedByAuthor counts the approved suggestions of a user
+func (r *suggestionRepository) CountApprovedByAuthor(ctx context.Context, authorID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&EditSuggestionORM{}).
+		Where("author_id = ? AND status = ?", authorID, model.SuggestionApproved).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// VisitORM is the database model for Visit
+type VisitORM struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID      `gorm:"type:uuid;not null;index"`
+	RestaurantID  uuid.UUID      `gorm:"type:uuid;not null;index"`
+	VisitedOn     time.Time      `gorm:"type:date;not null"`
+	PartySize     int            `gorm:"type:int;not null"`
+	Rating        *int           `gorm:"type:smallint"`
+	Dishes        pq.StringArray `gorm:"type:text[]"`
+	SpendAmount   *float64       `gorm:"type:numeric(14,2)"`
+	SpendCurrency *string        `gorm:"type:char(3)"`
+	PhotoURLs     pq.StringArray `gorm:"column:photo_urls;type:text[]"`
+	CreatedAt     time.Time      `gorm:"not null"`
+	UpdatedAt     time.Time      `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (VisitORM) TableName() string {
+	return "user_visits"
+}
+
+// ToDomain converts ORM model to Domain entity
+func (v *VisitORM) ToDomain() *model.Visit {
+	details := model.VisitDetails{
+		VisitedOn: model.VisitDate(v.VisitedOn),
+		PartySize: v.PartySize,
+		Dishes:    append([]string{}, v.Dishes...),
+		PhotoURLs: append([]string{}, v.PhotoURLs...),
+	}
+	if v.Rating != nil {
+		details.Rating = *v.Rating
+	}
+	if v.SpendAmount != nil && v.SpendCurrency != nil {
+		details.Spend = &model.Money{Amount: *v.SpendAmount, Currency: *v.SpendCurrency}
+	}
+
+	return model.ReconstructVisit(
+		v.ID,
+		v.UserID,
+		v.RestaurantID,
+		details,
+		v.CreatedAt,
+		v.UpdatedAt,
+	)
+}
+
+// FromDomainVisit converts Domain entity to ORM model
+func FromDomainVisit(v *model.Visit) *VisitORM {
+	orm := &VisitORM{
+		ID:           v.ID(),
+		UserID:       v.UserID(),
+		RestaurantID: v.RestaurantID(),
+		VisitedOn:    v.VisitedOn(),
+		PartySize:    v.PartySize(),
+		Dishes:       pq.StringArray(v.Dishes()),
+		PhotoURLs:    pq.StringArray(v.PhotoURLs()),
+		CreatedAt:    v.CreatedAt(),
+		UpdatedAt:    v.UpdatedAt(),
+	}
+	if v.IsRated() {
+		rating := v.Rating()
+		orm.Rating = &rating
+	}
+	if spend := v.Spend(); spend != nil {
+		orm.SpendAmount = &spend.Amount
+		orm.SpendCurrency = &spend.Currency
+	}
+	return orm
+}
+
+type visitRepository struct {
+	db *gorm.DB
+}
+
+// NewVisitRepository creates a new postgres visit repository
+func NewVisitRepository(db *gorm.DB) repository.VisitRepository {
+	return &visitRepository{db: db}
+}
+
+// Create stores a new visit
+func (r *visitRepository) Create(ctx context.Context, visit *model.Visit) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(FromDomainVisit(visit)).Error; err != nil {
+			return err
+		}
+		return appendEvents(tx, model.NewVisitRecordedEvent(visit))
+	})
+}
+
+// FindByID finds a visit by ID
+func (r *visitRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Visit, error) {
+	var orm VisitORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainerrors.ErrVisitNotFound
+		}
+		return nil, err
+	}
+
+	return orm.ToDomain(), nil
+}
+
+// FindByUser lists a user's visits, newest first, and counts all matches
+func (r *visitRepository) FindByUser(ctx context.Context, query repository.VisitQuery) ([]*model.Visit, int64, error) {
+	tx := r.db.WithContext(ctx).Model(&VisitORM{}).Where("user_id = ?", query.UserID)
+	if query.RestaurantID != nil {
+		tx = tx.Where("restaurant_id = ?", *query.RestaurantID)
+	}
+	if query.From != nil {
+		tx = tx.Where("visited_on >= ?", query.From.Format(time.DateOnly))
+	}
+	if query.To != nil {
+		tx = tx.Where("visited_on <= ?", query.To.Format(time.DateOnly))
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orms []VisitORM
+	if err := tx.Order("visited_on DESC, created_at DESC, id").Limit(query.Limit).Offset(query.Offset).Find(&orms).Error; err != nil {
+		return nil, 0, err
+	}
+
+	visits := make([]*model.Visit, len(orms))
+	for i := range orms {
+		visits[i] = orms[i].ToDomain()
+	}
+	return visits, total, nil
+}
+
+// Update updates a visit. All columns are written, so a cleared rating or
+// spend is stored as NULL.
+func (r *visitRepository) Update(ctx context.Context, visit *model.Visit) error {
+	orm := FromDomainVisit(visit)
+
+	result := r.db.WithContext(ctx).Model(&VisitORM{}).
+		Where("id = ?", orm.ID).
+		Select("*").
+		Omit("id", "user_id", "created_at").
+		Updates(orm)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrVisitNotFound
+	}
+	return nil
+}
+
+// Delete deletes a visit
+func (r *visitRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&VisitORM{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.ErrVisitNotFound
+	}
+	return nil
+}
+
+// CountByMonth counts a user's visits per month since the given month
+func (r *visitRepository) CountByMonth(ctx context.Context, userID uuid.UUID, since time.Time) ([]*repository.MonthlyVisits, error) {
+	var rows []*repository.MonthlyVisits
+	err := r.db.WithContext(ctx).Model(&VisitORM{}).
+		Select("DATE_TRUNC('month', visited_on)::date AS month, COUNT(*) AS visits, COUNT(DISTINCT restaurant_id) AS restaurants").
+		Where("user_id = ? AND visited_on >= ?", userID, since.Format(time.DateOnly)).
+		Group("month").
+		Order("month").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SpendByCuisine sums a user's recorded spend per cuisine and currency.
+// Restaurants without a cuisine are grouped under an empty cuisine.
+func (r *visitRepository) SpendByCuisine(ctx context.Context, userID uuid.UUID) ([]*repository.CuisineSpend, error) {
+	var rows []*repository.CuisineSpend
+	err := r.db.WithContext(ctx).Model(&VisitORM{}).
+		Select("COALESCE(restaurants.cuisine_type, '') AS cuisine_type, user_visits.spend_currency AS currency, "+
+			"COUNT(*) AS visits, SUM(user_visits.spend_amount)::float8 AS total").
+		Joins("JOIN restaurants ON restaurants.id = user_visits.restaurant_id").
+		Where("user_visits.user_id = ? AND user_visits.spend_amount IS NOT NULL", userID).
+		Group("1, 2").
+		Order("total DESC, cuisine_type, currency").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// MostRevisited lists the restaurants a user visited more than once
+func (r *visitRepository) MostRevisited(ctx context.Context, userID uuid.UUID, limit int) ([]*repository.RevisitedRestaurant, error) {
+	var rows []*repository.RevisitedRestaurant
+	err := r.db.WithContext(ctx).Model(&VisitORM{}).
+		Select("restaurant_id, COUNT(*) AS visits, MAX(visited_on) AS last_visited_on, AVG(rating)::float8 AS average_rating").
+		Where("user_id = ?", userID).
+		Group("restaurant_id").
+		Having("COUNT(*) > 1").
+		Order("visits DESC, last_visited_on DESC, restaurant_id").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// CountByRestaurant counts a user's visits of a restaurant
+func (r *visitRepository) CountByRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*repository.RestaurantVisits, error) {
+	var row repository.RestaurantVisits
+	err := r.db.WithContext(ctx).Model(&VisitORM{}).
+		Select("COUNT(*) AS visits, MAX(visited_on) AS last_visited_on").
+		Where("user_id = ? AND restaurant_id = ?", userID, restaurantID).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// popularityBucketsKey is a sorted set of the buckets holding counts,
+	// scored by their start time
+	popularityBucketsKey = "restaurant_popularity:buckets"
+
+	// popularityBucketTTL bounds how long counts survive when the rollup is
+	// not running, so Redis does not grow without limit
+	popularityBucketTTL = 7 * 24 * time.Hour
+)
+
+type popularityCounter struct {
+	client *redis.Client
+}
+
+// NewPopularityCounter creates a new redis popularity counter. Each bucket is
+// a hash of "<restaurant id>:<event>" fields, so counting an event is a single
+// HINCRBY instead of a row update in Postgres.
+func NewPopularityCounter(client *redis.Client) repository.PopularityCounter {
+	return &popularityCounter{client: client}
+}
+
+func (c *popularityCounter) bucketKey(bucket time.Time) string {
+	return fmt.Sprintf("restaurant_popularity:%d", bucket.Unix())
+}
+
+func (c *popularityCounter) Incr(ctx context.Context, restaurantID uuid.UUID, event model.PopularityEvent, at time.Time) error {
+	bucket := model.PopularityBucket(at)
+	key := c.bucketKey(bucket)
+
+	pipe := c.client.Pipeline()
+	pipe.HIncrBy(ctx, key, restaurantID.String()+":"+string(event), 1)
+	pipe.Expire(ctx, key, popularityBucketTTL)
+	pipe.ZAdd(ctx, popularityBucketsKey, redis.Z{
+		Score:  float64(bucket.Unix()),
+		Member: strconv.FormatInt(bucket.Unix(), 10),
+	})
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *popularityCounter) Buckets(ctx context.Context) ([]time.Time, error) {
+	members, err := c.client.ZRange(ctx, popularityBucketsKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]time.Time, 0, len(members))
+	for _, member := range members {
+		unix, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		buckets = append(buckets, time.Unix(unix, 0).UTC())
+	}
+	return buckets, nil
+}
+
+func (c *popularityCounter) Counts(ctx context.Context, bucket time.Time) ([]repository.PopularityCount, error) {
+	fields, err := c.client.HGetAll(ctx, c.bucketKey(bucket)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	byRestaurant := make(map[uuid.UUID]*repository.PopularityCount)
+	for field, value := range fields {
+		idStr, eventStr, ok := strings.Cut(field, ":")
+		event := model.PopularityEvent(eventStr)
+		if !ok || !event.IsValid() {
+			continue
+		}
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		count, ok := byRestaurant[id]
+		if !ok {
+			count = &repository.PopularityCount{RestaurantID: id, BucketStart: bucket}
+			byRestaurant[id] = count
+		}
+		count.Add(event, n)
+	}
+
+	counts := make([]repository.PopularityCount, 0, len(byRestaurant))
+	for _, count := range byRestaurant {
+		counts = append(counts, *count)
+	}
+	// Stable order keeps the rollup's row locks in a consistent order
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].RestaurantID.String() < counts[j].RestaurantID.String()
+	})
+	return counts, nil
+}
+
+func (c *popularityCounter) DeleteBucket(ctx context.Context, bucket time.Time) error {
+	pipe := c.client.Pipeline()
+	pipe.Del(ctx, c.bucketKey(bucket))
+	pipe.ZRem(ctx, popularityBucketsKey, strconv.FormatInt(bucket.Unix(), 10))
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestPopularityCounter(t *testing.T) (repository.PopularityCounter, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return NewPopularityCounter(client), mr
+}
+
+func TestPopularityCounter_IncrAndCounts(t *testing.T) {
+	counter, _ := setupTestPopularityCounter(t)
+	ctx := context.Background()
+	first, second := uuid.New(), uuid.New()
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	require.NoError(t, counter.Incr(ctx, first, model.PopularityEventView, at))
+	require.NoError(t, counter.Incr(ctx, first, model.PopularityEventView, at.Add(10*time.Minute)))
+	require.NoError(t, counter.Incr(ctx, first, model.PopularityEventFavorite, at))
+	require.NoError(t, counter.Incr(ctx, second, model.PopularityEventVisit, at))
+	require.NoError(t, counter.Incr(ctx, second, model.PopularityEventView, at.Add(-time.Hour)))
+
+	buckets, err := counter.Buckets(ctx)
+	require.NoError(t, err)
+	bucket := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, []time.Time{bucket.Add(-time.Hour), bucket}, buckets)
+
+	counts, err := counter.Counts(ctx, bucket)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []repository.PopularityCount{
+		{RestaurantID: first, BucketStart: bucket, Views: 2, Favorites: 1},
+		{RestaurantID: second, BucketStart: bucket, Visits: 1},
+	}, counts)
+}
+
+func TestPopularityCounter_DeleteBucket(t *testing.T) {
+	counter, mr := setupTestPopularityCounter(t)
+	ctx := context.Background()
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	bucket := model.PopularityBucket(at)
+
+	require.NoError(t, counter.Incr(ctx, uuid.New(), model.PopularityEventView, at))
+	assert.True(t, mr.TTL("restaurant_popularity:1714564800") > 0)
+
+	require.NoError(t, counter.DeleteBucket(ctx, bucket))
+
+	buckets, err := counter.Buckets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, buckets)
+	counts, err := counter.Counts(ctx, bucket)
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
@@ -2,7 +2,9 @@ package grpc
 
 import (
 	restaurantv1 "github.com/Leon180/tabelogo-v2/api/gen/restaurant/v1"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -39,6 +41,26 @@ func toProtoRestaurant(r *model.Restaurant) *restaurantv1.Restaurant {
 		ViewCount:    r.ViewCount(),
 		CreatedAt:    timestamppb.New(r.CreatedAt()),
 		UpdatedAt:    timestamppb.New(r.UpdatedAt()),
+		Tabelog:      toProtoTabelogProfile(r.Tabelog()),
+		ReviewRating: r.Reviews().Average,
+		ReviewCount:  int32(r.Reviews().Count),
+	}
+}
+
+func toProtoTabelogProfile(p *model.TabelogProfile) *restaurantv1.TabelogProfile {
+	if p == nil {
+		return nil
+	}
+
+	return &restaurantv1.TabelogProfile{
+		Url:         p.URL(),
+		Rating:      p.Rating(),
+		ReviewCount: int32(p.ReviewCount()),
+		Bookmarks:   int32(p.Bookmarks()),
+		Genres:      p.Genres(),
+		MatchScore:  p.MatchScore(),
+		Confirmed:   p.Confirmed(),
+		SyncedAt:    timestamppb.New(p.SyncedAt()),
 	}
 }
 
@@ -72,6 +94,29 @@ func toProtoRestaurants(restaurants []*model.Restaurant) []*restaurantv1.Restaur
 	return result
 }
 
+func toProtoSearchHits(hits []*repository.RestaurantSearchHit) []*restaurantv1.RestaurantSearchHit {
+	result := make([]*restaurantv1.RestaurantSearchHit, len(hits))
+	for i, hit := range hits {
+		result[i] = &restaurantv1.RestaurantSearchHit{
+			Restaurant: toProtoRestaurant(hit.Restaurant),
+			Score:      hit.Score,
+		}
+	}
+	return result
+}
+
+func toProtoListedRestaurants(results []*repository.FilteredRestaurant) []*restaurantv1.ListedRestaurant {
+	result := make([]*restaurantv1.ListedRestaurant, len(results))
+	for i, r := range results {
+		result[i] = &restaurantv1.ListedRestaurant{
+			Restaurant: toProtoRestaurant(r.Restaurant),
+			DistanceKm: r.DistanceKm,
+			Score:      r.Score,
+		}
+	}
+	return result
+}
+
 func toProtoFavorites(favorites []*model.Favorite) []*restaurantv1.Favorite {
 	result := make([]*restaurantv1.Favorite, len(favorites))
 	for i, f := range favorites {
@@ -80,6 +125,115 @@ func toProtoFavorites(favorites []*model.Favorite) []*restaurantv1.Favorite {
 	return result
 }
 
+func toProtoCollection(c *model.Collection) *restaurantv1.Collection {
+	var sharedAt *timestamppb.Timestamp
+	if c.SharedAt() != nil {
+		sharedAt = timestamppb.New(*c.SharedAt())
+	}
+
+	return &restaurantv1.Collection{
+		Id:          c.ID().String(),
+		UserId:      c.UserID().String(),
+		Name:        c.Name(),
+		Description: c.Description(),
+		CreatedAt:   timestamppb.New(c.CreatedAt()),
+		UpdatedAt:   timestamppb.New(c.UpdatedAt()),
+		ShareSlug:   c.ShareSlug(),
+		SharedAt:    sharedAt,
+	}
+}
+
+func toProtoCollections(collections []*model.Collection) []*restaurantv1.Collection {
+	result := make([]*restaurantv1.Collection, len(collections))
+	for i, c := range collections {
+		result[i] = toProtoCollection(c)
+	}
+	return result
+}
+
+func toProtoCollectionDetail(detail *application.CollectionDetail) *restaurantv1.CollectionDetailResponse {
+	items := make([]*restaurantv1.CollectionItem, len(detail.Items))
+	for i, item := range detail.Items {
+		items[i] = &restaurantv1.CollectionItem{
+			Position: int32(item.Position),
+			AddedAt:  timestamppb.New(item.AddedAt),
+			Favorite: toProtoFavorite(item.Favorite),
+		}
+	}
+	return &restaurantv1.CollectionDetailResponse{
+		Collection: toProtoCollection(detail.Collection),
+		Items:      items,
+	}
+}
+
+func toProtoDuplicateCandidates(candidates []*application.DuplicateCandidate) []*restaurantv1.DuplicateCandidate {
+	result := make([]*restaurantv1.DuplicateCandidate, len(candidates))
+	for i, c := range candidates {
+		result[i] = &restaurantv1.DuplicateCandidate{
+			A:             toProtoRestaurant(c.A),
+			B:             toProtoRestaurant(c.B),
+			Score:         c.Match.Score,
+			NameScore:     c.Match.Name,
+			PhoneScore:    c.Match.Phone,
+			LocationScore: c.Match.Location,
+			DistanceKm:    c.Match.DistanceKm,
+		}
+	}
+	return result
+}
+
+func toProtoRestaurantMerge(m *model.RestaurantMerge) *restaurantv1.RestaurantMerge {
+	if m == nil {
+		return nil
+	}
+
+	var unmergedAt *timestamppb.Timestamp
+	if m.UnmergedAt() != nil {
+		unmergedAt = timestamppb.New(*m.UnmergedAt())
+	}
+
+	return &restaurantv1.RestaurantMerge{
+		Id:               m.ID().String(),
+		CanonicalId:      m.CanonicalID().String(),
+		MergedId:         m.MergedID().String(),
+		Source:           string(m.Source()),
+		ExternalId:       m.ExternalID(),
+		Score:            m.Score(),
+		MergedBy:         m.MergedBy(),
+		MovedFavorites:   int32(len(m.MovedFavoriteIDs())),
+		DroppedFavorites: int32(len(m.DroppedFavoriteIDs())),
+		CreatedAt:        timestamppb.New(m.CreatedAt()),
+		UnmergedAt:       unmergedAt,
+	}
+}
+
+func toProtoExternalRef(ref repository.ExternalRef) *restaurantv1.ExternalRef {
+	return &restaurantv1.ExternalRef{
+		Source:     string(ref.Source),
+		ExternalId: ref.ExternalID,
+	}
+}
+
+var upsertStatuses = map[application.UpsertOutcome]restaurantv1.UpsertStatus{
+	application.UpsertCreated:   restaurantv1.UpsertStatus_UPSERT_STATUS_CREATED,
+	application.UpsertUpdated:   restaurantv1.UpsertStatus_UPSERT_STATUS_UPDATED,
+	application.UpsertUnchanged: restaurantv1.UpsertStatus_UPSERT_STATUS_UNCHANGED,
+	application.UpsertFailed:    restaurantv1.UpsertStatus_UPSERT_STATUS_FAILED,
+}
+
+func toProtoUpsertResults(results []application.UpsertResult) []*restaurantv1.UpsertResult {
+	result := make([]*restaurantv1.UpsertResult, len(results))
+	for i, r := range results {
+		result[i] = &restaurantv1.UpsertResult{
+			Index:      int32(i),
+			Status:     upsertStatuses[r.Outcome],
+			Restaurant: toProtoRestaurant(r.Restaurant),
+			Error:      r.Error,
+		}
+	}
+	return result
+}
+
 // Proto to Domain converters
 
 func fromProtoLocation(loc *restaurantv1.Location) (*model.Location, error) {
@@ -88,3 +242,33 @@ func fromProtoLocation(loc *restaurantv1.Location) (*model.Location, error) {
 	}
 	return model.NewLocation(loc.Latitude, loc.Longitude)
 }
+
+func fromProtoExternalRef(ref *restaurantv1.ExternalRef) repository.ExternalRef {
+	return repository.ExternalRef{
+		Source:     model.RestaurantSource(ref.GetSource()),
+		ExternalID: ref.GetExternalId(),
+	}
+}
+
+// fromProtoUpsertItem converts an upsert item to the record the catalog
+// service upserts. A missing location is left for validation to report.
+func fromProtoUpsertItem(item *restaurantv1.UpsertRestaurantItem) *application.CatalogRecord {
+	record := &application.CatalogRecord{
+		Source:      item.GetSource(),
+		ExternalID:  item.GetExternalId(),
+		Name:        item.GetName(),
+		NameJa:      item.GetNameJa(),
+		Area:        item.GetArea(),
+		Address:     item.GetAddress(),
+		Rating:      item.GetRating(),
+		PriceRange:  item.GetPriceRange(),
+		CuisineType: item.GetCuisineType(),
+		Phone:       item.GetPhone(),
+		Website:     item.GetWebsite(),
+	}
+	if loc := item.GetLocation(); loc != nil {
+		lat, lng := loc.Latitude, loc.Longitude
+		record.Latitude, record.Longitude = &lat, &lng
+	}
+	return record
+}
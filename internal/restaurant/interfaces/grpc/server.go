@@ -2,11 +2,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	restaurantv1 "github.com/Leon180/tabelogo-v2/api/gen/restaurant/v1"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/dedup"
 	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -15,17 +18,29 @@ import (
 
 type RestaurantServer struct {
 	restaurantv1.UnimplementedRestaurantServiceServer
-	service application.RestaurantService
-	logger  *zap.Logger
+	service           application.RestaurantService
+	mergeService      application.MergeService
+	catalogService    application.CatalogService
+	collectionService application.CollectionService
+	visitService      application.VisitService
+	logger            *zap.Logger
 }
 
 func NewRestaurantServer(
 	service application.RestaurantService,
+	mergeService application.MergeService,
+	catalogService application.CatalogService,
+	collectionService application.CollectionService,
+	visitService application.VisitService,
 	logger *zap.Logger,
 ) *RestaurantServer {
 	return &RestaurantServer{
-		service: service,
-		logger:  logger,
+		service:           service,
+		mergeService:      mergeService,
+		catalogService:    catalogService,
+		collectionService: collectionService,
+		visitService:      visitService,
+		logger:            logger,
 	}
 }
 
@@ -178,19 +193,45 @@ func (s *RestaurantServer) SearchRestaurants(
 		offset = 0
 	}
 
-	restaurants, err := s.service.SearchRestaurants(ctx, req.Query, limit, offset)
+	var hits []*repository.RestaurantSearchHit
+	var total int64
+	var next *repository.Cursor
+	var err error
+	if offset > 0 {
+		if req.Cursor != "" {
+			return nil, status.Error(codes.InvalidArgument, "offset and cursor cannot be combined")
+		}
+		hits, total, err = s.service.SearchRestaurants(ctx, req.Query, limit, offset)
+	} else {
+		after, decodeErr := repository.DecodeCursor(req.Cursor)
+		if decodeErr != nil {
+			return nil, status.Error(codes.InvalidArgument, decodeErr.Error())
+		}
+		hits, total, next, err = s.service.SearchRestaurantsAfter(ctx, req.Query, after, limit)
+	}
 	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("Failed to search restaurants", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to search restaurants")
 	}
 
+	protoHits := toProtoSearchHits(hits)
+	restaurants := make([]*restaurantv1.Restaurant, len(protoHits))
+	for i, hit := range protoHits {
+		restaurants[i] = hit.Restaurant
+	}
+
 	return &restaurantv1.SearchRestaurantsResponse{
-		Restaurants: toProtoRestaurants(restaurants),
-		Total:       int32(len(restaurants)),
+		Restaurants: restaurants,
+		Total:       int32(total),
+		Hits:        protoHits,
+		NextCursor:  next.Encode(),
 	}, nil
 }
 
-// ListRestaurants lists restaurants with pagination
+// ListRestaurants lists restaurants matching the request criteria with pagination
 func (s *RestaurantServer) ListRestaurants(
 	ctx context.Context,
 	req *restaurantv1.ListRestaurantsRequest,
@@ -204,15 +245,73 @@ func (s *RestaurantServer) ListRestaurants(
 		offset = 0
 	}
 
-	restaurants, err := s.service.ListRestaurants(ctx, limit, offset)
+	filter := repository.RestaurantFilter{
+		Area:          req.Area,
+		CuisineType:   req.CuisineType,
+		Source:        model.RestaurantSource(req.Source),
+		MinRating:     req.MinRating,
+		MinPriceLevel: int(req.MinPriceLevel),
+		MaxPriceLevel: int(req.MaxPriceLevel),
+		OpenNow:       req.OpenNow,
+		Text:          req.Query,
+		Sort:          repository.RestaurantSort(req.Sort),
+	}
+	if req.Near != nil {
+		if req.Near.Location == nil {
+			return nil, status.Error(codes.InvalidArgument, "near requires a location")
+		}
+		filter.Near = &repository.NearPoint{
+			Latitude:  req.Near.Location.Latitude,
+			Longitude: req.Near.Location.Longitude,
+			RadiusKm:  req.Near.RadiusKm,
+		}
+	}
+	if req.OpenAt != nil {
+		openAt := req.OpenAt.AsTime()
+		filter.OpenAt = &openAt
+	}
+
+	var results []*repository.FilteredRestaurant
+	var total int64
+	var next *repository.Cursor
+	var err error
+	if offset > 0 {
+		if req.Cursor != "" {
+			return nil, status.Error(codes.InvalidArgument, "offset and cursor cannot be combined")
+		}
+		results, total, err = s.service.FilterRestaurants(ctx, filter, limit, offset)
+	} else {
+		after, decodeErr := repository.DecodeCursor(req.Cursor)
+		if decodeErr != nil {
+			return nil, status.Error(codes.InvalidArgument, decodeErr.Error())
+		}
+		results, total, next, err = s.service.FilterRestaurantsAfter(ctx, filter, after, limit)
+	}
 	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidLocation),
+			errors.Is(err, domainerrors.ErrInvalidRadius),
+			errors.Is(err, domainerrors.ErrInvalidRating),
+			errors.Is(err, domainerrors.ErrInvalidPriceLevel),
+			errors.Is(err, domainerrors.ErrInvalidSort),
+			errors.Is(err, domainerrors.ErrInvalidCursor):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("Failed to list restaurants", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list restaurants")
 	}
 
+	protoResults := toProtoListedRestaurants(results)
+	restaurants := make([]*restaurantv1.Restaurant, len(protoResults))
+	for i, result := range protoResults {
+		restaurants[i] = result.Restaurant
+	}
+
 	return &restaurantv1.ListRestaurantsResponse{
-		Restaurants: toProtoRestaurants(restaurants),
-		Total:       int32(len(restaurants)),
+		Restaurants: restaurants,
+		Total:       int32(total),
+		Results:     protoResults,
+		NextCursor:  next.Encode(),
 	}, nil
 }
 
@@ -284,14 +383,600 @@ func (s *RestaurantServer) GetUserFavorites(
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
 
-	favorites, err := s.service.GetUserFavorites(ctx, userID)
+	if req.Tag != "" {
+		favorites, err := s.service.GetUserFavoritesByTag(ctx, userID, req.Tag)
+		if err != nil {
+			s.logger.Error("Failed to get user favorites by tag", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to get favorites")
+		}
+
+		return &restaurantv1.GetUserFavoritesResponse{
+			Favorites: toProtoFavorites(favorites),
+			Total:     int32(len(favorites)),
+		}, nil
+	}
+
+	if req.Limit <= 0 && req.Cursor == "" {
+		favorites, err := s.service.GetUserFavorites(ctx, userID)
+		if err != nil {
+			s.logger.Error("Failed to get user favorites", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to get favorites")
+		}
+
+		return &restaurantv1.GetUserFavoritesResponse{
+			Favorites: toProtoFavorites(favorites),
+			Total:     int32(len(favorites)),
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	after, err := repository.DecodeCursor(req.Cursor)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	favorites, next, err := s.service.GetUserFavoritesAfter(ctx, userID, after, limit)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("Failed to get user favorites", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get favorites")
 	}
 
 	return &restaurantv1.GetUserFavoritesResponse{
-		Favorites: toProtoFavorites(favorites),
-		Total:     int32(len(favorites)),
+		Favorites:  toProtoFavorites(favorites),
+		Total:      int32(len(favorites)),
+		NextCursor: next.Encode(),
+	}, nil
+}
+
+// GetFavorite retrieves a user's favorite of a restaurant
+func (s *RestaurantServer) GetFavorite(
+	ctx context.Context,
+	req *restaurantv1.GetFavoriteRequest,
+) (*restaurantv1.GetFavoriteResponse, error) {
+	userID, restaurantID, err := parseFavoriteIDs(req.UserId, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite, err := s.service.GetFavoriteByUserAndRestaurant(ctx, userID, restaurantID)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to get favorite")
+	}
+
+	return &restaurantv1.GetFavoriteResponse{
+		Favorite: toProtoFavorite(favorite),
+	}, nil
+}
+
+// UpdateFavoriteNotes replaces the notes of a favorite
+func (s *RestaurantServer) UpdateFavoriteNotes(
+	ctx context.Context,
+	req *restaurantv1.UpdateFavoriteNotesRequest,
+) (*restaurantv1.FavoriteResponse, error) {
+	userID, restaurantID, err := parseFavoriteIDs(req.UserId, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite, err := s.service.UpdateFavoriteNotes(ctx, userID, restaurantID, req.Notes)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to update favorite notes")
+	}
+
+	return &restaurantv1.FavoriteResponse{
+		Favorite: toProtoFavorite(favorite),
+	}, nil
+}
+
+// AddFavoriteTag adds a tag to a favorite
+func (s *RestaurantServer) AddFavoriteTag(
+	ctx context.Context,
+	req *restaurantv1.AddFavoriteTagRequest,
+) (*restaurantv1.FavoriteResponse, error) {
+	userID, restaurantID, err := parseFavoriteIDs(req.UserId, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag is required")
+	}
+
+	favorite, err := s.service.AddFavoriteTag(ctx, userID, restaurantID, req.Tag)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to add favorite tag")
+	}
+
+	return &restaurantv1.FavoriteResponse{
+		Favorite: toProtoFavorite(favorite),
+	}, nil
+}
+
+// RemoveFavoriteTag removes a tag from a favorite
+func (s *RestaurantServer) RemoveFavoriteTag(
+	ctx context.Context,
+	req *restaurantv1.RemoveFavoriteTagRequest,
+) (*restaurantv1.FavoriteResponse, error) {
+	userID, restaurantID, err := parseFavoriteIDs(req.UserId, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite, err := s.service.RemoveFavoriteTag(ctx, userID, restaurantID, req.Tag)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to remove favorite tag")
+	}
+
+	return &restaurantv1.FavoriteResponse{
+		Favorite: toProtoFavorite(favorite),
+	}, nil
+}
+
+// AddFavoriteVisit records a visit today to a favorite restaurant in the
+// user's visit history
+func (s *RestaurantServer) AddFavoriteVisit(
+	ctx context.Context,
+	req *restaurantv1.AddFavoriteVisitRequest,
+) (*restaurantv1.FavoriteResponse, error) {
+	userID, restaurantID, err := parseFavoriteIDs(req.UserId, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite, err := s.visitService.LogFavoriteVisit(ctx, userID, restaurantID)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to record visit")
+	}
+
+	return &restaurantv1.FavoriteResponse{
+		Favorite: toProtoFavorite(favorite),
+	}, nil
+}
+
+func parseFavoriteIDs(userIDStr, restaurantIDStr string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid restaurant ID")
+	}
+
+	return userID, restaurantID, nil
+}
+
+// favoriteError maps favorite and collection errors to gRPC statuses;
+// unknown errors are logged and reported with message
+func (s *RestaurantServer) favoriteError(err error, message string) error {
+	switch {
+	case errors.Is(err, domainerrors.ErrFavoriteNotFound),
+		errors.Is(err, domainerrors.ErrRestaurantNotFound),
+		errors.Is(err, domainerrors.ErrCollectionNotFound),
+		errors.Is(err, domainerrors.ErrNotInCollection):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domainerrors.ErrFavoriteAlreadyExists),
+		errors.Is(err, domainerrors.ErrCollectionAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domainerrors.ErrInvalidCollectionName),
+		errors.Is(err, domainerrors.ErrInvalidCollectionOrder):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.Error(message, zap.Error(err))
+		return status.Error(codes.Internal, message)
+	}
+}
+
+// CreateCollection creates a named collection of a user's favorites
+func (s *RestaurantServer) CreateCollection(
+	ctx context.Context,
+	req *restaurantv1.CreateCollectionRequest,
+) (*restaurantv1.CollectionResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	collection, err := s.collectionService.CreateCollection(ctx, userID, req.Name, req.Description)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to create collection")
+	}
+
+	return &restaurantv1.CollectionResponse{
+		Collection: toProtoCollection(collection),
+	}, nil
+}
+
+// ListCollections lists a user's collections
+func (s *RestaurantServer) ListCollections(
+	ctx context.Context,
+	req *restaurantv1.ListCollectionsRequest,
+) (*restaurantv1.ListCollectionsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	collections, err := s.collectionService.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to list collections")
+	}
+
+	return &restaurantv1.ListCollectionsResponse{
+		Collections: toProtoCollections(collections),
+		Total:       int32(len(collections)),
+	}, nil
+}
+
+// GetCollection retrieves a collection with its favorites in order
+func (s *RestaurantServer) GetCollection(
+	ctx context.Context,
+	req *restaurantv1.GetCollectionRequest,
+) (*restaurantv1.CollectionDetailResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := s.collectionService.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to get collection")
+	}
+
+	return toProtoCollectionDetail(detail), nil
+}
+
+// UpdateCollection renames a collection or changes its description
+func (s *RestaurantServer) UpdateCollection(
+	ctx context.Context,
+	req *restaurantv1.UpdateCollectionRequest,
+) (*restaurantv1.CollectionResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := s.collectionService.UpdateCollection(ctx, userID, collectionID, application.UpdateCollectionRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to update collection")
+	}
+
+	return &restaurantv1.CollectionResponse{
+		Collection: toProtoCollection(collection),
+	}, nil
+}
+
+// DeleteCollection deletes a collection; its favorites are kept
+func (s *RestaurantServer) DeleteCollection(
+	ctx context.Context,
+	req *restaurantv1.DeleteCollectionRequest,
+) (*restaurantv1.DeleteCollectionResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionService.DeleteCollection(ctx, userID, collectionID); err != nil {
+		return nil, s.favoriteError(err, "failed to delete collection")
+	}
+
+	return &restaurantv1.DeleteCollectionResponse{
+		Success: true,
+	}, nil
+}
+
+// AddToCollection appends one of the user's favorite restaurants to a collection
+func (s *RestaurantServer) AddToCollection(
+	ctx context.Context,
+	req *restaurantv1.AddToCollectionRequest,
+) (*restaurantv1.CollectionDetailResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+	restaurantID, err := uuid.Parse(req.RestaurantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid restaurant ID")
+	}
+
+	detail, err := s.collectionService.AddToCollection(ctx, userID, collectionID, restaurantID)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to add to collection")
+	}
+
+	return toProtoCollectionDetail(detail), nil
+}
+
+// RemoveFromCollection removes a restaurant from a collection
+func (s *RestaurantServer) RemoveFromCollection(
+	ctx context.Context,
+	req *restaurantv1.RemoveFromCollectionRequest,
+) (*restaurantv1.RemoveFromCollectionResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+	restaurantID, err := uuid.Parse(req.RestaurantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid restaurant ID")
+	}
+
+	if err := s.collectionService.RemoveFromCollection(ctx, userID, collectionID, restaurantID); err != nil {
+		return nil, s.favoriteError(err, "failed to remove from collection")
+	}
+
+	return &restaurantv1.RemoveFromCollectionResponse{
+		Success: true,
 	}, nil
 }
+
+// ReorderCollection sets the order of a collection
+func (s *RestaurantServer) ReorderCollection(
+	ctx context.Context,
+	req *restaurantv1.ReorderCollectionRequest,
+) (*restaurantv1.CollectionDetailResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	restaurantIDs := make([]uuid.UUID, len(req.RestaurantIds))
+	for i, idStr := range req.RestaurantIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid restaurant ID: %s", idStr)
+		}
+		restaurantIDs[i] = id
+	}
+
+	detail, err := s.collectionService.ReorderCollection(ctx, userID, collectionID, restaurantIDs)
+	if err != nil {
+		return nil, s.favoriteError(err, "failed to reorder collection")
+	}
+
+	return toProtoCollectionDetail(detail), nil
+}
+
+func parseCollectionIDs(userIDStr, collectionIDStr string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	collectionID, err := uuid.Parse(collectionIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid collection ID")
+	}
+
+	return userID, collectionID, nil
+}
+
+// ListDuplicateCandidates lists restaurant pairs that likely describe the same place
+func (s *RestaurantServer) ListDuplicateCandidates(
+	ctx context.Context,
+	req *restaurantv1.ListDuplicateCandidatesRequest,
+) (*restaurantv1.ListDuplicateCandidatesResponse, error) {
+	var restaurantID *uuid.UUID
+	if req.RestaurantId != "" {
+		id, err := uuid.Parse(req.RestaurantId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid restaurant ID")
+		}
+		restaurantID = &id
+	}
+
+	minScore := dedup.DefaultMinScore
+	if req.MinScore != nil {
+		minScore = *req.MinScore
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+
+	candidates, err := s.mergeService.FindDuplicateCandidates(ctx, restaurantID, minScore, limit)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidMinScore) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error("Failed to list duplicate candidates", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list duplicate candidates")
+	}
+
+	return &restaurantv1.ListDuplicateCandidatesResponse{
+		Candidates: toProtoDuplicateCandidates(candidates),
+		Total:      int32(len(candidates)),
+	}, nil
+}
+
+// MergeRestaurants merges a duplicate restaurant into a canonical one
+func (s *RestaurantServer) MergeRestaurants(
+	ctx context.Context,
+	req *restaurantv1.MergeRestaurantsRequest,
+) (*restaurantv1.MergeRestaurantsResponse, error) {
+	canonicalID, err := uuid.Parse(req.CanonicalId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canonical restaurant ID")
+	}
+	duplicateID, err := uuid.Parse(req.DuplicateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid duplicate restaurant ID")
+	}
+
+	merge, err := s.mergeService.MergeRestaurants(ctx, canonicalID, duplicateID, req.MergedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMergeSelf):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			return nil, status.Error(codes.NotFound, "restaurant not found")
+		}
+		s.logger.Error("Failed to merge restaurants", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to merge restaurants")
+	}
+
+	return &restaurantv1.MergeRestaurantsResponse{
+		Merge: toProtoRestaurantMerge(merge),
+	}, nil
+}
+
+// UnmergeRestaurants undoes a restaurant merge
+func (s *RestaurantServer) UnmergeRestaurants(
+	ctx context.Context,
+	req *restaurantv1.UnmergeRestaurantsRequest,
+) (*restaurantv1.UnmergeRestaurantsResponse, error) {
+	mergeID, err := uuid.Parse(req.MergeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid merge ID")
+	}
+
+	merge, err := s.mergeService.UnmergeRestaurants(ctx, mergeID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMergeNotFound):
+			return nil, status.Error(codes.NotFound, "merge not found")
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			return nil, status.Error(codes.NotFound, "merged restaurant no longer exists")
+		case errors.Is(err, domainerrors.ErrMergeAlreadyUndone):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to unmerge restaurants", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to unmerge restaurants")
+	}
+
+	return &restaurantv1.UnmergeRestaurantsResponse{
+		Merge: toProtoRestaurantMerge(merge),
+	}, nil
+}
+
+// BatchGetRestaurants looks up restaurants by ID and by external identity in one call
+func (s *RestaurantServer) BatchGetRestaurants(
+	ctx context.Context,
+	req *restaurantv1.BatchGetRestaurantsRequest,
+) (*restaurantv1.BatchGetRestaurantsResponse, error) {
+	ids := make([]uuid.UUID, len(req.Ids))
+	for i, raw := range req.Ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid restaurant ID %q", raw)
+		}
+		ids[i] = id
+	}
+	refs := make([]repository.ExternalRef, len(req.ExternalRefs))
+	for i, ref := range req.ExternalRefs {
+		refs[i] = fromProtoExternalRef(ref)
+	}
+
+	byID, byRef, err := s.catalogService.BatchGet(ctx, ids, refs)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrBatchTooLarge) {
+			return nil, status.Errorf(codes.InvalidArgument, "at most %d lookups per batch", application.MaxBatchSize)
+		}
+		s.logger.Error("Failed to batch get restaurants", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get restaurants")
+	}
+
+	resp := &restaurantv1.BatchGetRestaurantsResponse{
+		Restaurants: []*restaurantv1.Restaurant{},
+	}
+	added := make(map[uuid.UUID]bool, len(ids)+len(refs))
+	add := func(r *model.Restaurant) {
+		if !added[r.ID()] {
+			added[r.ID()] = true
+			resp.Restaurants = append(resp.Restaurants, toProtoRestaurant(r))
+		}
+	}
+	for i, id := range ids {
+		if r := byID[id]; r != nil {
+			add(r)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, req.Ids[i])
+		}
+	}
+	for _, ref := range refs {
+		if r := byRef[ref]; r != nil {
+			add(r)
+		} else {
+			resp.MissingExternalRefs = append(resp.MissingExternalRefs, toProtoExternalRef(ref))
+		}
+	}
+
+	return resp, nil
+}
+
+// BatchUpsertRestaurants creates or updates restaurants, reporting the outcome of each
+func (s *RestaurantServer) BatchUpsertRestaurants(
+	ctx context.Context,
+	req *restaurantv1.BatchUpsertRestaurantsRequest,
+) (*restaurantv1.BatchUpsertRestaurantsResponse, error) {
+	records := make([]*application.CatalogRecord, len(req.Items))
+	for i, item := range req.Items {
+		records[i] = fromProtoUpsertItem(item)
+	}
+
+	results, err := s.catalogService.BatchUpsert(ctx, records, application.ImportOptions{
+		DryRun:  req.DryRun,
+		ActorID: req.ActorId,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrBatchTooLarge) {
+			return nil, status.Errorf(codes.InvalidArgument, "at most %d items per batch", application.MaxBatchSize)
+		}
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		s.logger.Error("Failed to batch upsert restaurants", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to upsert restaurants")
+	}
+
+	return &restaurantv1.BatchUpsertRestaurantsResponse{
+		Results: toProtoUpsertResults(results),
+	}, nil
+}
+
+// StreamRestaurants streams every restaurant matching the request criteria
+func (s *RestaurantServer) StreamRestaurants(
+	req *restaurantv1.StreamRestaurantsRequest,
+	stream restaurantv1.RestaurantService_StreamRestaurantsServer,
+) error {
+	filter := application.ExportFilter{
+		Area:        req.Area,
+		CuisineType: req.CuisineType,
+		Source:      model.RestaurantSource(req.Source),
+	}
+	if req.UpdatedSince != nil {
+		updatedSince := req.UpdatedSince.AsTime()
+		filter.UpdatedSince = &updatedSince
+	}
+
+	ctx := stream.Context()
+	var sendErr error
+	_, err := s.catalogService.Stream(ctx, filter, func(r *model.Restaurant) error {
+		sendErr = stream.Send(&restaurantv1.StreamRestaurantsResponse{
+			Restaurant: toProtoRestaurant(r),
+		})
+		return sendErr
+	})
+	if err != nil {
+		// A failed send means the client went away; its status is already final
+		if sendErr != nil {
+			return sendErr
+		}
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		s.logger.Error("Failed to stream restaurants", zap.Error(err))
+		return status.Error(codes.Internal, "failed to stream restaurants")
+	}
+
+	return nil
+}
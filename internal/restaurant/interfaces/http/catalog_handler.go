@@ -0,0 +1,141 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxImportBytes bounds the size of an uploaded import file
+const maxImportBytes = 256 << 20
+
+// CatalogHandler handles bulk restaurant import and export
+type CatalogHandler struct {
+	service application.CatalogService
+	logger  *zap.Logger
+}
+
+func NewCatalogHandler(service application.CatalogService, logger *zap.Logger) *CatalogHandler {
+	return &CatalogHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ImportRestaurants godoc
+// @Summary Import restaurants
+// @Description Upsert restaurants from a CSV, JSON Lines or GeoJSON file sent as the request body, matching
+// @Description existing restaurants on (source, external_id). CSV files need a header with at least the
+// @Description source, external_id, name, latitude and longitude columns. Invalid rows are skipped and
+// @Description reported; with dry_run nothing is saved (admin only).
+// @Tags admin
+// @Accept text/csv,application/x-ndjson,application/geo+json
+// @Produce json
+// @Param format query string true "File format" Enums(csv, jsonl, geojson)
+// @Param dry_run query bool false "Only validate and report" default(false)
+// @Success 200 {object} ImportReportResponse
+// @Failure 400 {object} ImportFailedResponse
+// @Failure 413 {object} ImportFailedResponse
+// @Failure 500 {object} ImportFailedResponse
+// @Router /admin/restaurants/import [post]
+func (h *CatalogHandler) ImportRestaurants(c *gin.Context) {
+	format, err := application.ParseCatalogFormat(c.Query("format"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_format",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: "dry_run must be a boolean",
+		})
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBytes)
+
+	report, err := h.service.Import(c.Request.Context(), format, body, application.ImportOptions{
+		DryRun:  dryRun,
+		ActorID: actorID,
+	})
+	if err != nil {
+		var resp ImportFailedResponse
+		status := http.StatusInternalServerError
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			status = http.StatusRequestEntityTooLarge
+			resp.Error = "file_too_large"
+			resp.Message = fmt.Sprintf("import files are limited to %d MB", maxImportBytes>>20)
+		case errors.Is(err, domainerrors.ErrInvalidImportFile):
+			status = http.StatusBadRequest
+			resp.Error = "invalid_import_file"
+			resp.Message = err.Error()
+		default:
+			h.logger.Error("Failed to import restaurants", zap.Error(err))
+			resp.Error = "internal_error"
+			resp.Message = "Failed to import restaurants"
+		}
+		// Rows before the failure are already saved, so report them
+		if report != nil && report.Total > 0 {
+			partial := toImportReportResponse(report)
+			resp.Report = &partial
+		}
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, toImportReportResponse(report))
+}
+
+// ExportRestaurants godoc
+// @Summary Export restaurants
+// @Description Download restaurants as a CSV, JSON Lines or GeoJSON FeatureCollection file, optionally
+// @Description filtered by area or cuisine. The file is streamed, newest restaurants first (admin only).
+// @Tags admin
+// @Produce text/csv,application/x-ndjson,application/geo+json
+// @Param format query string false "File format" Enums(csv, jsonl, geojson) default(csv)
+// @Param area query string false "Filter by area"
+// @Param cuisine_type query string false "Filter by cuisine type"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/restaurants/export [get]
+func (h *CatalogHandler) ExportRestaurants(c *gin.Context) {
+	format, err := application.ParseCatalogFormat(c.DefaultQuery("format", string(application.CatalogFormatCSV)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_format",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	filter := application.ExportFilter{
+		Area:        c.Query("area"),
+		CuisineType: c.Query("cuisine_type"),
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="restaurants.%s"`, format))
+	c.Status(http.StatusOK)
+
+	if _, err := h.service.Export(c.Request.Context(), format, c.Writer, filter); err != nil {
+		// The response has started, so the status can no longer change;
+		// the client sees a truncated file
+		h.logger.Error("Failed to export restaurants", zap.Error(err))
+		c.Abort()
+	}
+}
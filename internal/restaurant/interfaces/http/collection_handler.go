@@ -0,0 +1,511 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CollectionHandler serves users' named collections of favorites
+type CollectionHandler struct {
+	service application.CollectionService
+	logger  *zap.Logger
+}
+
+func NewCollectionHandler(service application.CollectionService, logger *zap.Logger) *CollectionHandler {
+	return &CollectionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListCollections godoc
+// @Summary List collections
+// @Description List the user's collections, oldest first
+// @Tags collections
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} CollectionListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections [get]
+func (h *CollectionHandler) ListCollections(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	collections, err := h.service.ListCollections(c.Request.Context(), userID)
+	if err != nil {
+		h.writeError(c, err, "Failed to list collections")
+		return
+	}
+
+	c.JSON(http.StatusOK, CollectionListResponse{
+		Collections: toCollectionDTOList(collections),
+		Total:       len(collections),
+	})
+}
+
+// CreateCollection godoc
+// @Summary Create a collection
+// @Description Create a named collection of favorites, such as "Osaka trip". Names are unique per user, ignoring case.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param request body CreateCollectionRequest true "Collection"
+// @Success 201 {object} CollectionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections [post]
+func (h *CollectionHandler) CreateCollection(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req CreateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	collection, err := h.service.CreateCollection(c.Request.Context(), userID, req.Name, req.Description)
+	if err != nil {
+		h.writeError(c, err, "Failed to create collection")
+		return
+	}
+
+	c.JSON(http.StatusCreated, CollectionResponse{
+		Collection: toCollectionDTO(collection),
+	})
+}
+
+// GetCollection godoc
+// @Summary Get a collection
+// @Description Get a collection with its favorites in order
+// @Tags collections
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Success 200 {object} CollectionDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId} [get]
+func (h *CollectionHandler) GetCollection(c *gin.Context) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	detail, err := h.service.GetCollection(c.Request.Context(), userID, collectionID)
+	if err != nil {
+		h.writeError(c, err, "Failed to get collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, toCollectionDetailResponse(detail))
+}
+
+// UpdateCollection godoc
+// @Summary Update a collection
+// @Description Rename a collection or change its description; fields left out are kept
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Param request body UpdateCollectionRequest true "Changes"
+// @Success 200 {object} CollectionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId} [patch]
+func (h *CollectionHandler) UpdateCollection(c *gin.Context) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	collection, err := h.service.UpdateCollection(c.Request.Context(), userID, collectionID, application.UpdateCollectionRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.writeError(c, err, "Failed to update collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, CollectionResponse{
+		Collection: toCollectionDTO(collection),
+	})
+}
+
+// DeleteCollection godoc
+// @Summary Delete a collection
+// @Description Delete a collection; its favorites are kept
+// @Tags collections
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId} [delete]
+func (h *CollectionHandler) DeleteCollection(c *gin.Context) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCollection(c.Request.Context(), userID, collectionID); err != nil {
+		h.writeError(c, err, "Failed to delete collection")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AddCollectionItem godoc
+// @Summary Add a favorite to a collection
+// @Description Append one of the user's favorite restaurants to the end of a collection.
+// @Description Adding a restaurant already in the collection changes nothing.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Param request body AddCollectionItemRequest true "Restaurant to add"
+// @Success 200 {object} CollectionDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId}/items [post]
+func (h *CollectionHandler) AddCollectionItem(c *gin.Context) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	var req AddCollectionItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	restaurantID, err := uuid.Parse(req.RestaurantID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_restaurant_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	detail, err := h.service.AddToCollection(c.Request.Context(), userID, collectionID, restaurantID)
+	if err != nil {
+		h.writeError(c, err, "Failed to add to collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, toCollectionDetailResponse(detail))
+}
+
+// RemoveCollectionItem godoc
+// @Summary Remove a favorite from a collection
+// @Description Remove a restaurant from a collection; it stays among the user's favorites
+// @Tags collections
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId}/items/{restaurantId} [delete]
+func (h *CollectionHandler) RemoveCollectionItem(c *gin.Context) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	restaurantID, err := uuid.Parse(c.Param("restaurantId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_restaurant_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	if err := h.service.RemoveFromCollection(c.Request.Context(), userID, collectionID, restaurantID); err != nil {
+		h.writeError(c, err, "Failed to remove from collection")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReorderCollection godoc
+// @Summary Reorder a collection
+// @Description Set the order of a collection. restaurant_ids must list every restaurant in the collection once.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Param request body ReorderCollectionRequest true "New order"
+// @Success 200 {object} CollectionDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId}/items/order [put]
+func (h *CollectionHandler) ReorderCollection(c *gin.Context) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	var req ReorderCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	restaurantIDs := make([]uuid.UUID, len(req.RestaurantIDs))
+	for i, s := range req.RestaurantIDs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_restaurant_id",
+				Message: "Invalid restaurant ID: " + s,
+			})
+			return
+		}
+		restaurantIDs[i] = id
+	}
+
+	detail, err := h.service.ReorderCollection(c.Request.Context(), userID, collectionID, restaurantIDs)
+	if err != nil {
+		h.writeError(c, err, "Failed to reorder collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, toCollectionDetailResponse(detail))
+}
+
+// ShareCollection godoc
+// @Summary Share a collection
+// @Description Publish a collection as a read-only public list at /lists/{share_slug}. The slug is random and cannot
+// @Description be guessed. Sharing a shared collection keeps its slug.
+// @Tags collections
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Success 200 {object} CollectionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId}/share [post]
+func (h *CollectionHandler) ShareCollection(c *gin.Context) {
+	h.changeSharing(c, h.service.ShareCollection, "Failed to share collection")
+}
+
+// RotateShareSlug godoc
+// @Summary Rotate a share link
+// @Description Give a shared collection a new slug; links with the old slug stop working
+// @Tags collections
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Success 200 {object} CollectionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId}/share/rotate [post]
+func (h *CollectionHandler) RotateShareSlug(c *gin.Context) {
+	h.changeSharing(c, h.service.RotateShareSlug, "Failed to rotate share link")
+}
+
+// UnshareCollection godoc
+// @Summary Stop sharing a collection
+// @Description Revoke the public list of a collection
+// @Tags collections
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param collectionId path string true "Collection ID"
+// @Success 200 {object} CollectionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/collections/{collectionId}/share [delete]
+func (h *CollectionHandler) UnshareCollection(c *gin.Context) {
+	h.changeSharing(c, h.service.UnshareCollection, "Failed to stop sharing collection")
+}
+
+type sharingFunc func(ctx context.Context, userID, collectionID uuid.UUID) (*model.Collection, error)
+
+func (h *CollectionHandler) changeSharing(c *gin.Context, change sharingFunc, message string) {
+	userID, collectionID, ok := parseCollectionPath(c)
+	if !ok {
+		return
+	}
+
+	collection, err := change(c.Request.Context(), userID, collectionID)
+	if err != nil {
+		h.writeError(c, err, message)
+		return
+	}
+
+	c.JSON(http.StatusOK, CollectionResponse{
+		Collection: toCollectionDTO(collection),
+	})
+}
+
+// GetSharedList godoc
+// @Summary Get a shared list
+// @Description Get a collection shared as a public list, with the full data of its restaurants in order.
+// @Description No authentication is needed; signed in owners get is_owner set.
+// @Tags collections
+// @Produce json
+// @Param slug path string true "Share slug"
+// @Success 200 {object} SharedListResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /lists/{slug} [get]
+func (h *CollectionHandler) GetSharedList(c *gin.Context) {
+	list, err := h.service.GetSharedList(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.writeError(c, err, "Failed to get shared list")
+		return
+	}
+
+	viewerID, _ := middleware.GetUserID(c)
+	isOwner := viewerID != "" && strings.EqualFold(viewerID, list.Collection.UserID().String())
+
+	c.JSON(http.StatusOK, toSharedListResponse(list, isOwner))
+}
+
+// parseUserIDParam parses the userId path parameter, writing a 400 response
+// when it is invalid
+func parseUserIDParam(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_user_id",
+			Message: "Invalid user ID",
+		})
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// parseCollectionPath parses the userId and collectionId path parameters,
+// writing a 400 response when either is invalid
+func parseCollectionPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	collectionID, err := uuid.Parse(c.Param("collectionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid collection ID",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, collectionID, true
+}
+
+func (h *CollectionHandler) writeError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidCollectionName),
+		errors.Is(err, domainerrors.ErrInvalidCollectionOrder):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrCollectionNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Collection not found",
+		})
+	case errors.Is(err, domainerrors.ErrFavoriteNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Restaurant is not a favorite",
+		})
+	case errors.Is(err, domainerrors.ErrNotInCollection):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrSharedListNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Shared list not found",
+		})
+	case errors.Is(err, domainerrors.ErrCollectionNotShared):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error:   "not_shared",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrCollectionAlreadyExists):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error:   "already_exists",
+			Message: err.Error(),
+		})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DeletedRestaurantHandler serves the admin endpoints for deleted restaurants
+type DeletedRestaurantHandler struct {
+	service application.DeletedRestaurantService
+	logger  *zap.Logger
+}
+
+func NewDeletedRestaurantHandler(service application.DeletedRestaurantService, logger *zap.Logger) *DeletedRestaurantHandler {
+	return &DeletedRestaurantHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListDeletedRestaurants godoc
+// @Summary List deleted restaurants
+// @Description List soft-deleted restaurants, most recently deleted first, with the time each one is purged (admin only).
+// @Description Restaurants merged into another one are left out; they come back by undoing the merge.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} DeletedRestaurantListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/deleted [get]
+func (h *DeletedRestaurantHandler) ListDeletedRestaurants(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_offset",
+			Message: "offset must not be negative",
+		})
+		return
+	}
+
+	deleted, total, err := h.service.ListDeletedRestaurants(c.Request.Context(), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to list deleted restaurants",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toDeletedRestaurantListResponse(deleted, total))
+}
+
+// RestoreRestaurant godoc
+// @Summary Restore a deleted restaurant
+// @Description Undo the deletion of a restaurant and of the favorites deleted with it (admin only).
+// @Description Favorites their users removed stay removed. Fails with 409 when the restaurant
+// @Description is merged, or when a live restaurant has taken its source and external ID.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} RestaurantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/{id}/restore [post]
+func (h *DeletedRestaurantHandler) RestoreRestaurant(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	restaurant, err := h.service.RestoreRestaurant(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotDeleted):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "not_deleted",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantMerged):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "restaurant_merged",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantReplaced):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "restaurant_replaced",
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to restore restaurant",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(restaurant),
+	})
+}
@@ -3,7 +3,9 @@ package http
 import (
 	"time"
 
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 )
 
 // Request DTOs
@@ -33,6 +35,115 @@ type AddFavoriteRequest struct {
 	RestaurantID string `json:"restaurant_id" binding:"required"`
 }
 
+// AddUserFavoriteRequest adds a restaurant to the favorites of the user in the path
+type AddUserFavoriteRequest struct {
+	RestaurantID string `json:"restaurant_id" binding:"required"`
+}
+
+// UpdateFavoriteNotesRequest replaces the notes of a favorite
+type UpdateFavoriteNotesRequest struct {
+	Notes string `json:"notes" binding:"max=2000"`
+}
+
+// AddFavoriteTagRequest adds a tag to a favorite
+type AddFavoriteTagRequest struct {
+	Tag string `json:"tag" binding:"required,max=50"`
+}
+
+// CreateCollectionRequest creates a named collection of favorites
+type CreateCollectionRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description" binding:"max=1000"`
+}
+
+// UpdateCollectionRequest changes the fields that are set
+type UpdateCollectionRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+}
+
+// AddCollectionItemRequest adds one of the user's favorite restaurants to a collection
+type AddCollectionItemRequest struct {
+	RestaurantID string `json:"restaurant_id" binding:"required"`
+}
+
+// ReorderCollectionRequest lists every restaurant in the collection in the new order
+type ReorderCollectionRequest struct {
+	RestaurantIDs []string `json:"restaurant_ids" binding:"required"`
+}
+
+// MergeRestaurantsRequest merges duplicate_id into canonical_id
+type MergeRestaurantsRequest struct {
+	CanonicalID string `json:"canonical_id" binding:"required"`
+	DuplicateID string `json:"duplicate_id" binding:"required"`
+}
+
+// ConfirmTabelogListingRequest names the Tabelog listing chosen for a restaurant
+type ConfirmTabelogListingRequest struct {
+	Link string `json:"link" binding:"required"`
+}
+
+// TriggerRefreshRequest starts a refresh run of stale restaurants
+type TriggerRefreshRequest struct {
+	DryRun bool `json:"dry_run"`
+	Limit  int  `json:"limit" binding:"min=0"`
+}
+
+// ReviewSuggestionRequest approves or rejects an edit suggestion with an optional note
+type ReviewSuggestionRequest struct {
+	Note string `json:"note" binding:"max=1000"`
+}
+
+// IngestPhotosRequest lists the Tabelog images to store along with the restaurant's Google photos
+type IngestPhotosRequest struct {
+	TabelogURLs []string `json:"tabelog_urls" binding:"max=50"`
+}
+
+// LogVisitRequest records a visit of a restaurant
+type LogVisitRequest struct {
+	RestaurantID string `json:"restaurant_id" binding:"required"`
+	VisitDetailsRequest
+}
+
+// VisitDetailsRequest is what a user records about a visit. visited_on is a
+// date such as 2026-03-14; a rating of 0 or an omitted spend leave them
+// unrecorded.
+type VisitDetailsRequest struct {
+	VisitedOn string    `json:"visited_on" binding:"required"`
+	PartySize int       `json:"party_size"`
+	Rating    int       `json:"rating"`
+	Dishes    []string  `json:"dishes"`
+	Spend     *MoneyDTO `json:"spend"`
+	PhotoURLs []string  `json:"photo_urls"`
+}
+
+// ReviewRequest is a user's review of a restaurant. language is a BCP 47 tag
+// such as ja or en-US and may be left out.
+type ReviewRequest struct {
+	Score    int    `json:"score" binding:"required"`
+	Text     string `json:"text"`
+	Language string `json:"language"`
+}
+
+// ModerateReviewRequest hides or restores a review with an optional note
+type ModerateReviewRequest struct {
+	Note string `json:"note" binding:"max=1000"`
+}
+
+// LocalizationRequest is a restaurant's text in one language; empty fields
+// fall back to other languages
+type LocalizationRequest struct {
+	Name         string `json:"name" binding:"max=255"`
+	Address      string `json:"address" binding:"max=500"`
+	CuisineLabel string `json:"cuisine_label" binding:"max=100"`
+	Summary      string `json:"summary" binding:"max=2000"`
+}
+
+// SetCuisinesRequest replaces a restaurant's cuisine categories
+type SetCuisinesRequest struct {
+	Cuisines []string `json:"cuisines" binding:"max=20"`
+}
+
 // Response DTOs
 
 type ErrorResponse struct {
@@ -52,14 +163,94 @@ type RestaurantDTO struct {
 	Longitude    float64                `json:"longitude"`
 	Rating       float64                `json:"rating"`
 	PriceRange   string                 `json:"price_range"`
+	Price        *PriceDTO              `json:"price,omitempty"`
 	CuisineType  string                 `json:"cuisine_type"`
+	Cuisines     []string               `json:"cuisines"` // category IDs, see /cuisines
 	Phone        string                 `json:"phone"`
 	Website      string                 `json:"website"`
 	OpeningHours map[string]string      `json:"opening_hours,omitempty"`
 	Metadata     map[string]interface{} `json:"metadata,omitempty"`
 	ViewCount    int64                  `json:"view_count"`
+	DistanceKm   *float64               `json:"distance_km,omitempty"`
+	Score        *float64               `json:"score,omitempty"`
+	Tabelog      *TabelogProfileDTO     `json:"tabelog,omitempty"`
 	CreatedAt    time.Time              `json:"created_at"`
 	UpdatedAt    time.Time              `json:"updated_at"`
+	// Rating from our users' visible reviews, separate from the source rating
+	ReviewRating float64 `json:"review_rating"`
+	ReviewCount  int     `json:"review_count"`
+	// Structured opening hours; is_open_at is evaluated at open_at when
+	// given and at the time of the request otherwise
+	OpeningSchedule *OpeningScheduleDTO `json:"opening_schedule,omitempty"`
+	IsOpenAt        *bool               `json:"is_open_at,omitempty"`
+	// Stored text per language, and the text in the request's languages
+	// with fallbacks applied
+	Localizations map[string]LocalizedContentDTO `json:"localizations,omitempty"`
+	Localized     *LocalizedContentDTO           `json:"localized,omitempty"`
+}
+
+// CuisineDTO is a category of the cuisine taxonomy. Label is in the
+// request's content language; labels has every language.
+type CuisineDTO struct {
+	ID     string            `json:"id"`
+	Parent string            `json:"parent,omitempty"`
+	Label  string            `json:"label"`
+	Labels map[string]string `json:"labels"`
+}
+
+// CuisineListResponse is the cuisine taxonomy, parents before their children
+type CuisineListResponse struct {
+	Cuisines []CuisineDTO `json:"cuisines"`
+}
+
+// PriceDTO is a restaurant's normalized price. The bands are per person in
+// yen; display has them in the currency the request asked for.
+type PriceDTO struct {
+	Level   int              `json:"level,omitempty"` // 1 ("$") to 4 ("$$$$")
+	Text    string           `json:"text,omitempty"`  // the source's original text
+	Lunch   *PriceBandDTO    `json:"lunch,omitempty"`
+	Dinner  *PriceBandDTO    `json:"dinner,omitempty"`
+	Display *DisplayPriceDTO `json:"display,omitempty"`
+}
+
+// PriceBandDTO is a per-person price range; max is omitted when it has no upper bound
+type PriceBandDTO struct {
+	Min float64  `json:"min"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+// DisplayPriceDTO is a price's bands converted to another currency
+type DisplayPriceDTO struct {
+	Currency string        `json:"currency"`
+	Lunch    *PriceBandDTO `json:"lunch,omitempty"`
+	Dinner   *PriceBandDTO `json:"dinner,omitempty"`
+}
+
+// LocalizedContentDTO is a restaurant's display text in one language
+type LocalizedContentDTO struct {
+	Language     string `json:"language,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Address      string `json:"address,omitempty"`
+	CuisineLabel string `json:"cuisine_label,omitempty"`
+	Summary      string `json:"summary,omitempty"`
+}
+
+// OpeningScheduleDTO is a restaurant's weekly opening periods in Asia/Tokyo time
+type OpeningScheduleDTO struct {
+	Periods  []model.OpeningPeriod `json:"periods"`
+	Holidays []string              `json:"holidays"`
+}
+
+// TabelogProfileDTO is the Tabelog listing matched to a restaurant
+type TabelogProfileDTO struct {
+	URL         string    `json:"url"`
+	Rating      float64   `json:"rating"`
+	ReviewCount int       `json:"review_count"`
+	Bookmarks   int       `json:"bookmarks"`
+	Genres      []string  `json:"genres"`
+	MatchScore  float64   `json:"match_score"`
+	Confirmed   bool      `json:"confirmed"`
+	SyncedAt    time.Time `json:"synced_at"`
 }
 
 type RestaurantResponse struct {
@@ -69,6 +260,7 @@ type RestaurantResponse struct {
 type RestaurantListResponse struct {
 	Restaurants []RestaurantDTO `json:"restaurants"`
 	Total       int             `json:"total"`
+	NextCursor  string          `json:"next_cursor,omitempty"`
 }
 
 type FavoriteDTO struct {
@@ -87,8 +279,402 @@ type FavoriteResponse struct {
 }
 
 type FavoriteListResponse struct {
-	Favorites []FavoriteDTO `json:"favorites"`
-	Total     int           `json:"total"`
+	Favorites  []FavoriteDTO `json:"favorites"`
+	Total      int           `json:"total"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// DuplicateCandidateDTO is a pair of restaurants that may be the same place.
+// phone_score and location_score are omitted when either restaurant lacks the data.
+type DuplicateCandidateDTO struct {
+	A             RestaurantDTO `json:"a"`
+	B             RestaurantDTO `json:"b"`
+	Score         float64       `json:"score"`
+	NameScore     float64       `json:"name_score"`
+	PhoneScore    *float64      `json:"phone_score,omitempty"`
+	LocationScore *float64      `json:"location_score,omitempty"`
+	DistanceKm    *float64      `json:"distance_km,omitempty"`
+}
+
+type DuplicateCandidateListResponse struct {
+	Candidates []DuplicateCandidateDTO `json:"candidates"`
+	Total      int                     `json:"total"`
+}
+
+// RestaurantMergeDTO is a merge of merged_id into canonical_id. source and
+// external_id are the identity of the merged restaurant.
+type RestaurantMergeDTO struct {
+	ID               string     `json:"id"`
+	CanonicalID      string     `json:"canonical_id"`
+	MergedID         string     `json:"merged_id"`
+	Source           string     `json:"source"`
+	ExternalID       string     `json:"external_id"`
+	Score            float64    `json:"score"`
+	MergedBy         string     `json:"merged_by,omitempty"`
+	MovedFavorites   int        `json:"moved_favorites"`
+	DroppedFavorites int        `json:"dropped_favorites"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UnmergedAt       *time.Time `json:"unmerged_at,omitempty"`
+}
+
+type RestaurantMergeResponse struct {
+	Merge RestaurantMergeDTO `json:"merge"`
+}
+
+type LinkedIdentityListResponse struct {
+	Identities []RestaurantMergeDTO `json:"identities"`
+	Total      int                  `json:"total"`
+}
+
+// TabelogMatchResponse is the outcome of matching Tabelog listings to a restaurant
+type TabelogMatchResponse struct {
+	Restaurant RestaurantDTO                `json:"restaurant"`
+	Listings   []application.TabelogListing `json:"listings"`
+	Best       *application.TabelogListing  `json:"best,omitempty"`
+	Score      float64                      `json:"score"`
+	Attached   bool                         `json:"attached"`
+}
+
+// RevisionDTO is one entry of a restaurant's change history
+type RevisionDTO struct {
+	ID           string           `json:"id"`
+	RestaurantID string           `json:"restaurant_id"`
+	Source       string           `json:"source"`
+	ActorID      string           `json:"actor_id,omitempty"`
+	Changes      []FieldChangeDTO `json:"changes"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+// FieldChangeDTO is the before and after value of one field; empty means unset
+type FieldChangeDTO struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type RevisionListResponse struct {
+	Revisions []RevisionDTO `json:"revisions"`
+	Total     int64         `json:"total"`
+}
+
+// SuggestionDTO is an edit suggestion and its moderation state
+type SuggestionDTO struct {
+	ID           string     `json:"id"`
+	RestaurantID string     `json:"restaurant_id"`
+	AuthorID     string     `json:"author_id"`
+	Edit         EditDTO    `json:"edit"`
+	Status       string     `json:"status"`
+	ReviewerID   string     `json:"reviewer_id,omitempty"`
+	ReviewNote   string     `json:"review_note,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// EditDTO holds the proposed restaurant details; omitted fields are unchanged
+type EditDTO struct {
+	Name        string  `json:"name,omitempty"`
+	NameJa      string  `json:"name_ja,omitempty"`
+	Area        string  `json:"area,omitempty"`
+	Address     string  `json:"address,omitempty"`
+	Rating      float64 `json:"rating,omitempty"`
+	PriceRange  string  `json:"price_range,omitempty"`
+	CuisineType string  `json:"cuisine_type,omitempty"`
+	Phone       string  `json:"phone,omitempty"`
+	Website     string  `json:"website,omitempty"`
+	Latitude    float64 `json:"latitude,omitempty"`
+	Longitude   float64 `json:"longitude,omitempty"`
+}
+
+type SuggestionResponse struct {
+	Suggestion SuggestionDTO `json:"suggestion"`
+}
+
+type SuggestionListResponse struct {
+	Suggestions []SuggestionDTO `json:"suggestions"`
+	Total       int64           `json:"total"`
+}
+
+// RefreshRunDTO reports a refresh run. selected lists the place IDs chosen
+// for refresh in priority order (the full plan of a dry run).
+type RefreshRunDTO struct {
+	ID         string     `json:"id"`
+	DryRun     bool       `json:"dry_run"`
+	Trigger    string     `json:"trigger"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Stale      int64      `json:"stale"`
+	Selected   []string   `json:"selected"`
+	Refreshed  int        `json:"refreshed"`
+	Missing    int        `json:"missing"`
+	Failed     int        `json:"failed"`
+	Error      string     `json:"error,omitempty"`
+}
+
+type RefreshRunResponse struct {
+	Run RefreshRunDTO `json:"run"`
+}
+
+type RefreshStatusResponse struct {
+	Running   *RefreshRunDTO `json:"running,omitempty"`
+	Last      *RefreshRunDTO `json:"last,omitempty"`
+	Interval  string         `json:"interval"`
+	BatchSize int            `json:"batch_size"`
+	Quota     int            `json:"quota"`
+	DryRun    bool           `json:"dry_run"`
+}
+
+// PhotoDTO is a stored restaurant photo with signed URLs of its renditions
+type PhotoDTO struct {
+	ID           string    `json:"id"`
+	Source       string    `json:"source"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type PhotoListResponse struct {
+	Photos []PhotoDTO `json:"photos"`
+}
+
+// IngestPhotosResponse lists the newly stored photos
+type IngestPhotosResponse struct {
+	Photos  []PhotoDTO `json:"photos"`
+	Skipped int        `json:"skipped"` // already stored
+	Failed  int        `json:"failed"`  // could not be downloaded or decoded
+}
+
+// ImportRowErrorDTO is a rejected row of an import file
+type ImportRowErrorDTO struct {
+	Row        int    `json:"row"`
+	ExternalID string `json:"external_id,omitempty"`
+	Message    string `json:"message"`
+}
+
+// ImportReportResponse summarizes an import
+type ImportReportResponse struct {
+	DryRun          bool                `json:"dry_run"`
+	Total           int                 `json:"total"`
+	Created         int                 `json:"created"`
+	Updated         int                 `json:"updated"`
+	Unchanged       int                 `json:"unchanged"`
+	Failed          int                 `json:"failed"`
+	Errors          []ImportRowErrorDTO `json:"errors"`
+	ErrorsTruncated bool                `json:"errors_truncated,omitempty"`
+}
+
+// ImportFailedResponse is an import stopped by an error. Report covers the
+// rows processed before it, which stay saved unless it was a dry run.
+type ImportFailedResponse struct {
+	Error   string                `json:"error"`
+	Message string                `json:"message"`
+	Report  *ImportReportResponse `json:"report,omitempty"`
+}
+
+// TrendingRestaurantDTO is a restaurant with its trending score and the
+// events counted in the trending window
+type TrendingRestaurantDTO struct {
+	Restaurant RestaurantDTO `json:"restaurant"`
+	Score      float64       `json:"score"`
+	Views      int64         `json:"views"`
+	Favorites  int64         `json:"favorites"`
+	Visits     int64         `json:"visits"`
+}
+
+// TrendingRestaurantsResponse lists trending restaurants, highest score first
+type TrendingRestaurantsResponse struct {
+	Restaurants []TrendingRestaurantDTO `json:"restaurants"`
+}
+
+// RecommendationDTO is a restaurant recommended to a user with the reason it
+// was picked
+type RecommendationDTO struct {
+	Restaurant RestaurantDTO `json:"restaurant"`
+	DistanceKm float64       `json:"distance_km"`
+	Score      float64       `json:"score"`
+	Reason     string        `json:"reason"`
+}
+
+// RecommendationsResponse lists recommendations, best match first
+type RecommendationsResponse struct {
+	Recommendations []RecommendationDTO `json:"recommendations"`
+}
+
+// CollectionDTO is a named list of a user's favorites. share_slug is set while
+// the collection is shared as a public list at /lists/{share_slug}.
+type CollectionDTO struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	ShareSlug   string     `json:"share_slug,omitempty"`
+	SharedAt    *time.Time `json:"shared_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// CollectionItemDTO is a favorite at its place in a collection
+type CollectionItemDTO struct {
+	Position int         `json:"position"`
+	AddedAt  time.Time   `json:"added_at"`
+	Favorite FavoriteDTO `json:"favorite"`
+}
+
+type CollectionResponse struct {
+	Collection CollectionDTO `json:"collection"`
+}
+
+// CollectionDetailResponse is a collection with its favorites in order
+type CollectionDetailResponse struct {
+	Collection CollectionDTO       `json:"collection"`
+	Items      []CollectionItemDTO `json:"items"`
+}
+
+type CollectionListResponse struct {
+	Collections []CollectionDTO `json:"collections"`
+	Total       int             `json:"total"`
+}
+
+// SharedListItemDTO is a restaurant at its place in a shared list
+type SharedListItemDTO struct {
+	Position   int           `json:"position"`
+	AddedAt    time.Time     `json:"added_at"`
+	Restaurant RestaurantDTO `json:"restaurant"`
+}
+
+// SharedListResponse is a read-only public list. is_owner tells a signed in
+// viewer that the list is theirs.
+type SharedListResponse struct {
+	Slug        string              `json:"slug"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	SharedAt    *time.Time          `json:"shared_at"`
+	IsOwner     bool                `json:"is_owner"`
+	Items       []SharedListItemDTO `json:"items"`
+	Total       int                 `json:"total"`
+}
+
+// MoneyDTO is an amount in an ISO 4217 currency, such as 3200 JPY
+type MoneyDTO struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// VisitDTO is one visit of a user to a restaurant. rating is omitted when the
+// visit was not rated.
+type VisitDTO struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	RestaurantID string    `json:"restaurant_id"`
+	VisitedOn    string    `json:"visited_on"`
+	PartySize    int       `json:"party_size"`
+	Rating       *int      `json:"rating,omitempty"`
+	Dishes       []string  `json:"dishes"`
+	Spend        *MoneyDTO `json:"spend,omitempty"`
+	PhotoURLs    []string  `json:"photo_urls"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type VisitResponse struct {
+	Visit VisitDTO `json:"visit"`
+}
+
+type VisitListResponse struct {
+	Visits []VisitDTO `json:"visits"`
+	Total  int64      `json:"total"`
+}
+
+// MonthlyVisitsDTO counts the visits in a month, given as 2026-03
+type MonthlyVisitsDTO struct {
+	Month       string `json:"month"`
+	Visits      int64  `json:"visits"`
+	Restaurants int64  `json:"restaurants"`
+}
+
+type MonthlyVisitsResponse struct {
+	Months []MonthlyVisitsDTO `json:"months"`
+}
+
+// CuisineSpendDTO is the spend on a cuisine in one currency; amounts in
+// different currencies are listed separately
+type CuisineSpendDTO struct {
+	CuisineType string  `json:"cuisine_type"`
+	Currency    string  `json:"currency"`
+	Visits      int64   `json:"visits"`
+	Total       float64 `json:"total"`
+}
+
+type SpendByCuisineResponse struct {
+	Cuisines []CuisineSpendDTO `json:"cuisines"`
+}
+
+// RevisitedRestaurantDTO is a restaurant the user went back to.
+// average_rating is omitted when no visit was rated.
+type RevisitedRestaurantDTO struct {
+	Restaurant    RestaurantDTO `json:"restaurant"`
+	Visits        int64         `json:"visits"`
+	LastVisitedOn string        `json:"last_visited_on"`
+	AverageRating *float64      `json:"average_rating,omitempty"`
+}
+
+type MostRevisitedResponse struct {
+	Restaurants []RevisitedRestaurantDTO `json:"restaurants"`
+}
+
+// ReviewDTO is a user's review of a restaurant. edited_at is set once the
+// author edits the review; the moderation fields once a moderator acts on it.
+type ReviewDTO struct {
+	ID             string     `json:"id"`
+	UserID         string     `json:"user_id"`
+	RestaurantID   string     `json:"restaurant_id"`
+	Score          int        `json:"score"`
+	Text           string     `json:"text"`
+	Language       string     `json:"language,omitempty"`
+	Status         string     `json:"status"`
+	HelpfulCount   int        `json:"helpful_count"`
+	ModerationNote string     `json:"moderation_note,omitempty"`
+	ModeratedAt    *time.Time `json:"moderated_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	EditedAt       *time.Time `json:"edited_at,omitempty"`
+}
+
+type ReviewResponse struct {
+	Review ReviewDTO `json:"review"`
+}
+
+type ReviewListResponse struct {
+	Reviews []ReviewDTO `json:"reviews"`
+	Total   int64       `json:"total"`
+}
+
+// ReviewEditDTO is a previous version of a review, replaced at edited_at
+type ReviewEditDTO struct {
+	Score    int       `json:"score"`
+	Text     string    `json:"text"`
+	Language string    `json:"language,omitempty"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// ReviewHistoryResponse lists the previous versions of a review, newest first
+type ReviewHistoryResponse struct {
+	ReviewID string          `json:"review_id"`
+	Edits    []ReviewEditDTO `json:"edits"`
+}
+
+// DeletedRestaurantDTO is a soft-deleted restaurant. It can be restored until
+// purge_at, which is omitted when deleted restaurants are kept.
+type DeletedRestaurantDTO struct {
+	Restaurant RestaurantDTO `json:"restaurant"`
+	DeletedAt  *time.Time    `json:"deleted_at"`
+	PurgeAt    *time.Time    `json:"purge_at,omitempty"`
+}
+
+type DeletedRestaurantListResponse struct {
+	Restaurants []DeletedRestaurantDTO `json:"restaurants"`
+	Total       int64                  `json:"total"`
 }
 
 // Mapper functions
@@ -112,14 +698,89 @@ func toRestaurantDTO(r *model.Restaurant) RestaurantDTO {
 		Longitude:    lng,
 		Rating:       r.Rating(),
 		PriceRange:   r.PriceRange(),
+		Price:        toPriceDTO(r.Price()),
 		CuisineType:  r.CuisineType(),
+		Cuisines:     r.Cuisines(),
 		Phone:        r.Phone(),
 		Website:      r.Website(),
 		OpeningHours: r.OpeningHours(),
 		Metadata:     r.Metadata(),
 		ViewCount:    r.ViewCount(),
+		Tabelog:      toTabelogProfileDTO(r.Tabelog()),
 		CreatedAt:    r.CreatedAt(),
 		UpdatedAt:    r.UpdatedAt(),
+
+		ReviewRating:    r.Reviews().Average,
+		ReviewCount:     r.Reviews().Count,
+		OpeningSchedule: toOpeningScheduleDTO(r.OpeningSchedule()),
+		IsOpenAt:        isOpenAt(r, time.Now()),
+		Localizations:   toLocalizationDTOs(r.Localizations()),
+	}
+}
+
+func toLocalizationDTOs(localizations map[string]model.LocalizedContent) map[string]LocalizedContentDTO {
+	if len(localizations) == 0 {
+		return nil
+	}
+
+	dtos := make(map[string]LocalizedContentDTO, len(localizations))
+	for lang, content := range localizations {
+		dtos[lang] = toLocalizedContentDTO("", content)
+	}
+	return dtos
+}
+
+func toLocalizedContentDTO(lang string, content model.LocalizedContent) LocalizedContentDTO {
+	return LocalizedContentDTO{
+		Language:     lang,
+		Name:         content.Name,
+		Address:      content.Address,
+		CuisineLabel: content.CuisineLabel,
+		Summary:      content.Summary,
+	}
+}
+
+// localize returns the restaurant's text in the first of langs, the
+// request's content languages, with fallbacks applied
+func localize(r *model.Restaurant, langs []string) *LocalizedContentDTO {
+	dto := toLocalizedContentDTO(langs[0], r.Localize(langs...))
+	return &dto
+}
+
+func toOpeningScheduleDTO(s *model.OpeningSchedule) *OpeningScheduleDTO {
+	if s == nil {
+		return nil
+	}
+
+	return &OpeningScheduleDTO{
+		Periods:  s.Periods(),
+		Holidays: s.Holidays(),
+	}
+}
+
+// isOpenAt returns nil when the restaurant has no structured opening hours
+func isOpenAt(r *model.Restaurant, t time.Time) *bool {
+	open, ok := r.IsOpenAt(t)
+	if !ok {
+		return nil
+	}
+	return &open
+}
+
+func toTabelogProfileDTO(p *model.TabelogProfile) *TabelogProfileDTO {
+	if p == nil {
+		return nil
+	}
+
+	return &TabelogProfileDTO{
+		URL:         p.URL(),
+		Rating:      p.Rating(),
+		ReviewCount: p.ReviewCount(),
+		Bookmarks:   p.Bookmarks(),
+		Genres:      p.Genres(),
+		MatchScore:  p.MatchScore(),
+		Confirmed:   p.Confirmed(),
+		SyncedAt:    p.SyncedAt(),
 	}
 }
 
@@ -131,6 +792,36 @@ func toRestaurantDTOList(restaurants []*model.Restaurant) []RestaurantDTO {
 	return dtos
 }
 
+func toNearbyRestaurantDTOList(results []*repository.NearbyRestaurant) []RestaurantDTO {
+	dtos := make([]RestaurantDTO, len(results))
+	for i, result := range results {
+		distance := result.DistanceKm
+		dtos[i] = toRestaurantDTO(result.Restaurant)
+		dtos[i].DistanceKm = &distance
+	}
+	return dtos
+}
+
+func toSearchHitDTOList(hits []*repository.RestaurantSearchHit) []RestaurantDTO {
+	dtos := make([]RestaurantDTO, len(hits))
+	for i, hit := range hits {
+		score := hit.Score
+		dtos[i] = toRestaurantDTO(hit.Restaurant)
+		dtos[i].Score = &score
+	}
+	return dtos
+}
+
+func toFilteredRestaurantDTOList(results []*repository.FilteredRestaurant) []RestaurantDTO {
+	dtos := make([]RestaurantDTO, len(results))
+	for i, result := range results {
+		dtos[i] = toRestaurantDTO(result.Restaurant)
+		dtos[i].DistanceKm = result.DistanceKm
+		dtos[i].Score = result.Score
+	}
+	return dtos
+}
+
 func toFavoriteDTO(f *model.Favorite) FavoriteDTO {
 	return FavoriteDTO{
 		ID:            f.ID().String(),
@@ -151,3 +842,390 @@ func toFavoriteDTOList(favorites []*model.Favorite) []FavoriteDTO {
 	}
 	return dtos
 }
+
+func toDuplicateCandidateDTOList(candidates []*application.DuplicateCandidate) []DuplicateCandidateDTO {
+	dtos := make([]DuplicateCandidateDTO, len(candidates))
+	for i, c := range candidates {
+		dtos[i] = DuplicateCandidateDTO{
+			A:             toRestaurantDTO(c.A),
+			B:             toRestaurantDTO(c.B),
+			Score:         c.Match.Score,
+			NameScore:     c.Match.Name,
+			PhoneScore:    c.Match.Phone,
+			LocationScore: c.Match.Location,
+			DistanceKm:    c.Match.DistanceKm,
+		}
+	}
+	return dtos
+}
+
+func toRestaurantMergeDTO(m *model.RestaurantMerge) RestaurantMergeDTO {
+	return RestaurantMergeDTO{
+		ID:               m.ID().String(),
+		CanonicalID:      m.CanonicalID().String(),
+		MergedID:         m.MergedID().String(),
+		Source:           string(m.Source()),
+		ExternalID:       m.ExternalID(),
+		Score:            m.Score(),
+		MergedBy:         m.MergedBy(),
+		MovedFavorites:   len(m.MovedFavoriteIDs()),
+		DroppedFavorites: len(m.DroppedFavoriteIDs()),
+		CreatedAt:        m.CreatedAt(),
+		UnmergedAt:       m.UnmergedAt(),
+	}
+}
+
+func toRestaurantMergeDTOList(merges []*model.RestaurantMerge) []RestaurantMergeDTO {
+	dtos := make([]RestaurantMergeDTO, len(merges))
+	for i, m := range merges {
+		dtos[i] = toRestaurantMergeDTO(m)
+	}
+	return dtos
+}
+
+func toRefreshRunDTO(run *application.RefreshRun) *RefreshRunDTO {
+	if run == nil {
+		return nil
+	}
+
+	return &RefreshRunDTO{
+		ID:         run.ID.String(),
+		DryRun:     run.DryRun,
+		Trigger:    run.Trigger,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		Stale:      run.Stale,
+		Selected:   run.Selected,
+		Refreshed:  run.Refreshed,
+		Missing:    run.Missing,
+		Failed:     run.Failed,
+		Error:      run.Error,
+	}
+}
+
+func toRevisionDTOList(revisions []*model.RestaurantRevision) []RevisionDTO {
+	dtos := make([]RevisionDTO, len(revisions))
+	for i, r := range revisions {
+		changes := make([]FieldChangeDTO, len(r.Changes()))
+		for j, c := range r.Changes() {
+			changes[j] = FieldChangeDTO{Field: c.Field, Before: c.Before, After: c.After}
+		}
+		dtos[i] = RevisionDTO{
+			ID:           r.ID().String(),
+			RestaurantID: r.RestaurantID().String(),
+			Source:       string(r.Source()),
+			ActorID:      r.ActorID(),
+			Changes:      changes,
+			CreatedAt:    r.CreatedAt(),
+		}
+	}
+	return dtos
+}
+
+func toSuggestionDTO(s *model.EditSuggestion) SuggestionDTO {
+	edit := s.Edit()
+	return SuggestionDTO{
+		ID:           s.ID().String(),
+		RestaurantID: s.RestaurantID().String(),
+		AuthorID:     s.AuthorID(),
+		Edit: EditDTO{
+			Name:        edit.Name,
+			NameJa:      edit.NameJa,
+			Area:        edit.Area,
+			Address:     edit.Address,
+			Rating:      edit.Rating,
+			PriceRange:  edit.PriceRange,
+			CuisineType: edit.CuisineType,
+			Phone:       edit.Phone,
+			Website:     edit.Website,
+			Latitude:    edit.Latitude,
+			Longitude:   edit.Longitude,
+		},
+		Status:     string(s.Status()),
+		ReviewerID: s.ReviewerID(),
+		ReviewNote: s.ReviewNote(),
+		CreatedAt:  s.CreatedAt(),
+		ReviewedAt: s.ReviewedAt(),
+	}
+}
+
+func toSuggestionDTOList(suggestions []*model.EditSuggestion) []SuggestionDTO {
+	dtos := make([]SuggestionDTO, len(suggestions))
+	for i, s := range suggestions {
+		dtos[i] = toSuggestionDTO(s)
+	}
+	return dtos
+}
+
+func toPhotoDTO(p *model.Photo, url, thumbnailURL string) PhotoDTO {
+	return PhotoDTO{
+		ID:           p.ID().String(),
+		Source:       string(p.Source()),
+		Width:        p.Width(),
+		Height:       p.Height(),
+		URL:          url,
+		ThumbnailURL: thumbnailURL,
+		CreatedAt:    p.CreatedAt(),
+	}
+}
+
+func toImportReportResponse(r *application.ImportReport) ImportReportResponse {
+	errs := make([]ImportRowErrorDTO, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = ImportRowErrorDTO{
+			Row:        e.Row,
+			ExternalID: e.ExternalID,
+			Message:    e.Message,
+		}
+	}
+
+	return ImportReportResponse{
+		DryRun:          r.DryRun,
+		Total:           r.Total,
+		Created:         r.Created,
+		Updated:         r.Updated,
+		Unchanged:       r.Unchanged,
+		Failed:          r.Failed,
+		Errors:          errs,
+		ErrorsTruncated: r.ErrorsTruncated,
+	}
+}
+
+func toTrendingRestaurantsResponse(results []*repository.TrendingRestaurant) TrendingRestaurantsResponse {
+	dtos := make([]TrendingRestaurantDTO, len(results))
+	for i, r := range results {
+		dtos[i] = TrendingRestaurantDTO{
+			Restaurant: toRestaurantDTO(r.Restaurant),
+			Score:      r.Score,
+			Views:      r.Views,
+			Favorites:  r.Favorites,
+			Visits:     r.Visits,
+		}
+	}
+	return TrendingRestaurantsResponse{Restaurants: dtos}
+}
+
+func toRecommendationsResponse(recommendations []*application.Recommendation) RecommendationsResponse {
+	dtos := make([]RecommendationDTO, len(recommendations))
+	for i, r := range recommendations {
+		dtos[i] = RecommendationDTO{
+			Restaurant: toRestaurantDTO(r.Restaurant),
+			DistanceKm: r.DistanceKm,
+			Score:      r.Score,
+			Reason:     r.Reason,
+		}
+	}
+	return RecommendationsResponse{Recommendations: dtos}
+}
+
+func toCollectionDTO(c *model.Collection) CollectionDTO {
+	return CollectionDTO{
+		ID:          c.ID().String(),
+		UserID:      c.UserID().String(),
+		Name:        c.Name(),
+		Description: c.Description(),
+		ShareSlug:   c.ShareSlug(),
+		SharedAt:    c.SharedAt(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}
+}
+
+func toCollectionDTOList(collections []*model.Collection) []CollectionDTO {
+	dtos := make([]CollectionDTO, len(collections))
+	for i, c := range collections {
+		dtos[i] = toCollectionDTO(c)
+	}
+	return dtos
+}
+
+func toCollectionDetailResponse(detail *application.CollectionDetail) CollectionDetailResponse {
+	items := make([]CollectionItemDTO, len(detail.Items))
+	for i, item := range detail.Items {
+		items[i] = CollectionItemDTO{
+			Position: item.Position,
+			AddedAt:  item.AddedAt,
+			Favorite: toFavoriteDTO(item.Favorite),
+		}
+	}
+	return CollectionDetailResponse{
+		Collection: toCollectionDTO(detail.Collection),
+		Items:      items,
+	}
+}
+
+func toSharedListResponse(list *application.SharedList, isOwner bool) SharedListResponse {
+	items := make([]SharedListItemDTO, len(list.Items))
+	for i, item := range list.Items {
+		items[i] = SharedListItemDTO{
+			Position:   item.Position,
+			AddedAt:    item.AddedAt,
+			Restaurant: toRestaurantDTO(item.Restaurant),
+		}
+	}
+	return SharedListResponse{
+		Slug:        list.Collection.ShareSlug(),
+		Name:        list.Collection.Name(),
+		Description: list.Collection.Description(),
+		SharedAt:    list.Collection.SharedAt(),
+		IsOwner:     isOwner,
+		Items:       items,
+		Total:       len(items),
+	}
+}
+
+func toVisitDTO(v *model.Visit) VisitDTO {
+	dto := VisitDTO{
+		ID:           v.ID().String(),
+		UserID:       v.UserID().String(),
+		RestaurantID: v.RestaurantID().String(),
+		VisitedOn:    v.VisitedOn().Format(time.DateOnly),
+		PartySize:    v.PartySize(),
+		Dishes:       v.Dishes(),
+		PhotoURLs:    v.PhotoURLs(),
+		CreatedAt:    v.CreatedAt(),
+		UpdatedAt:    v.UpdatedAt(),
+	}
+	if v.IsRated() {
+		rating := v.Rating()
+		dto.Rating = &rating
+	}
+	if spend := v.Spend(); spend != nil {
+		dto.Spend = &MoneyDTO{Amount: spend.Amount, Currency: spend.Currency}
+	}
+	return dto
+}
+
+func toVisitDTOList(visits []*model.Visit) []VisitDTO {
+	dtos := make([]VisitDTO, len(visits))
+	for i, v := range visits {
+		dtos[i] = toVisitDTO(v)
+	}
+	return dtos
+}
+
+func toMonthlyVisitsResponse(months []*repository.MonthlyVisits) MonthlyVisitsResponse {
+	dtos := make([]MonthlyVisitsDTO, len(months))
+	for i, m := range months {
+		dtos[i] = MonthlyVisitsDTO{
+			Month:       m.Month.Format("2006-01"),
+			Visits:      m.Visits,
+			Restaurants: m.Restaurants,
+		}
+	}
+	return MonthlyVisitsResponse{Months: dtos}
+}
+
+func toSpendByCuisineResponse(spend []*repository.CuisineSpend) SpendByCuisineResponse {
+	dtos := make([]CuisineSpendDTO, len(spend))
+	for i, s := range spend {
+		dtos[i] = CuisineSpendDTO{
+			CuisineType: s.CuisineType,
+			Currency:    s.Currency,
+			Visits:      s.Visits,
+			Total:       s.Total,
+		}
+	}
+	return SpendByCuisineResponse{Cuisines: dtos}
+}
+
+func toMostRevisitedResponse(places []*application.RevisitedPlace) MostRevisitedResponse {
+	dtos := make([]RevisitedRestaurantDTO, len(places))
+	for i, p := range places {
+		dtos[i] = RevisitedRestaurantDTO{
+			Restaurant:    toRestaurantDTO(p.Restaurant),
+			Visits:        p.Visits,
+			LastVisitedOn: p.LastVisitedOn.Format(time.DateOnly),
+			AverageRating: p.AverageRating,
+		}
+	}
+	return MostRevisitedResponse{Restaurants: dtos}
+}
+
+func toReviewDTO(r *model.Review) ReviewDTO {
+	return ReviewDTO{
+		ID:             r.ID().String(),
+		UserID:         r.UserID().String(),
+		RestaurantID:   r.RestaurantID().String(),
+		Score:          r.Score(),
+		Text:           r.Text(),
+		Language:       r.Language(),
+		Status:         string(r.Status()),
+		HelpfulCount:   r.HelpfulCount(),
+		ModerationNote: r.ModerationNote(),
+		ModeratedAt:    r.ModeratedAt(),
+		CreatedAt:      r.CreatedAt(),
+		UpdatedAt:      r.UpdatedAt(),
+		EditedAt:       r.EditedAt(),
+	}
+}
+
+func toReviewListResponse(page *application.ReviewPage) ReviewListResponse {
+	dtos := make([]ReviewDTO, len(page.Reviews))
+	for i, r := range page.Reviews {
+		dtos[i] = toReviewDTO(r)
+	}
+	return ReviewListResponse{Reviews: dtos, Total: page.Total}
+}
+
+func toReviewHistoryResponse(reviewID string, edits []*model.ReviewEdit) ReviewHistoryResponse {
+	dtos := make([]ReviewEditDTO, len(edits))
+	for i, e := range edits {
+		dtos[i] = ReviewEditDTO{
+			Score:    e.Score,
+			Text:     e.Text,
+			Language: e.Language,
+			EditedAt: e.EditedAt,
+		}
+	}
+	return ReviewHistoryResponse{ReviewID: reviewID, Edits: dtos}
+}
+
+func toDeletedRestaurantListResponse(deleted []*application.DeletedRestaurant, total int64) DeletedRestaurantListResponse {
+	dtos := make([]DeletedRestaurantDTO, len(deleted))
+	for i, d := range deleted {
+		dtos[i] = DeletedRestaurantDTO{
+			Restaurant: toRestaurantDTO(d.Restaurant),
+			DeletedAt:  d.Restaurant.DeletedAt(),
+			PurgeAt:    d.PurgeAt,
+		}
+	}
+	return DeletedRestaurantListResponse{Restaurants: dtos, Total: total}
+}
+
+func toPriceDTO(p *model.Price) *PriceDTO {
+	if p == nil {
+		return nil
+	}
+	return &PriceDTO{
+		Level:  p.Level(),
+		Text:   p.Text(),
+		Lunch:  toPriceBandDTO(p.Lunch()),
+		Dinner: toPriceBandDTO(p.Dinner()),
+	}
+}
+
+func toPriceBandDTO(b *model.PriceBand) *PriceBandDTO {
+	if b == nil {
+		return nil
+	}
+	dto := &PriceBandDTO{Min: float64(b.Min)}
+	if b.Max > 0 {
+		max := float64(b.Max)
+		dto.Max = &max
+	}
+	return dto
+}
+
+func toCuisineListResponse(categories []*model.CuisineCategory, langs []string) CuisineListResponse {
+	dtos := make([]CuisineDTO, len(categories))
+	for i, category := range categories {
+		dtos[i] = CuisineDTO{
+			ID:     category.ID,
+			Parent: category.Parent,
+			Label:  category.Label(langs...),
+			Labels: category.Labels,
+		}
+	}
+	return CuisineListResponse{Cuisines: dtos}
+}
@@ -1,26 +1,44 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
 	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 type RestaurantHandler struct {
-	service application.RestaurantService
-	logger  *zap.Logger
+	service     application.RestaurantService
+	suggestions application.SuggestionService
+	visits      application.VisitService
+	currencies  *application.CurrencyConverter
+	logger      *zap.Logger
 }
 
-func NewRestaurantHandler(service application.RestaurantService, logger *zap.Logger) *RestaurantHandler {
+func NewRestaurantHandler(
+	service application.RestaurantService,
+	suggestions application.SuggestionService,
+	visits application.VisitService,
+	currencies *application.CurrencyConverter,
+	logger *zap.Logger,
+) *RestaurantHandler {
 	return &RestaurantHandler{
-		service: service,
-		logger:  logger,
+		service:     service,
+		suggestions: suggestions,
+		visits:      visits,
+		currencies:  currencies,
+		logger:      logger,
 	}
 }
 
@@ -77,11 +95,15 @@ func (h *RestaurantHandler) CreateRestaurant(c *gin.Context) {
 
 // GetRestaurant godoc
 // @Summary Get a restaurant by ID
-// @Description Get restaurant details by ID
+// @Description Get restaurant details by ID. Each request counts as a view towards the restaurant's popularity.
 // @Tags restaurants
 // @Accept json
 // @Produce json
 // @Param id path string true "Restaurant ID"
+// @Param open_at query string false "Time to evaluate is_open_at at (RFC 3339), defaults to now"
+// @Param currency query string false "Currency to add price.display in (e.g. USD)"
+// @Param lang query string false "Content language of localized, before the Accept-Language ones" Enums(en, ja, zh-TW)
+// @Param Accept-Language header string false "Preferred content languages"
 // @Success 200 {object} RestaurantResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
@@ -97,6 +119,22 @@ func (h *RestaurantHandler) GetRestaurant(c *gin.Context) {
 		return
 	}
 
+	openAt, errResp := parseOpenAt(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+	langs, errResp := parseContentLanguages(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+	currency, errResp := h.parseCurrency(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
 	restaurant, err := h.service.GetRestaurant(c.Request.Context(), id)
 	if err != nil {
 		if err == domainerrors.ErrRestaurantNotFound {
@@ -115,20 +153,34 @@ func (h *RestaurantHandler) GetRestaurant(c *gin.Context) {
 		return
 	}
 
+	// Counting the view is best effort and never fails the request
+	if err := h.service.IncrementRestaurantViewCount(c.Request.Context(), id); err != nil {
+		h.logger.Warn("Failed to count restaurant view", zap.String("id", id.String()), zap.Error(err))
+	}
+
+	dto := toRestaurantDTO(restaurant)
+	dto.Localized = localize(restaurant, langs)
+	h.displayPrice(&dto, restaurant, currency)
+	if openAt != nil {
+		dto.IsOpenAt = isOpenAt(restaurant, *openAt)
+	}
 	c.JSON(http.StatusOK, RestaurantResponse{
-		Restaurant: toRestaurantDTO(restaurant),
+		Restaurant: dto,
 	})
 }
 
 // UpdateRestaurant godoc
 // @Summary Update a restaurant
-// @Description Update restaurant details (currently supports Japanese name)
+// @Description Update restaurant details (currently supports Japanese name).
+// @Description Edits by admins and trusted users are applied immediately and return 200 with the restaurant.
+// @Description Edits by other users are stored as a pending suggestion for moderation and return 202.
 // @Tags restaurants
 // @Accept json
 // @Produce json
 // @Param id path string true "Restaurant ID"
 // @Param request body UpdateRestaurantRequest true "Update request"
 // @Success 200 {object} RestaurantResponse
+// @Success 202 {object} SuggestionResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -158,40 +210,567 @@ func (h *RestaurantHandler) UpdateRestaurant(c *gin.Context) {
 		NameJa: req.NameJa,
 	}
 
-	restaurant, err := h.service.UpdateRestaurant(c.Request.Context(), id, appReq)
+	result, err := h.suggestions.SubmitEdit(c.Request.Context(), id, appReq, editorFromContext(c))
 	if err != nil {
-		if err == domainerrors.ErrRestaurantNotFound {
+		switch {
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error:   "not_found",
 				Message: "Restaurant not found",
 			})
+		case errors.Is(err, domainerrors.ErrEmptyEdit):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		default:
+			h.logger.Error("Failed to update restaurant", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to update restaurant",
+			})
+		}
+		return
+	}
+
+	if result.Suggestion != nil {
+		c.JSON(http.StatusAccepted, SuggestionResponse{
+			Suggestion: toSuggestionDTO(result.Suggestion),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(result.Restaurant),
+	})
+}
+
+// SetRestaurantLocalization godoc
+// @Summary Set a restaurant's localized text
+// @Description Replace the name, address, cuisine label and summary shown in ja or zh-TW (admin only).
+// @Description Empty fields fall back to other languages; an empty body removes the language.
+// @Description The next Map Service refresh overwrites the name, address and summary but keeps the cuisine label.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param lang path string true "Language" Enums(ja, zh-TW)
+// @Param request body LocalizationRequest true "Localized text"
+// @Success 200 {object} RestaurantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/{id}/localizations/{lang} [put]
+func (h *RestaurantHandler) SetRestaurantLocalization(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	var req LocalizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	restaurant, err := h.service.SetRestaurantLocalization(c.Request.Context(), id, c.Param("lang"), model.LocalizedContent{
+		Name:         req.Name,
+		Address:      req.Address,
+		CuisineLabel: req.CuisineLabel,
+		Summary:      req.Summary,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrUnsupportedLanguage):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_language",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		default:
+			h.logger.Error("Failed to set restaurant localization", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to set restaurant localization",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(restaurant),
+	})
+}
+
+// SetRestaurantCuisines godoc
+// @Summary Set a restaurant's cuisine categories
+// @Description Replace the cuisine categories of a restaurant with IDs from /cuisines (admin only); an empty list removes them all.
+// @Description The change is recorded in the revision log. Map Service refreshes and Tabelog matches add back
+// @Description the categories their types and genres map onto.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param request body SetCuisinesRequest true "Cuisine category IDs"
+// @Success 200 {object} RestaurantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/{id}/cuisines [put]
+func (h *RestaurantHandler) SetRestaurantCuisines(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	var req SetCuisinesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	restaurant, err := h.service.SetRestaurantCuisines(c.Request.Context(), id, req.Cuisines, actorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrUnknownCuisine):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_cuisine",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		default:
+			h.logger.Error("Failed to set restaurant cuisines", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to set restaurant cuisines",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(restaurant),
+	})
+}
+
+// ListCuisines godoc
+// @Summary List cuisine categories
+// @Description The cuisine taxonomy, parents before their children. Filtering restaurants by a category
+// @Description with the cuisine parameter includes its descendants.
+// @Tags restaurants
+// @Produce json
+// @Param lang query string false "Language of label, before the Accept-Language ones" Enums(en, ja, zh-TW)
+// @Param Accept-Language header string false "Preferred content languages"
+// @Success 200 {object} CuisineListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /cuisines [get]
+func (h *RestaurantHandler) ListCuisines(c *gin.Context) {
+	langs, errResp := parseContentLanguages(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCuisineListResponse(model.Cuisines.Categories(), langs))
+}
+
+// ListRestaurants godoc
+// @Summary List restaurants
+// @Description List restaurants matching all given criteria. Every filter is optional and they can be combined.
+// @Description q applies the same matching as /restaurants/search and adds a score to each result;
+// @Description lat, lng and radius_km restrict results to a radius and add distance_km to each result.
+// @Description sort defaults to relevance when q is set, then distance when lat/lng are set, then rating.
+// @Description Pages are cursor-based: pass next_cursor back as cursor to get the next page.
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param area query string false "Area (e.g. Tokyo)"
+// @Param cuisine_type query string false "Cuisine type; a category name also matches its descendants"
+// @Param cuisine query string false "Cuisine category ID from /cuisines, including its descendants"
+// @Param source query string false "Data source" Enums(tabelog, google, opentable)
+// @Param min_rating query number false "Minimum rating (0-5)"
+// @Param min_price query int false "Minimum price level (1-4, number of $)"
+// @Param max_price query int false "Maximum price level (1-4, number of $)"
+// @Param min_budget query number false "Minimum per-person budget, in currency or yen"
+// @Param max_budget query number false "Maximum per-person budget, in currency or yen"
+// @Param meal query string false "Meal the budget applies to; either meal when empty" Enums(lunch, dinner)
+// @Param currency query string false "Currency of the budget, and of price.display in the results (e.g. USD)"
+// @Param open_now query bool false "Only restaurants open now per their opening schedule"
+// @Param open_at query string false "Only restaurants open at this time per their opening schedule (RFC 3339 or now); is_open_at is evaluated at it"
+// @Param q query string false "Text query"
+// @Param lat query number false "Latitude (requires lng)"
+// @Param lng query number false "Longitude (requires lat)"
+// @Param radius_km query number false "Search radius in kilometers" default(1)
+// @Param sort query string false "Sort order" Enums(relevance, distance, rating, view_count, newest, name)
+// @Param limit query int false "Limit" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param offset query int false "Offset (legacy paging, no next_cursor)"
+// @Param lang query string false "Content language of localized, before the Accept-Language ones" Enums(en, ja, zh-TW)
+// @Param Accept-Language header string false "Preferred content languages"
+// @Success 200 {object} RestaurantListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants [get]
+func (h *RestaurantHandler) ListRestaurants(c *gin.Context) {
+	currency, errResp := h.parseCurrency(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	filter, errResp := h.parseRestaurantFilter(c, currency)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	page, errResp := parsePagination(c, 20)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	langs, errResp := parseContentLanguages(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	var results []*repository.FilteredRestaurant
+	var total int64
+	var next *repository.Cursor
+	var err error
+	if page.useOffset {
+		results, total, err = h.service.FilterRestaurants(c.Request.Context(), filter, page.limit, page.offset)
+	} else {
+		results, total, next, err = h.service.FilterRestaurantsAfter(c.Request.Context(), filter, page.after, page.limit)
+	}
+	if err != nil {
+		if isInvalidFilterError(err) || errors.Is(err, domainerrors.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
 			return
 		}
 
-		h.logger.Error("Failed to update restaurant", zap.Error(err))
+		h.logger.Error("Failed to list restaurants", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "internal_error",
-			Message: "Failed to update restaurant",
+			Message: "Failed to list restaurants",
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, RestaurantResponse{
-		Restaurant: toRestaurantDTO(restaurant),
+	dtos := toFilteredRestaurantDTOList(results)
+	for i, result := range results {
+		dtos[i].Localized = localize(result.Restaurant, langs)
+		h.displayPrice(&dtos[i], result.Restaurant, currency)
+		if filter.OpenAt != nil {
+			dtos[i].IsOpenAt = isOpenAt(result.Restaurant, *filter.OpenAt)
+		}
+	}
+	c.JSON(http.StatusOK, RestaurantListResponse{
+		Restaurants: dtos,
+		Total:       int(total),
+		NextCursor:  next.Encode(),
 	})
 }
 
+// parseRestaurantFilter reads the listing criteria from the query string,
+// with budgets in currency. Range and combination checks are left to the service.
+func (h *RestaurantHandler) parseRestaurantFilter(c *gin.Context, currency string) (repository.RestaurantFilter, *ErrorResponse) {
+	filter := repository.RestaurantFilter{
+		Area:        c.Query("area"),
+		CuisineType: c.Query("cuisine_type"),
+		Cuisine:     c.Query("cuisine"),
+		Source:      model.RestaurantSource(c.Query("source")),
+		Text:        c.Query("q"),
+		Sort:        repository.RestaurantSort(c.Query("sort")),
+	}
+
+	if v := c.Query("min_rating"); v != "" {
+		rating, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return filter, &ErrorResponse{Error: "invalid_rating", Message: "min_rating must be a number"}
+		}
+		filter.MinRating = rating
+	}
+
+	if v := c.Query("min_price"); v != "" {
+		level, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, &ErrorResponse{Error: "invalid_price", Message: "min_price must be an integer"}
+		}
+		filter.MinPriceLevel = level
+	}
+
+	if v := c.Query("max_price"); v != "" {
+		level, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, &ErrorResponse{Error: "invalid_price", Message: "max_price must be an integer"}
+		}
+		filter.MaxPriceLevel = level
+	}
+
+	budget, errResp := h.parseBudget(c, currency)
+	if errResp != nil {
+		return filter, errResp
+	}
+	filter.Budget = budget
+
+	if v := c.Query("open_now"); v != "" {
+		openNow, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, &ErrorResponse{Error: "invalid_open_now", Message: "open_now must be a boolean"}
+		}
+		filter.OpenNow = openNow
+	}
+
+	openAt, errResp := parseOpenAt(c)
+	if errResp != nil {
+		return filter, errResp
+	}
+	filter.OpenAt = openAt
+
+	latStr, lngStr := c.Query("lat"), c.Query("lng")
+	if latStr != "" || lngStr != "" {
+		lat, latErr := strconv.ParseFloat(latStr, 64)
+		lng, lngErr := strconv.ParseFloat(lngStr, 64)
+		if latErr != nil || lngErr != nil {
+			return filter, &ErrorResponse{Error: "invalid_location", Message: "lat and lng must both be numbers"}
+		}
+
+		radiusKm, err := strconv.ParseFloat(c.DefaultQuery("radius_km", "1"), 64)
+		if err != nil {
+			return filter, &ErrorResponse{Error: "invalid_radius", Message: "radius_km must be a number"}
+		}
+
+		filter.Near = &repository.NearPoint{Latitude: lat, Longitude: lng, RadiusKm: radiusKm}
+	}
+
+	return filter, nil
+}
+
+// parseBudget reads the optional per-person budget, min_budget and max_budget
+// in currency (yen when empty) for the meal, and converts it to yen
+func (h *RestaurantHandler) parseBudget(c *gin.Context, currency string) (*repository.BudgetFilter, *ErrorResponse) {
+	minStr, maxStr := c.Query("min_budget"), c.Query("max_budget")
+	if minStr == "" && maxStr == "" {
+		return nil, nil
+	}
+	if currency == "" {
+		currency = application.BaseCurrency
+	}
+
+	budget := &repository.BudgetFilter{Meal: model.Meal(c.Query("meal"))}
+	for _, bound := range []struct {
+		value string
+		yen   *int
+	}{{minStr, &budget.Min}, {maxStr, &budget.Max}} {
+		if bound.value == "" {
+			continue
+		}
+		amount, err := strconv.ParseFloat(bound.value, 64)
+		if err != nil {
+			return nil, &ErrorResponse{Error: "invalid_budget", Message: "min_budget and max_budget must be numbers"}
+		}
+		yen, err := h.currencies.ToYen(model.NewMoney(amount, currency))
+		if err != nil {
+			return nil, &ErrorResponse{Error: "invalid_currency", Message: err.Error()}
+		}
+		*bound.yen = yen
+	}
+	return budget, nil
+}
+
+// parseCurrency reads the optional currency prices are also shown in
+func (h *RestaurantHandler) parseCurrency(c *gin.Context) (string, *ErrorResponse) {
+	currency := strings.ToUpper(c.Query("currency"))
+	if currency == "" {
+		return "", nil
+	}
+	if !h.currencies.Supports(currency) {
+		return "", &ErrorResponse{
+			Error:   "invalid_currency",
+			Message: "currency must be one of " + strings.Join(h.currencies.Currencies(), ", "),
+		}
+	}
+	return currency, nil
+}
+
+// displayPrice adds the restaurant's price bands converted to currency to
+// its DTO; it does nothing without a currency or bands
+func (h *RestaurantHandler) displayPrice(dto *RestaurantDTO, r *model.Restaurant, currency string) {
+	if currency == "" || dto.Price == nil || r.Price() == nil || !r.Price().HasBands() {
+		return
+	}
+
+	display := &DisplayPriceDTO{Currency: currency}
+	var err error
+	if display.Lunch, err = h.convertBand(r.Price().Lunch(), currency); err != nil {
+		return
+	}
+	if display.Dinner, err = h.convertBand(r.Price().Dinner(), currency); err != nil {
+		return
+	}
+	dto.Price.Display = display
+}
+
+// convertBand converts a yen price band to currency
+func (h *RestaurantHandler) convertBand(band *model.PriceBand, currency string) (*PriceBandDTO, error) {
+	if band == nil {
+		return nil, nil
+	}
+
+	min, err := h.currencies.FromYen(band.Min, currency)
+	if err != nil {
+		return nil, err
+	}
+	dto := &PriceBandDTO{Min: min.Amount}
+	if band.Max > 0 {
+		max, err := h.currencies.FromYen(band.Max, currency)
+		if err != nil {
+			return nil, err
+		}
+		dto.Max = &max.Amount
+	}
+	return dto, nil
+}
+
+// parseOpenAt reads the optional open_at time, an RFC 3339 timestamp or "now"
+func parseOpenAt(c *gin.Context) (*time.Time, *ErrorResponse) {
+	v := c.Query("open_at")
+	if v == "" {
+		return nil, nil
+	}
+
+	if v == "now" {
+		now := time.Now()
+		return &now, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, &ErrorResponse{Error: "invalid_open_at", Message: "open_at must be an RFC 3339 time or now"}
+	}
+	return &t, nil
+}
+
+// parseContentLanguages reads the content languages of a request: the lang
+// query parameter, then the Accept-Language header, then the default
+// language. The first one is reported in the Content-Language header.
+func parseContentLanguages(c *gin.Context) ([]string, *ErrorResponse) {
+	langs := application.ContentLanguages(c.GetHeader("Accept-Language"))
+	if v := c.Query("lang"); v != "" {
+		lang, ok := application.MatchLanguage(v)
+		if !ok {
+			return nil, &ErrorResponse{Error: "invalid_language", Message: "lang must be one of en, ja, zh-TW"}
+		}
+		langs = append([]string{lang}, langs...)
+	}
+
+	c.Header("Content-Language", langs[0])
+	return langs, nil
+}
+
+// pagination is the paging of a listing request. Requests that pass offset
+// keep offset paging; all others page by cursor, starting at the first page
+// when no cursor is given.
+type pagination struct {
+	limit     int
+	offset    int
+	useOffset bool
+	after     *repository.Cursor
+}
+
+// parsePagination reads limit, offset and cursor from the query string
+func parsePagination(c *gin.Context, defaultLimit int) (pagination, *ErrorResponse) {
+	var page pagination
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultLimit)))
+	if err != nil || limit <= 0 || limit > 100 {
+		return page, &ErrorResponse{Error: "invalid_limit", Message: "limit must be between 1 and 100"}
+	}
+	page.limit = limit
+
+	offsetStr, hasOffset := c.GetQuery("offset")
+	cursor := c.Query("cursor")
+	if hasOffset && cursor != "" {
+		return page, &ErrorResponse{Error: "invalid_request", Message: "offset and cursor cannot be combined"}
+	}
+
+	if hasOffset {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return page, &ErrorResponse{Error: "invalid_offset", Message: "offset must be a non-negative integer"}
+		}
+		page.offset = offset
+		page.useOffset = true
+		return page, nil
+	}
+
+	after, err := repository.DecodeCursor(cursor)
+	if err != nil {
+		return page, &ErrorResponse{Error: "invalid_cursor", Message: err.Error()}
+	}
+	page.after = after
+	return page, nil
+}
+
+// isInvalidFilterError reports whether err is a filter validation error from the service
+func isInvalidFilterError(err error) bool {
+	return errors.Is(err, domainerrors.ErrInvalidLocation) ||
+		errors.Is(err, domainerrors.ErrInvalidRadius) ||
+		errors.Is(err, domainerrors.ErrInvalidRating) ||
+		errors.Is(err, domainerrors.ErrInvalidPriceLevel) ||
+		errors.Is(err, domainerrors.ErrInvalidBudget) ||
+		errors.Is(err, domainerrors.ErrUnknownCuisine) ||
+		errors.Is(err, domainerrors.ErrInvalidSort)
+}
+
 // SearchRestaurants godoc
 // @Summary Search restaurants
-// @Description Search restaurants by query string
+// @Description Full-text and fuzzy search over English and Japanese names, cuisine, area and address.
+// @Description Kana and romaji spellings match each other ("ラーメン", "ramen") and small typos are tolerated.
+// @Description Results are ordered by relevance; each includes its score and total is the number of matches.
 // @Tags restaurants
 // @Accept json
 // @Produce json
 // @Param q query string true "Search query"
 // @Param limit query int false "Limit" default(10)
-// @Param offset query int false "Offset" default(0)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param offset query int false "Offset (legacy paging, no next_cursor)"
+// @Param currency query string false "Currency to add price.display in (e.g. USD)"
+// @Param lang query string false "Content language of localized, before the Accept-Language ones" Enums(en, ja, zh-TW)
+// @Param Accept-Language header string false "Preferred content languages"
 // @Success 200 {object} RestaurantListResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /restaurants/search [get]
 func (h *RestaurantHandler) SearchRestaurants(c *gin.Context) {
 	query := c.Query("q")
@@ -203,11 +782,41 @@ func (h *RestaurantHandler) SearchRestaurants(c *gin.Context) {
 		return
 	}
 
-	limit := 10
-	offset := 0
+	page, errResp := parsePagination(c, 10)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	langs, errResp := parseContentLanguages(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+	currency, errResp := h.parseCurrency(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
 
-	restaurants, err := h.service.SearchRestaurants(c.Request.Context(), query, limit, offset)
+	var hits []*repository.RestaurantSearchHit
+	var total int64
+	var next *repository.Cursor
+	var err error
+	if page.useOffset {
+		hits, total, err = h.service.SearchRestaurants(c.Request.Context(), query, page.limit, page.offset)
+	} else {
+		hits, total, next, err = h.service.SearchRestaurantsAfter(c.Request.Context(), query, page.after, page.limit)
+	}
 	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_cursor",
+				Message: err.Error(),
+			})
+			return
+		}
+
 		h.logger.Error("Failed to search restaurants", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "internal_error",
@@ -216,9 +825,102 @@ func (h *RestaurantHandler) SearchRestaurants(c *gin.Context) {
 		return
 	}
 
+	dtos := toSearchHitDTOList(hits)
+	for i, hit := range hits {
+		dtos[i].Localized = localize(hit.Restaurant, langs)
+		h.displayPrice(&dtos[i], hit.Restaurant, currency)
+	}
 	c.JSON(http.StatusOK, RestaurantListResponse{
-		Restaurants: toRestaurantDTOList(restaurants),
-		Total:       len(restaurants),
+		Restaurants: dtos,
+		Total:       int(total),
+		NextCursor:  next.Encode(),
+	})
+}
+
+// FindNearbyRestaurants godoc
+// @Summary Find restaurants near a location
+// @Description Find restaurants within a great-circle radius, ordered by distance.
+// @Description Each result includes distance_km from the given point.
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude" example(35.6812)
+// @Param lng query number true "Longitude" example(139.7671)
+// @Param radius_km query number false "Search radius in kilometers" default(1)
+// @Param limit query int false "Limit" default(20)
+// @Param currency query string false "Currency to add price.display in (e.g. USD)"
+// @Param lang query string false "Content language of localized, before the Accept-Language ones" Enums(en, ja, zh-TW)
+// @Param Accept-Language header string false "Preferred content languages"
+// @Success 200 {object} RestaurantListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/nearby [get]
+func (h *RestaurantHandler) FindNearbyRestaurants(c *gin.Context) {
+	lat, latErr := strconv.ParseFloat(c.Query("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.Query("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_location",
+			Message: "lat and lng query parameters are required",
+		})
+		return
+	}
+
+	radiusKm, err := strconv.ParseFloat(c.DefaultQuery("radius_km", "1"), 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_radius",
+			Message: "radius_km must be a number",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+
+	langs, errResp := parseContentLanguages(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+	currency, errResp := h.parseCurrency(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	results, err := h.service.FindRestaurantsByLocation(c.Request.Context(), lat, lng, radiusKm, limit)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidLocation) || errors.Is(err, domainerrors.ErrInvalidRadius) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		h.logger.Error("Failed to find nearby restaurants", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to find nearby restaurants",
+		})
+		return
+	}
+
+	dtos := toNearbyRestaurantDTOList(results)
+	for i, result := range results {
+		dtos[i].Localized = localize(result.Restaurant, langs)
+		h.displayPrice(&dtos[i], result.Restaurant, currency)
+	}
+	c.JSON(http.StatusOK, RestaurantListResponse{
+		Restaurants: dtos,
+		Total:       len(results),
 	})
 }
 
@@ -231,6 +933,9 @@ func (h *RestaurantHandler) SearchRestaurants(c *gin.Context) {
 // @Param request body AddFavoriteRequest true "Add favorite request"
 // @Success 201 {object} FavoriteResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Router /favorites [post]
 func (h *RestaurantHandler) AddToFavorites(c *gin.Context) {
 	var req AddFavoriteRequest
@@ -260,13 +965,21 @@ func (h *RestaurantHandler) AddToFavorites(c *gin.Context) {
 		return
 	}
 
+	if !isSelfOrAdmin(c, userID.String()) {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error:   "forbidden",
+			Message: "Cannot add favorites for another user",
+		})
+		return
+	}
+
+	h.addFavorite(c, userID, restaurantID)
+}
+
+func (h *RestaurantHandler) addFavorite(c *gin.Context, userID, restaurantID uuid.UUID) {
 	favorite, err := h.service.AddToFavorites(c.Request.Context(), userID, restaurantID)
 	if err != nil {
-		h.logger.Error("Failed to add favorite", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "internal_error",
-			Message: "Failed to add favorite",
-		})
+		h.writeFavoriteError(c, err, "Failed to add favorite")
 		return
 	}
 
@@ -277,13 +990,19 @@ func (h *RestaurantHandler) AddToFavorites(c *gin.Context) {
 
 // GetUserFavorites godoc
 // @Summary Get user favorites
-// @Description Get all favorites for a user
+// @Description Get favorites for a user, newest first. Without limit or cursor all favorites are returned;
+// @Description with either, one page is returned and next_cursor fetches the next one.
+// @Description With tag, all favorites carrying the tag are returned.
 // @Tags favorites
 // @Accept json
 // @Produce json
 // @Param userId path string true "User ID"
+// @Param tag query string false "Only favorites with this tag"
+// @Param limit query int false "Page size" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
 // @Success 200 {object} FavoriteListResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /users/{userId}/favorites [get]
 func (h *RestaurantHandler) GetUserFavorites(c *gin.Context) {
 	userIDStr := c.Param("userId")
@@ -296,8 +1015,73 @@ func (h *RestaurantHandler) GetUserFavorites(c *gin.Context) {
 		return
 	}
 
-	favorites, err := h.service.GetUserFavorites(c.Request.Context(), userID)
+	if tag := c.Query("tag"); tag != "" {
+		favorites, err := h.service.GetUserFavoritesByTag(c.Request.Context(), userID, tag)
+		if err != nil {
+			h.logger.Error("Failed to get user favorites by tag", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to get favorites",
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, FavoriteListResponse{
+			Favorites: toFavoriteDTOList(favorites),
+			Total:     len(favorites),
+		})
+		return
+	}
+
+	// Without limit or cursor the full list is returned, as before cursor paging
+	_, hasLimit := c.GetQuery("limit")
+	_, hasCursor := c.GetQuery("cursor")
+	if !hasLimit && !hasCursor {
+		favorites, err := h.service.GetUserFavorites(c.Request.Context(), userID)
+		if err != nil {
+			h.logger.Error("Failed to get user favorites", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to get favorites",
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, FavoriteListResponse{
+			Favorites: toFavoriteDTOList(favorites),
+			Total:     len(favorites),
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+
+	after, err := repository.DecodeCursor(c.Query("cursor"))
 	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_cursor",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	favorites, next, err := h.service.GetUserFavoritesAfter(c.Request.Context(), userID, after, limit)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_cursor",
+				Message: err.Error(),
+			})
+			return
+		}
+
 		h.logger.Error("Failed to get user favorites", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "internal_error",
@@ -307,11 +1091,303 @@ func (h *RestaurantHandler) GetUserFavorites(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, FavoriteListResponse{
-		Favorites: toFavoriteDTOList(favorites),
-		Total:     len(favorites),
+		Favorites:  toFavoriteDTOList(favorites),
+		Total:      len(favorites),
+		NextCursor: next.Encode(),
+	})
+}
+
+// AddUserFavorite godoc
+// @Summary Add restaurant to a user's favorites
+// @Description Add a restaurant to the favorites of the user in the path
+// @Tags favorites
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param request body AddUserFavoriteRequest true "Restaurant to add"
+// @Success 201 {object} FavoriteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /users/{userId}/favorites [post]
+func (h *RestaurantHandler) AddUserFavorite(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_user_id",
+			Message: "Invalid user ID",
+		})
+		return
+	}
+
+	var req AddUserFavoriteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	restaurantID, err := uuid.Parse(req.RestaurantID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_restaurant_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	h.addFavorite(c, userID, restaurantID)
+}
+
+// GetUserFavorite godoc
+// @Summary Get a favorite
+// @Description Get the user's favorite of a restaurant
+// @Tags favorites
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Success 200 {object} FavoriteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/favorites/{restaurantId} [get]
+func (h *RestaurantHandler) GetUserFavorite(c *gin.Context) {
+	userID, restaurantID, ok := parseFavoritePath(c)
+	if !ok {
+		return
+	}
+
+	favorite, err := h.service.GetFavoriteByUserAndRestaurant(c.Request.Context(), userID, restaurantID)
+	if err != nil {
+		h.writeFavoriteError(c, err, "Failed to get favorite")
+		return
+	}
+
+	c.JSON(http.StatusOK, FavoriteResponse{
+		Favorite: toFavoriteDTO(favorite),
 	})
 }
 
+// RemoveUserFavorite godoc
+// @Summary Remove restaurant from favorites
+// @Description Remove a restaurant from the user's favorites. It is also removed from the user's collections.
+// @Tags favorites
+// @Param userId path string true "User ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/favorites/{restaurantId} [delete]
+func (h *RestaurantHandler) RemoveUserFavorite(c *gin.Context) {
+	userID, restaurantID, ok := parseFavoritePath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveFromFavorites(c.Request.Context(), userID, restaurantID); err != nil {
+		h.writeFavoriteError(c, err, "Failed to remove favorite")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateFavoriteNotes godoc
+// @Summary Update favorite notes
+// @Description Replace the user's notes on a favorite
+// @Tags favorites
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body UpdateFavoriteNotesRequest true "New notes"
+// @Success 200 {object} FavoriteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/favorites/{restaurantId}/notes [put]
+func (h *RestaurantHandler) UpdateFavoriteNotes(c *gin.Context) {
+	userID, restaurantID, ok := parseFavoritePath(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateFavoriteNotesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	favorite, err := h.service.UpdateFavoriteNotes(c.Request.Context(), userID, restaurantID, req.Notes)
+	if err != nil {
+		h.writeFavoriteError(c, err, "Failed to update favorite notes")
+		return
+	}
+
+	c.JSON(http.StatusOK, FavoriteResponse{
+		Favorite: toFavoriteDTO(favorite),
+	})
+}
+
+// AddFavoriteTag godoc
+// @Summary Tag a favorite
+// @Description Add a tag to a favorite; adding a tag it already has changes nothing
+// @Tags favorites
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body AddFavoriteTagRequest true "Tag to add"
+// @Success 200 {object} FavoriteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/favorites/{restaurantId}/tags [post]
+func (h *RestaurantHandler) AddFavoriteTag(c *gin.Context) {
+	userID, restaurantID, ok := parseFavoritePath(c)
+	if !ok {
+		return
+	}
+
+	var req AddFavoriteTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	favorite, err := h.service.AddFavoriteTag(c.Request.Context(), userID, restaurantID, req.Tag)
+	if err != nil {
+		h.writeFavoriteError(c, err, "Failed to add favorite tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, FavoriteResponse{
+		Favorite: toFavoriteDTO(favorite),
+	})
+}
+
+// RemoveFavoriteTag godoc
+// @Summary Untag a favorite
+// @Description Remove a tag from a favorite
+// @Tags favorites
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Param tag path string true "Tag"
+// @Success 200 {object} FavoriteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/favorites/{restaurantId}/tags/{tag} [delete]
+func (h *RestaurantHandler) RemoveFavoriteTag(c *gin.Context) {
+	userID, restaurantID, ok := parseFavoritePath(c)
+	if !ok {
+		return
+	}
+
+	favorite, err := h.service.RemoveFavoriteTag(c.Request.Context(), userID, restaurantID, c.Param("tag"))
+	if err != nil {
+		h.writeFavoriteError(c, err, "Failed to remove favorite tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, FavoriteResponse{
+		Favorite: toFavoriteDTO(favorite),
+	})
+}
+
+// AddFavoriteVisit godoc
+// @Summary Record a visit
+// @Description Record that the user visited a favorite restaurant today. The visit is added to the
+// @Description user's visit history, which the favorite's visit count is derived from.
+// @Tags favorites
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param restaurantId path string true "Restaurant ID"
+// @Success 200 {object} FavoriteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/favorites/{restaurantId}/visits [post]
+func (h *RestaurantHandler) AddFavoriteVisit(c *gin.Context) {
+	userID, restaurantID, ok := parseFavoritePath(c)
+	if !ok {
+		return
+	}
+
+	favorite, err := h.visits.LogFavoriteVisit(c.Request.Context(), userID, restaurantID)
+	if err != nil {
+		h.writeFavoriteError(c, err, "Failed to record visit")
+		return
+	}
+
+	c.JSON(http.StatusOK, FavoriteResponse{
+		Favorite: toFavoriteDTO(favorite),
+	})
+}
+
+// parseFavoritePath parses the userId and restaurantId path parameters,
+// writing a 400 response when either is invalid
+func parseFavoritePath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_user_id",
+			Message: "Invalid user ID",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	restaurantID, err := uuid.Parse(c.Param("restaurantId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_restaurant_id",
+			Message: "Invalid restaurant ID",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, restaurantID, true
+}
+
+// writeFavoriteError maps favorite errors to responses; unknown errors are
+// logged and reported with message
+func (h *RestaurantHandler) writeFavoriteError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domainerrors.ErrFavoriteNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Favorite not found",
+		})
+	case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Restaurant not found",
+		})
+	case errors.Is(err, domainerrors.ErrFavoriteAlreadyExists):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error:   "already_exists",
+			Message: "Restaurant is already a favorite",
+		})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
+
 // QuickSearchByPlaceID godoc
 // @Summary Quick search restaurant by Google Place ID
 // @Description Search for a restaurant using Google Place ID with cache-first strategy.
@@ -324,6 +1400,9 @@ func (h *RestaurantHandler) GetUserFavorites(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param place_id path string true "Google Place ID" example("ChIJN1t_tDeuEmsRUsoyG83frY4")
+// @Param currency query string false "Currency to add price.display in (e.g. USD)"
+// @Param lang query string false "Content language of localized, before the Accept-Language ones" Enums(en, ja, zh-TW)
+// @Param Accept-Language header string false "Preferred content languages"
 // @Success 200 {object} RestaurantResponse "Restaurant found" headers(X-Cache-Status=string,X-Data-Source=string,X-Data-Age=string)
 // @Failure 400 {object} ErrorResponse "Invalid place ID"
 // @Failure 404 {object} ErrorResponse "Restaurant not found"
@@ -341,6 +1420,16 @@ func (h *RestaurantHandler) QuickSearchByPlaceID(c *gin.Context) {
 		})
 		return
 	}
+	langs, errResp := parseContentLanguages(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+	currency, errResp := h.parseCurrency(c)
+	if errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
 
 	// Log request
 	h.logger.Info("QuickSearchByPlaceID request",
@@ -396,7 +1485,10 @@ func (h *RestaurantHandler) QuickSearchByPlaceID(c *gin.Context) {
 		zap.Float64("data_age_seconds", dataAge.Seconds()),
 	)
 
+	dto := toRestaurantDTO(restaurant)
+	dto.Localized = localize(restaurant, langs)
+	h.displayPrice(&dto, restaurant, currency)
 	c.JSON(http.StatusOK, RestaurantResponse{
-		Restaurant: toRestaurantDTO(restaurant),
+		Restaurant: dto,
 	})
 }
@@ -0,0 +1,274 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/dedup"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MergeHandler serves the admin endpoints for restaurant deduplication
+type MergeHandler struct {
+	service application.MergeService
+	logger  *zap.Logger
+}
+
+func NewMergeHandler(service application.MergeService, logger *zap.Logger) *MergeHandler {
+	return &MergeHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListDuplicateCandidates godoc
+// @Summary List duplicate restaurant candidates
+// @Description List pairs of restaurants from different sources that likely describe the same place,
+// @Description scored by name similarity, phone number and distance (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param restaurant_id query string false "Only pairs including this restaurant"
+// @Param min_score query number false "Minimum match score (0-1)" default(0.6)
+// @Param limit query int false "Limit" default(20)
+// @Success 200 {object} DuplicateCandidateListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/duplicates [get]
+func (h *MergeHandler) ListDuplicateCandidates(c *gin.Context) {
+	var restaurantID *uuid.UUID
+	if idStr := c.Query("restaurant_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_id",
+				Message: "Invalid restaurant ID",
+			})
+			return
+		}
+		restaurantID = &id
+	}
+
+	minScore := dedup.DefaultMinScore
+	if scoreStr := c.Query("min_score"); scoreStr != "" {
+		score, err := strconv.ParseFloat(scoreStr, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_min_score",
+				Message: "min_score must be a number",
+			})
+			return
+		}
+		minScore = score
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+
+	candidates, err := h.service.FindDuplicateCandidates(c.Request.Context(), restaurantID, minScore, limit)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrInvalidMinScore) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_min_score",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		h.logger.Error("Failed to list duplicate candidates", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to list duplicate candidates",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, DuplicateCandidateListResponse{
+		Candidates: toDuplicateCandidateDTOList(candidates),
+		Total:      len(candidates),
+	})
+}
+
+// MergeRestaurants godoc
+// @Summary Merge duplicate restaurants
+// @Description Merge duplicate_id into canonical_id (admin only). The duplicate is removed, its source ID
+// @Description stays linked to the canonical restaurant and its favorites move to the canonical restaurant.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body MergeRestaurantsRequest true "Merge request"
+// @Success 201 {object} RestaurantMergeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/merges [post]
+func (h *MergeHandler) MergeRestaurants(c *gin.Context) {
+	var req MergeRestaurantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	canonicalID, err := uuid.Parse(req.CanonicalID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid canonical restaurant ID",
+		})
+		return
+	}
+	duplicateID, err := uuid.Parse(req.DuplicateID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid duplicate restaurant ID",
+		})
+		return
+	}
+
+	mergedBy, _ := middleware.GetUserID(c)
+
+	merge, err := h.service.MergeRestaurants(c.Request.Context(), canonicalID, duplicateID, mergedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMergeSelf):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		default:
+			h.logger.Error("Failed to merge restaurants", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to merge restaurants",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, RestaurantMergeResponse{
+		Merge: toRestaurantMergeDTO(merge),
+	})
+}
+
+// UnmergeRestaurants godoc
+// @Summary Undo a restaurant merge
+// @Description Restore the merged restaurant and move its favorites back (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param mergeId path string true "Merge ID"
+// @Success 200 {object} RestaurantMergeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/merges/{mergeId}/unmerge [post]
+func (h *MergeHandler) UnmergeRestaurants(c *gin.Context) {
+	mergeID, err := uuid.Parse(c.Param("mergeId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid merge ID",
+		})
+		return
+	}
+
+	merge, err := h.service.UnmergeRestaurants(c.Request.Context(), mergeID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMergeNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Merge not found",
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Merged restaurant no longer exists",
+			})
+		case errors.Is(err, domainerrors.ErrMergeAlreadyUndone):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "already_unmerged",
+				Message: err.Error(),
+			})
+		default:
+			h.logger.Error("Failed to unmerge restaurants", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to unmerge restaurants",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantMergeResponse{
+		Merge: toRestaurantMergeDTO(merge),
+	})
+}
+
+// GetLinkedIdentities godoc
+// @Summary List linked identities of a restaurant
+// @Description List the active merges into a restaurant, i.e. the source IDs linked to it (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} LinkedIdentityListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/{id}/identities [get]
+func (h *MergeHandler) GetLinkedIdentities(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	merges, err := h.service.GetLinkedIdentities(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrRestaurantNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+			return
+		}
+
+		h.logger.Error("Failed to get linked identities", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to get linked identities",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, LinkedIdentityListResponse{
+		Identities: toRestaurantMergeDTOList(merges),
+		Total:      len(merges),
+	})
+}
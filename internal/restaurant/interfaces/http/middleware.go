@@ -1,9 +1,12 @@
 package http
 
 import (
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -94,6 +97,31 @@ func RecordDatabaseQuery(operation, status string) {
 	databaseQueriesTotal.WithLabelValues(operation, status).Inc()
 }
 
+// isSelfOrAdmin reports whether the authenticated user is userID or an admin
+func isSelfOrAdmin(c *gin.Context, userID string) bool {
+	if role, _ := middleware.GetUserRole(c); role == "admin" {
+		return true
+	}
+	authUserID, ok := middleware.GetUserID(c)
+	return ok && strings.EqualFold(authUserID, userID)
+}
+
+// RequireSelfOrAdmin only lets the user named by the userId path parameter, or
+// an admin, through. It must run after RequireAuth.
+func RequireSelfOrAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !isSelfOrAdmin(c, c.Param("userId")) {
+			c.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{
+				Error:   "forbidden",
+				Message: "Cannot access another user's data",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
 // CORSMiddleware handles Cross-Origin Resource Sharing (CORS)
 func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
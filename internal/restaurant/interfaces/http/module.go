@@ -21,6 +21,19 @@ import (
 var Module = fx.Module("restaurant.http",
 	fx.Provide(
 		NewRestaurantHandler,
+		NewMergeHandler,
+		NewTabelogHandler,
+		NewRefreshHandler,
+		NewRevisionHandler,
+		NewSuggestionHandler,
+		NewPhotoHandler,
+		NewCatalogHandler,
+		NewPopularityHandler,
+		NewRecommendationHandler,
+		NewCollectionHandler,
+		NewVisitHandler,
+		NewReviewHandler,
+		NewDeletedRestaurantHandler,
 		NewHTTPServer,
 		NewAuthMiddleware,
 	),
@@ -54,6 +67,19 @@ func RegisterRoutes(
 	lc fx.Lifecycle,
 	router *gin.Engine,
 	handler *RestaurantHandler,
+	mergeHandler *MergeHandler,
+	tabelogHandler *TabelogHandler,
+	refreshHandler *RefreshHandler,
+	revisionHandler *RevisionHandler,
+	suggestionHandler *SuggestionHandler,
+	photoHandler *PhotoHandler,
+	catalogHandler *CatalogHandler,
+	popularityHandler *PopularityHandler,
+	recommendationHandler *RecommendationHandler,
+	collectionHandler *CollectionHandler,
+	visitHandler *VisitHandler,
+	reviewHandler *ReviewHandler,
+	deletedHandler *DeletedRestaurantHandler,
 	authMW *middleware.AuthMiddleware,
 	cfg *config.Config,
 	logger *zap.Logger,
@@ -85,19 +111,45 @@ func RegisterRoutes(
 		publicRestaurants := v1.Group("/restaurants")
 		publicRestaurants.Use(authMW.Optional()) // Track authenticated users but don't require auth
 		{
+			publicRestaurants.GET("", handler.ListRestaurants)
 			publicRestaurants.GET("/:id", handler.GetRestaurant)
 			publicRestaurants.GET("/search", handler.SearchRestaurants)
+			publicRestaurants.GET("/nearby", handler.FindNearbyRestaurants)
+			publicRestaurants.GET("/trending", popularityHandler.GetTrendingRestaurants)
 			publicRestaurants.GET("/quick-search/:place_id", handler.QuickSearchByPlaceID)
+			publicRestaurants.GET("/:id/photos", photoHandler.ListPhotos)
+			publicRestaurants.GET("/:id/reviews", reviewHandler.ListRestaurantReviews)
 		}
 
+		// Cuisine taxonomy
+		v1.GET("/cuisines", handler.ListCuisines)
+
+		// Photo proxy, authorized by the URL signature
+		v1.GET("/photos/:photoId", photoHandler.ServePhoto)
+
 		// Protected restaurant routes (write operations, admin only)
 		protectedRestaurants := v1.Group("/restaurants")
 		protectedRestaurants.Use(authMW.RequireAuth())
 		{
 			// Admin-only operations
 			protectedRestaurants.POST("", authMW.RequireRole("admin"), handler.CreateRestaurant)
-			// Allow authenticated users to update restaurant details (e.g., Japanese name)
+			// Allow authenticated users to update restaurant details (e.g., Japanese name).
+			// Edits by regular users wait in the moderation queue.
 			protectedRestaurants.PATCH("/:id", handler.UpdateRestaurant)
+			protectedRestaurants.GET("/suggestions", suggestionHandler.ListSuggestions)
+			protectedRestaurants.POST("/suggestions/:suggestionId/approve", suggestionHandler.ApproveSuggestion)
+			protectedRestaurants.POST("/suggestions/:suggestionId/reject", suggestionHandler.RejectSuggestion)
+			// Link restaurants to the Tabelog listings found by the spider service.
+			// Listings are loaded from the spider service, so users can match and
+			// confirm them; removing a link is left to admins.
+			protectedRestaurants.POST("/:id/tabelog/match", tabelogHandler.MatchTabelogListings)
+			protectedRestaurants.PUT("/:id/tabelog", tabelogHandler.ConfirmTabelogListing)
+			protectedRestaurants.DELETE("/:id/tabelog", authMW.RequireRole("admin"), tabelogHandler.DetachTabelogListing)
+			// Change history
+			protectedRestaurants.GET("/:id/revisions", revisionHandler.ListRevisions)
+			protectedRestaurants.POST("/:id/revisions/:revisionId/revert", authMW.RequireRole("admin"), revisionHandler.RevertToRevision)
+			// Reviews
+			protectedRestaurants.POST("/:id/reviews", reviewHandler.CreateReview)
 		}
 
 		// Protected favorite routes (require authentication)
@@ -107,11 +159,124 @@ func RegisterRoutes(
 			favorites.POST("", handler.AddToFavorites)
 		}
 
-		// Protected user favorites routes (require authentication)
+		// Protected user favorites routes (require authentication; users can
+		// only reach their own favorites unless they are admins)
 		userFavorites := v1.Group("/users/:userId/favorites")
-		userFavorites.Use(authMW.RequireAuth())
+		userFavorites.Use(authMW.RequireAuth(), RequireSelfOrAdmin())
 		{
 			userFavorites.GET("", handler.GetUserFavorites)
+			userFavorites.POST("", handler.AddUserFavorite)
+			userFavorites.GET("/:restaurantId", handler.GetUserFavorite)
+			userFavorites.DELETE("/:restaurantId", handler.RemoveUserFavorite)
+			userFavorites.PUT("/:restaurantId/notes", handler.UpdateFavoriteNotes)
+			userFavorites.POST("/:restaurantId/tags", handler.AddFavoriteTag)
+			userFavorites.DELETE("/:restaurantId/tags/:tag", handler.RemoveFavoriteTag)
+			userFavorites.POST("/:restaurantId/visits", handler.AddFavoriteVisit)
+		}
+
+		// Collections of a user's favorites, with the same ownership rule
+		userCollections := v1.Group("/users/:userId/collections")
+		userCollections.Use(authMW.RequireAuth(), RequireSelfOrAdmin())
+		{
+			userCollections.GET("", collectionHandler.ListCollections)
+			userCollections.POST("", collectionHandler.CreateCollection)
+			userCollections.GET("/:collectionId", collectionHandler.GetCollection)
+			userCollections.PATCH("/:collectionId", collectionHandler.UpdateCollection)
+			userCollections.DELETE("/:collectionId", collectionHandler.DeleteCollection)
+			userCollections.POST("/:collectionId/items", collectionHandler.AddCollectionItem)
+			userCollections.PUT("/:collectionId/items/order", collectionHandler.ReorderCollection)
+			userCollections.DELETE("/:collectionId/items/:restaurantId", collectionHandler.RemoveCollectionItem)
+			// Public sharing
+			userCollections.POST("/:collectionId/share", collectionHandler.ShareCollection)
+			userCollections.POST("/:collectionId/share/rotate", collectionHandler.RotateShareSlug)
+			userCollections.DELETE("/:collectionId/share", collectionHandler.UnshareCollection)
+		}
+
+		// Visit history and stats of a user, with the same ownership rule
+		userVisits := v1.Group("/users/:userId/visits")
+		userVisits.Use(authMW.RequireAuth(), RequireSelfOrAdmin())
+		{
+			userVisits.GET("", visitHandler.ListVisits)
+			userVisits.POST("", visitHandler.LogVisit)
+			userVisits.GET("/stats/monthly", visitHandler.GetMonthlyVisits)
+			userVisits.GET("/stats/spend-by-cuisine", visitHandler.GetSpendByCuisine)
+			userVisits.GET("/stats/most-revisited", visitHandler.GetMostRevisited)
+			userVisits.GET("/:visitId", visitHandler.GetVisit)
+			userVisits.PUT("/:visitId", visitHandler.UpdateVisit)
+			userVisits.DELETE("/:visitId", visitHandler.DeleteVisit)
+		}
+
+		// Recommendations for a user, with the same ownership rule
+		userRecommendations := v1.Group("/users/:userId/recommendations")
+		userRecommendations.Use(authMW.RequireAuth(), RequireSelfOrAdmin())
+		{
+			userRecommendations.GET("", recommendationHandler.GetRecommendations)
+		}
+
+		// Reviews are public; optional auth lets authors and admins see hidden ones
+		publicReviews := v1.Group("")
+		publicReviews.Use(authMW.Optional())
+		{
+			publicReviews.GET("/reviews/:reviewId", reviewHandler.GetReview)
+			publicReviews.GET("/reviews/:reviewId/history", reviewHandler.GetReviewHistory)
+			publicReviews.GET("/users/:userId/reviews", reviewHandler.ListUserReviews)
+		}
+
+		// Protected review routes; the service checks authorship
+		protectedReviews := v1.Group("/reviews")
+		protectedReviews.Use(authMW.RequireAuth())
+		{
+			protectedReviews.PUT("/:reviewId", reviewHandler.UpdateReview)
+			protectedReviews.DELETE("/:reviewId", reviewHandler.DeleteReview)
+			protectedReviews.POST("/:reviewId/helpful", reviewHandler.VoteHelpful)
+			protectedReviews.DELETE("/:reviewId/helpful", reviewHandler.RemoveHelpfulVote)
+		}
+
+		// Shared lists are public; optional auth tells owners apart
+		sharedLists := v1.Group("/lists")
+		sharedLists.Use(authMW.Optional())
+		{
+			sharedLists.GET("/:slug", collectionHandler.GetSharedList)
+		}
+
+		// Admin routes
+		adminRestaurants := v1.Group("/admin/restaurants")
+		adminRestaurants.Use(authMW.RequireAuth(), authMW.RequireRole("admin"))
+		{
+			adminRestaurants.GET("/duplicates", mergeHandler.ListDuplicateCandidates)
+			adminRestaurants.POST("/merges", mergeHandler.MergeRestaurants)
+			adminRestaurants.POST("/merges/:mergeId/unmerge", mergeHandler.UnmergeRestaurants)
+			adminRestaurants.GET("/:id/identities", mergeHandler.GetLinkedIdentities)
+
+			// Localized text
+			adminRestaurants.PUT("/:id/localizations/:lang", handler.SetRestaurantLocalization)
+
+			// Cuisine categories
+			adminRestaurants.PUT("/:id/cuisines", handler.SetRestaurantCuisines)
+
+			// Stale data refresh
+			adminRestaurants.GET("/refresh", refreshHandler.GetRefreshStatus)
+			adminRestaurants.POST("/refresh", refreshHandler.TriggerRefresh)
+
+			// Photo storage
+			adminRestaurants.POST("/:id/photos/ingest", photoHandler.IngestPhotos)
+
+			// Bulk import and export
+			adminRestaurants.POST("/import", catalogHandler.ImportRestaurants)
+			adminRestaurants.GET("/export", catalogHandler.ExportRestaurants)
+
+			// Deleted restaurants
+			adminRestaurants.GET("/deleted", deletedHandler.ListDeletedRestaurants)
+			adminRestaurants.POST("/:id/restore", deletedHandler.RestoreRestaurant)
+		}
+
+		// Review moderation
+		adminReviews := v1.Group("/admin/reviews")
+		adminReviews.Use(authMW.RequireAuth(), authMW.RequireRole("admin"))
+		{
+			adminReviews.GET("", reviewHandler.ListReviewsForModeration)
+			adminReviews.POST("/:reviewId/hide", reviewHandler.HideReview)
+			adminReviews.POST("/:reviewId/restore", reviewHandler.RestoreReview)
 		}
 	}
 
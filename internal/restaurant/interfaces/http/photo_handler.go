@@ -0,0 +1,245 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// photoProxyPath is the route signed photo URLs point to
+const photoProxyPath = "/api/v1/photos/"
+
+// PhotoHandler serves restaurant photos from blob storage
+type PhotoHandler struct {
+	service application.PhotoService
+	logger  *zap.Logger
+}
+
+func NewPhotoHandler(service application.PhotoService, logger *zap.Logger) *PhotoHandler {
+	return &PhotoHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListPhotos godoc
+// @Summary List restaurant photos
+// @Description List the stored photos of a restaurant with signed URLs of the image and its thumbnail.
+// @Description URLs stay valid for at least the configured TTL and are stable within it, so they can be cached.
+// @Tags restaurants
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} PhotoListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/photos [get]
+func (h *PhotoHandler) ListPhotos(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	photos, err := h.service.ListPhotos(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrRestaurantNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+			return
+		}
+
+		h.logger.Error("Failed to list photos", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to list photos",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PhotoListResponse{
+		Photos: h.toPhotoDTOList(photos),
+	})
+}
+
+// IngestPhotos godoc
+// @Summary Ingest restaurant photos
+// @Description Copy the restaurant's Google photos and the given Tabelog image URLs (e.g. from the spider's
+// @Description GetRestaurantPhotos) into photo storage and generate thumbnails. Photos already stored are skipped,
+// @Description so the call can be repeated (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param request body IngestPhotosRequest false "Tabelog image URLs"
+// @Success 200 {object} IngestPhotosResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/restaurants/{id}/photos/ingest [post]
+func (h *PhotoHandler) IngestPhotos(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	var req IngestPhotosRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.service.IngestPhotos(c.Request.Context(), id, req.TabelogURLs)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidPhotoURL):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_photo_url",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		default:
+			h.logger.Error("Failed to ingest photos", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to ingest photos",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, IngestPhotosResponse{
+		Photos:  h.toPhotoDTOList(result.Ingested),
+		Skipped: result.Skipped,
+		Failed:  result.Failed,
+	})
+}
+
+// ServePhoto godoc
+// @Summary Serve a photo
+// @Description Serve a stored photo image through a signed URL as returned by the photo listing.
+// @Description Responses are publicly cacheable until the signature expires.
+// @Tags photos
+// @Produce image/jpeg,image/png,image/gif
+// @Param photoId path string true "Photo ID"
+// @Param size query string false "Rendition" Enums(full, thumb) default(full)
+// @Param expires query int true "Signature expiry (unix seconds)"
+// @Param sig query string true "Signature"
+// @Success 200 {file} binary
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /photos/{photoId} [get]
+func (h *PhotoHandler) ServePhoto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("photoId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid photo ID",
+		})
+		return
+	}
+
+	size := model.PhotoSize(c.DefaultQuery("size", string(model.PhotoSizeFull)))
+	if !size.IsValid() {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_size",
+			Message: "size must be full or thumb",
+		})
+		return
+	}
+
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error:   "invalid_signature",
+			Message: domainerrors.ErrInvalidPhotoSignature.Error(),
+		})
+		return
+	}
+
+	content, err := h.service.OpenPhoto(c.Request.Context(), id, size, application.PhotoSignature{
+		Expires:   expires,
+		Signature: c.Query("sig"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidPhotoSignature):
+			c.JSON(http.StatusForbidden, ErrorResponse{
+				Error:   "invalid_signature",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrPhotoNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Photo not found",
+			})
+		default:
+			h.logger.Error("Failed to serve photo", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to serve photo",
+			})
+		}
+		return
+	}
+
+	// Cacheable until the signature expires; the content of a URL never changes
+	maxAge := int(math.Max(0, time.Until(content.ExpiresAt).Seconds()))
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", maxAge))
+	c.Header("ETag", content.ETag)
+	if c.GetHeader("If-None-Match") == content.ETag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, content.ContentType, content.Data)
+}
+
+// photoURL returns the signed proxy URL of a photo rendition
+func (h *PhotoHandler) photoURL(photo *model.Photo, size model.PhotoSize) string {
+	sig := h.service.SignPhoto(photo.ID(), size)
+
+	query := url.Values{}
+	query.Set("size", string(size))
+	query.Set("expires", strconv.FormatInt(sig.Expires, 10))
+	query.Set("sig", sig.Signature)
+	return photoProxyPath + photo.ID().String() + "?" + query.Encode()
+}
+
+func (h *PhotoHandler) toPhotoDTOList(photos []*model.Photo) []PhotoDTO {
+	dtos := make([]PhotoDTO, len(photos))
+	for i, p := range photos {
+		dtos[i] = toPhotoDTO(p, h.photoURL(p, model.PhotoSizeFull), h.photoURL(p, model.PhotoSizeThumbnail))
+	}
+	return dtos
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PopularityHandler handles trending restaurant requests
+type PopularityHandler struct {
+	service application.PopularityService
+	logger  *zap.Logger
+}
+
+func NewPopularityHandler(service application.PopularityService, logger *zap.Logger) *PopularityHandler {
+	return &PopularityHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// GetTrendingRestaurants godoc
+// @Summary List trending restaurants
+// @Description Get the restaurants with the most recent views, favorites and visits, optionally within an
+// @Description area or cuisine. Favorites and visits weigh more than views, and every event counts for half
+// @Description as much after each half-life (48 hours by default). Counts are refreshed every few minutes.
+// @Tags restaurants
+// @Produce json
+// @Param area query string false "Filter by area"
+// @Param cuisine_type query string false "Filter by cuisine type"
+// @Param limit query int false "Limit" default(20)
+// @Success 200 {object} TrendingRestaurantsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/trending [get]
+func (h *PopularityHandler) GetTrendingRestaurants(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(application.DefaultTrendingLimit)))
+	if err != nil || limit <= 0 || limit > application.MaxTrendingLimit {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+
+	results, err := h.service.GetTrending(c.Request.Context(), application.TrendingFilter{
+		Area:        c.Query("area"),
+		CuisineType: c.Query("cuisine_type"),
+		Limit:       limit,
+	})
+	if err != nil {
+		h.logger.Error("Failed to get trending restaurants", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to get trending restaurants",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTrendingRestaurantsResponse(results))
+}
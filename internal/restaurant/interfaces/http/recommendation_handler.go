@@ -0,0 +1,106 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RecommendationHandler handles personalized recommendation requests
+type RecommendationHandler struct {
+	service application.RecommendationService
+	logger  *zap.Logger
+}
+
+func NewRecommendationHandler(service application.RecommendationService, logger *zap.Logger) *RecommendationHandler {
+	return &RecommendationHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// GetRecommendations godoc
+// @Summary Recommend restaurants to a user
+// @Description Recommend restaurants within the radius that the user has not saved, best match first.
+// @Description Matches are scored on the cuisines, areas and price ranges of the user's favorites, rating
+// @Description and distance, and each comes with the reason it was picked. Users without favorites get
+// @Description the best rated restaurants nearby.
+// @Tags recommendations
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius_km query number false "Search radius in kilometers" default(2)
+// @Param limit query int false "Limit" default(10)
+// @Success 200 {object} RecommendationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/recommendations [get]
+func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	lat, latErr := strconv.ParseFloat(c.Query("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.Query("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_location",
+			Message: "lat and lng are required and must be numbers",
+		})
+		return
+	}
+
+	radiusKm, err := strconv.ParseFloat(c.DefaultQuery("radius_km", "0"), 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_radius",
+			Message: "radius_km must be a number",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(application.DefaultRecommendationLimit)))
+	if err != nil || limit <= 0 || limit > application.MaxRecommendationLimit {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", application.MaxRecommendationLimit),
+		})
+		return
+	}
+
+	recommendations, err := h.service.Recommend(c.Request.Context(), application.RecommendationRequest{
+		UserID:    userID,
+		Latitude:  lat,
+		Longitude: lng,
+		RadiusKm:  radiusKm,
+		Limit:     limit,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidLocation),
+			errors.Is(err, domainerrors.ErrInvalidRadius):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		default:
+			h.logger.Error("Failed to recommend restaurants", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to recommend restaurants",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toRecommendationsResponse(recommendations))
+}
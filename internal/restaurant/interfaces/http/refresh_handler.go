@@ -0,0 +1,93 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RefreshHandler serves the admin endpoints of the stale restaurant refresher
+type RefreshHandler struct {
+	refresher *application.Refresher
+	logger    *zap.Logger
+}
+
+func NewRefreshHandler(refresher *application.Refresher, logger *zap.Logger) *RefreshHandler {
+	return &RefreshHandler{
+		refresher: refresher,
+		logger:    logger,
+	}
+}
+
+// GetRefreshStatus godoc
+// @Summary Get the stale restaurant refresh status
+// @Description Return the refresh run in progress, the last finished run and the refresher settings (admin only)
+// @Tags admin
+// @Produce json
+// @Success 200 {object} RefreshStatusResponse
+// @Router /admin/restaurants/refresh [get]
+func (h *RefreshHandler) GetRefreshStatus(c *gin.Context) {
+	status := h.refresher.Status()
+
+	c.JSON(http.StatusOK, RefreshStatusResponse{
+		Running:   toRefreshRunDTO(status.Running),
+		Last:      toRefreshRunDTO(status.Last),
+		Interval:  status.Interval.String(),
+		BatchSize: status.BatchSize,
+		Quota:     status.Quota,
+		DryRun:    status.DryRun,
+	})
+}
+
+// TriggerRefresh godoc
+// @Summary Trigger a stale restaurant refresh
+// @Description Start refreshing restaurants past the data freshness TTL from the Map Service, most viewed
+// @Description and most favorited first. With dry_run the run only lists what it would refresh (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body TriggerRefreshRequest false "Refresh options"
+// @Success 202 {object} RefreshRunResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/restaurants/refresh [post]
+func (h *RefreshHandler) TriggerRefresh(c *gin.Context) {
+	var req TriggerRefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	run, err := h.refresher.Trigger(application.RefreshOptions{
+		DryRun: req.DryRun,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrRefreshInProgress) {
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "refresh_in_progress",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		h.logger.Error("Failed to trigger refresh", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to trigger refresh",
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, RefreshRunResponse{
+		Run: *toRefreshRunDTO(run),
+	})
+}
@@ -0,0 +1,543 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReviewHandler serves our users' reviews of restaurants and their moderation
+type ReviewHandler struct {
+	service application.ReviewService
+	logger  *zap.Logger
+}
+
+func NewReviewHandler(service application.ReviewService, logger *zap.Logger) *ReviewHandler {
+	return &ReviewHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListRestaurantReviews godoc
+// @Summary List restaurant reviews
+// @Description List the visible reviews of a restaurant
+// @Tags reviews
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param sort query string false "Sort order" Enums(newest, oldest, highest, lowest, helpful) default(newest)
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} ReviewListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/reviews [get]
+func (h *ReviewHandler) ListRestaurantReviews(c *gin.Context) {
+	restaurantID, ok := parseRestaurantIDParam(c)
+	if !ok {
+		return
+	}
+	req, ok := parseReviewListRequest(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.service.ListRestaurantReviews(c.Request.Context(), restaurantID, req)
+	if err != nil {
+		h.writeError(c, err, "Failed to list reviews")
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewListResponse(page))
+}
+
+// CreateReview godoc
+// @Summary Review a restaurant
+// @Description Write the authenticated user's review of a restaurant with a 1-5 score. Each user reviews a
+// @Description restaurant once and edits the review afterwards.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param request body ReviewRequest true "Review"
+// @Success 201 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/reviews [post]
+func (h *ReviewHandler) CreateReview(c *gin.Context) {
+	restaurantID, ok := parseRestaurantIDParam(c)
+	if !ok {
+		return
+	}
+	actor, ok := requireReviewActor(c)
+	if !ok {
+		return
+	}
+
+	var req ReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	review, err := h.service.CreateReview(c.Request.Context(), actor.UserID, restaurantID, application.ReviewInput{
+		Score:    req.Score,
+		Text:     req.Text,
+		Language: req.Language,
+	})
+	if err != nil {
+		h.writeError(c, err, "Failed to create review")
+		return
+	}
+
+	c.JSON(http.StatusCreated, ReviewResponse{Review: toReviewDTO(review)})
+}
+
+// GetReview godoc
+// @Summary Get a review
+// @Description Get a review. Hidden reviews are only found by their author and admins.
+// @Tags reviews
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Success 200 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{reviewId} [get]
+func (h *ReviewHandler) GetReview(c *gin.Context) {
+	reviewID, ok := parseReviewIDParam(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.service.GetReview(c.Request.Context(), reviewID, reviewActor(c))
+	if err != nil {
+		h.writeError(c, err, "Failed to get review")
+		return
+	}
+
+	c.JSON(http.StatusOK, ReviewResponse{Review: toReviewDTO(review)})
+}
+
+// GetReviewHistory godoc
+// @Summary Get review edit history
+// @Description List the previous versions of a review, newest first
+// @Tags reviews
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Success 200 {object} ReviewHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{reviewId}/history [get]
+func (h *ReviewHandler) GetReviewHistory(c *gin.Context) {
+	reviewID, ok := parseReviewIDParam(c)
+	if !ok {
+		return
+	}
+
+	edits, err := h.service.GetReviewHistory(c.Request.Context(), reviewID, reviewActor(c))
+	if err != nil {
+		h.writeError(c, err, "Failed to get review history")
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewHistoryResponse(reviewID.String(), edits))
+}
+
+// UpdateReview godoc
+// @Summary Edit a review
+// @Description Edit the authenticated user's review; the previous version is kept in its history
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Param request body ReviewRequest true "Review"
+// @Success 200 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{reviewId} [put]
+func (h *ReviewHandler) UpdateReview(c *gin.Context) {
+	reviewID, ok := parseReviewIDParam(c)
+	if !ok {
+		return
+	}
+	actor, ok := requireReviewActor(c)
+	if !ok {
+		return
+	}
+
+	var req ReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	review, err := h.service.UpdateReview(c.Request.Context(), reviewID, application.ReviewInput{
+		Score:    req.Score,
+		Text:     req.Text,
+		Language: req.Language,
+	}, actor)
+	if err != nil {
+		h.writeError(c, err, "Failed to update review")
+		return
+	}
+
+	c.JSON(http.StatusOK, ReviewResponse{Review: toReviewDTO(review)})
+}
+
+// DeleteReview godoc
+// @Summary Delete a review
+// @Description Delete the authenticated user's review; admins may delete any review
+// @Tags reviews
+// @Param reviewId path string true "Review ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{reviewId} [delete]
+func (h *ReviewHandler) DeleteReview(c *gin.Context) {
+	reviewID, ok := parseReviewIDParam(c)
+	if !ok {
+		return
+	}
+	actor, ok := requireReviewActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteReview(c.Request.Context(), reviewID, actor); err != nil {
+		h.writeError(c, err, "Failed to delete review")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// VoteHelpful godoc
+// @Summary Mark a review helpful
+// @Description Record that the authenticated user found a review helpful; voting twice has no effect
+// @Tags reviews
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Success 200 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{reviewId}/helpful [post]
+func (h *ReviewHandler) VoteHelpful(c *gin.Context) {
+	h.changeVote(c, h.service.VoteHelpful, "Failed to vote on review")
+}
+
+// RemoveHelpfulVote godoc
+// @Summary Withdraw a helpful vote
+// @Description Withdraw the authenticated user's helpful vote on a review
+// @Tags reviews
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Success 200 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{reviewId}/helpful [delete]
+func (h *ReviewHandler) RemoveHelpfulVote(c *gin.Context) {
+	h.changeVote(c, h.service.RemoveHelpfulVote, "Failed to withdraw vote")
+}
+
+type voteFunc func(ctx context.Context, userID, reviewID uuid.UUID) (*model.Review, error)
+
+func (h *ReviewHandler) changeVote(c *gin.Context, change voteFunc, message string) {
+	reviewID, ok := parseReviewIDParam(c)
+	if !ok {
+		return
+	}
+	actor, ok := requireReviewActor(c)
+	if !ok {
+		return
+	}
+
+	review, err := change(c.Request.Context(), actor.UserID, reviewID)
+	if err != nil {
+		h.writeError(c, err, message)
+		return
+	}
+
+	c.JSON(http.StatusOK, ReviewResponse{Review: toReviewDTO(review)})
+}
+
+// ListUserReviews godoc
+// @Summary List a user's reviews
+// @Description List a user's reviews; the user and admins also see hidden ones
+// @Tags reviews
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param sort query string false "Sort order" Enums(newest, oldest, highest, lowest, helpful) default(newest)
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} ReviewListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/reviews [get]
+func (h *ReviewHandler) ListUserReviews(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+	req, ok := parseReviewListRequest(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.service.ListUserReviews(c.Request.Context(), userID, req, reviewActor(c))
+	if err != nil {
+		h.writeError(c, err, "Failed to list reviews")
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewListResponse(page))
+}
+
+// ListReviewsForModeration godoc
+// @Summary List reviews for moderation
+// @Description List reviews of all restaurants, optionally only visible or hidden ones (admin only)
+// @Tags admin
+// @Produce json
+// @Param status query string false "Review status" Enums(visible, hidden)
+// @Param sort query string false "Sort order" Enums(newest, oldest, highest, lowest, helpful) default(newest)
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} ReviewListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reviews [get]
+func (h *ReviewHandler) ListReviewsForModeration(c *gin.Context) {
+	req, ok := parseReviewListRequest(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.service.ListReviewsForModeration(c.Request.Context(), model.ReviewStatus(c.Query("status")), req)
+	if err != nil {
+		h.writeError(c, err, "Failed to list reviews")
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewListResponse(page))
+}
+
+// HideReview godoc
+// @Summary Hide a review
+// @Description Take a review out of listings and the restaurant's review rating (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Param request body ModerateReviewRequest false "Moderation note"
+// @Success 200 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reviews/{reviewId}/hide [post]
+func (h *ReviewHandler) HideReview(c *gin.Context) {
+	h.moderate(c, h.service.HideReview, "Failed to hide review")
+}
+
+// RestoreReview godoc
+// @Summary Restore a review
+// @Description Make a hidden review visible again (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param reviewId path string true "Review ID"
+// @Param request body ModerateReviewRequest false "Moderation note"
+// @Success 200 {object} ReviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reviews/{reviewId}/restore [post]
+func (h *ReviewHandler) RestoreReview(c *gin.Context) {
+	h.moderate(c, h.service.RestoreReview, "Failed to restore review")
+}
+
+type moderateFunc func(ctx context.Context, reviewID uuid.UUID, moderatorID, note string) (*model.Review, error)
+
+func (h *ReviewHandler) moderate(c *gin.Context, change moderateFunc, message string) {
+	reviewID, ok := parseReviewIDParam(c)
+	if !ok {
+		return
+	}
+
+	// The note is optional, so an empty body is accepted
+	var req ModerateReviewRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	moderatorID, _ := middleware.GetUserID(c)
+	review, err := change(c.Request.Context(), reviewID, moderatorID, req.Note)
+	if err != nil {
+		h.writeError(c, err, message)
+		return
+	}
+
+	c.JSON(http.StatusOK, ReviewResponse{Review: toReviewDTO(review)})
+}
+
+// reviewActor is the authenticated user, or an anonymous actor when the
+// request carries no valid user
+func reviewActor(c *gin.Context) application.ReviewActor {
+	var actor application.ReviewActor
+	if userID, ok := middleware.GetUserID(c); ok {
+		actor.UserID, _ = uuid.Parse(userID)
+	}
+	if role, _ := middleware.GetUserRole(c); role == "admin" {
+		actor.IsAdmin = true
+	}
+	return actor
+}
+
+// requireReviewActor is the authenticated user, writing a 401 response when
+// the request carries no valid user ID
+func requireReviewActor(c *gin.Context) (application.ReviewActor, bool) {
+	actor := reviewActor(c)
+	if actor.UserID == uuid.Nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "unauthorized",
+			Message: "A valid user is required",
+		})
+		return actor, false
+	}
+	return actor, true
+}
+
+// parseRestaurantIDParam parses the id path parameter, writing a 400 response
+// when it is invalid
+func parseRestaurantIDParam(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// parseReviewIDParam parses the reviewId path parameter, writing a 400
+// response when it is invalid
+func parseReviewIDParam(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid review ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// parseReviewListRequest reads sort, limit and offset from the query string,
+// writing a 400 response when limit or offset is invalid
+func parseReviewListRequest(c *gin.Context) (application.ListReviewsRequest, bool) {
+	req := application.ListReviewsRequest{Sort: repository.ReviewSort(c.Query("sort"))}
+
+	var err error
+	req.Limit, err = strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || req.Limit <= 0 || req.Limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return req, false
+	}
+	req.Offset, err = strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || req.Offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_offset",
+			Message: "offset must not be negative",
+		})
+		return req, false
+	}
+	return req, true
+}
+
+func (h *ReviewHandler) writeError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidReviewScore),
+		errors.Is(err, domainerrors.ErrReviewTooLong),
+		errors.Is(err, domainerrors.ErrInvalidReviewLanguage),
+		errors.Is(err, domainerrors.ErrInvalidReviewStatus),
+		errors.Is(err, domainerrors.ErrInvalidReviewSort):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrCannotVoteOwnReview):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error:   "forbidden",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrReviewNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Review not found",
+		})
+	case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Restaurant not found",
+		})
+	case errors.Is(err, domainerrors.ErrVoteNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrReviewAlreadyExists):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error:   "already_exists",
+			Message: err.Error(),
+		})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
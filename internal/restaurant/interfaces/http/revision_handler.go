@@ -0,0 +1,154 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RevisionHandler serves the change history of restaurants
+type RevisionHandler struct {
+	service application.RevisionService
+	logger  *zap.Logger
+}
+
+func NewRevisionHandler(service application.RevisionService, logger *zap.Logger) *RevisionHandler {
+	return &RevisionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListRevisions godoc
+// @Summary List restaurant revisions
+// @Description List the change history of a restaurant, newest first. Each revision has the changed fields
+// @Description with their before/after values, the user who made the change and its source
+// @Description (user_edit, map_refresh, scrape, revert or import).
+// @Tags restaurants
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} RevisionListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/revisions [get]
+func (h *RevisionHandler) ListRevisions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_offset",
+			Message: "offset must not be negative",
+		})
+		return
+	}
+
+	revisions, total, err := h.service.ListRevisions(c.Request.Context(), id, limit, offset)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrRestaurantNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+			return
+		}
+
+		h.logger.Error("Failed to list revisions", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to list revisions",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, RevisionListResponse{
+		Revisions: toRevisionDTOList(revisions),
+		Total:     total,
+	})
+}
+
+// RevertToRevision godoc
+// @Summary Revert a restaurant to a revision
+// @Description Restore the fields changed after the given revision to their value at that revision (admin only).
+// @Description The revert is recorded as a new revision. The Tabelog listing is not restored.
+// @Tags restaurants
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param revisionId path string true "Revision ID"
+// @Success 200 {object} RestaurantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/revisions/{revisionId}/revert [post]
+func (h *RevisionHandler) RevertToRevision(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+	revisionID, err := uuid.Parse(c.Param("revisionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid revision ID",
+		})
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+
+	restaurant, err := h.service.RevertToRevision(c.Request.Context(), id, revisionID, actorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrRevisionNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Revision not found",
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		default:
+			h.logger.Error("Failed to revert restaurant", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to revert restaurant",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(restaurant),
+	})
+}
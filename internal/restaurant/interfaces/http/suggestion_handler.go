@@ -0,0 +1,213 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SuggestionHandler serves the moderation queue of restaurant edit suggestions
+type SuggestionHandler struct {
+	service application.SuggestionService
+	logger  *zap.Logger
+}
+
+func NewSuggestionHandler(service application.SuggestionService, logger *zap.Logger) *SuggestionHandler {
+	return &SuggestionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// editorFromContext returns the authenticated user making an edit
+func editorFromContext(c *gin.Context) application.Editor {
+	userID, _ := middleware.GetUserID(c)
+	role, _ := middleware.GetUserRole(c)
+	return application.Editor{UserID: userID, Role: role}
+}
+
+// ListSuggestions godoc
+// @Summary List edit suggestions
+// @Description List restaurant edit suggestions, oldest first. Moderators (admins and trusted users) see all
+// @Description suggestions; other users only see their own.
+// @Tags restaurants
+// @Produce json
+// @Param status query string false "Status: pending, approved, rejected or all" default(pending)
+// @Param restaurant_id query string false "Restaurant ID"
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} SuggestionListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/suggestions [get]
+func (h *SuggestionHandler) ListSuggestions(c *gin.Context) {
+	var query repository.SuggestionQuery
+
+	switch status := c.DefaultQuery("status", string(model.SuggestionPending)); model.SuggestionStatus(status) {
+	case model.SuggestionPending, model.SuggestionApproved, model.SuggestionRejected:
+		query.Status = model.SuggestionStatus(status)
+	case "all":
+	default:
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_status",
+			Message: "status must be pending, approved, rejected or all",
+		})
+		return
+	}
+
+	if restaurantID := c.Query("restaurant_id"); restaurantID != "" {
+		id, err := uuid.Parse(restaurantID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_id",
+				Message: "Invalid restaurant ID",
+			})
+			return
+		}
+		query.RestaurantID = &id
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_offset",
+			Message: "offset must not be negative",
+		})
+		return
+	}
+	query.Limit = limit
+	query.Offset = offset
+
+	suggestions, total, err := h.service.ListSuggestions(c.Request.Context(), query, editorFromContext(c))
+	if err != nil {
+		h.logger.Error("Failed to list edit suggestions", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to list edit suggestions",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuggestionListResponse{
+		Suggestions: toSuggestionDTOList(suggestions),
+		Total:       total,
+	})
+}
+
+// ApproveSuggestion godoc
+// @Summary Approve an edit suggestion
+// @Description Apply a pending edit suggestion to its restaurant (moderators only)
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param suggestionId path string true "Suggestion ID"
+// @Param request body ReviewSuggestionRequest false "Review note"
+// @Success 200 {object} SuggestionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/suggestions/{suggestionId}/approve [post]
+func (h *SuggestionHandler) ApproveSuggestion(c *gin.Context) {
+	h.review(c, h.service.ApproveSuggestion)
+}
+
+// RejectSuggestion godoc
+// @Summary Reject an edit suggestion
+// @Description Reject a pending edit suggestion (moderators only)
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param suggestionId path string true "Suggestion ID"
+// @Param request body ReviewSuggestionRequest false "Review note"
+// @Success 200 {object} SuggestionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/suggestions/{suggestionId}/reject [post]
+func (h *SuggestionHandler) RejectSuggestion(c *gin.Context) {
+	h.review(c, h.service.RejectSuggestion)
+}
+
+type reviewFunc func(ctx context.Context, id uuid.UUID, editor application.Editor, note string) (*model.EditSuggestion, error)
+
+func (h *SuggestionHandler) review(c *gin.Context, review reviewFunc) {
+	id, err := uuid.Parse(c.Param("suggestionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid suggestion ID",
+		})
+		return
+	}
+
+	// The note is optional, so an empty body is accepted
+	var req ReviewSuggestionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	suggestion, err := review(c.Request.Context(), id, editorFromContext(c), req.Note)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrNotModerator), errors.Is(err, domainerrors.ErrOwnSuggestion):
+			c.JSON(http.StatusForbidden, ErrorResponse{
+				Error:   "forbidden",
+				Message: err.Error(),
+			})
+		case errors.Is(err, domainerrors.ErrSuggestionNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Edit suggestion not found",
+			})
+		case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error:   "not_found",
+				Message: "Restaurant not found",
+			})
+		case errors.Is(err, domainerrors.ErrSuggestionNotPending):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error:   "already_reviewed",
+				Message: err.Error(),
+			})
+		default:
+			h.logger.Error("Failed to review edit suggestion", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to review edit suggestion",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, SuggestionResponse{
+		Suggestion: toSuggestionDTO(suggestion),
+	})
+}
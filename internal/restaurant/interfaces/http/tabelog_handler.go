@@ -0,0 +1,173 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/pkg/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// TabelogHandler serves the endpoints linking restaurants to Tabelog listings
+type TabelogHandler struct {
+	service application.TabelogService
+	logger  *zap.Logger
+}
+
+func NewTabelogHandler(service application.TabelogService, logger *zap.Logger) *TabelogHandler {
+	return &TabelogHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// MatchTabelogListings godoc
+// @Summary Match Tabelog listings to a restaurant
+// @Description Search Tabelog for the restaurant through the spider service, score the listings found and
+// @Description attach the best one when it matches with high confidence. A confirmed listing is never replaced.
+// @Description The listings are returned so that the user can confirm one of them.
+// @Tags restaurants
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} TabelogMatchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/tabelog/match [post]
+func (h *TabelogHandler) MatchTabelogListings(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	result, err := h.service.MatchTabelogListings(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err, "Failed to match tabelog listings")
+		return
+	}
+
+	c.JSON(http.StatusOK, TabelogMatchResponse{
+		Restaurant: toRestaurantDTO(result.Restaurant),
+		Listings:   result.Listings,
+		Best:       result.Best,
+		Score:      result.Match.Score,
+		Attached:   result.Attached,
+	})
+}
+
+// ConfirmTabelogListing godoc
+// @Summary Confirm the Tabelog listing of a restaurant
+// @Description Attach the Tabelog listing chosen by the user, replacing any previous one. The listing must be
+// @Description one of those the spider service finds for the restaurant; its details are loaded from there.
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param request body ConfirmTabelogListingRequest true "Link of the chosen listing"
+// @Success 200 {object} RestaurantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/tabelog [put]
+func (h *TabelogHandler) ConfirmTabelogListing(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	var req ConfirmTabelogListingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+
+	restaurant, err := h.service.ConfirmTabelogListing(c.Request.Context(), id, req.Link, actorID)
+	if err != nil {
+		h.handleError(c, err, "Failed to confirm tabelog listing")
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(restaurant),
+	})
+}
+
+// DetachTabelogListing godoc
+// @Summary Remove the Tabelog listing of a restaurant
+// @Description Unlink a wrongly matched Tabelog listing. Admin only.
+// @Tags restaurants
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} RestaurantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /restaurants/{id}/tabelog [delete]
+func (h *TabelogHandler) DetachTabelogListing(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+
+	restaurant, err := h.service.DetachTabelogListing(c.Request.Context(), id, actorID)
+	if err != nil {
+		h.handleError(c, err, "Failed to detach tabelog listing")
+		return
+	}
+
+	c.JSON(http.StatusOK, RestaurantResponse{
+		Restaurant: toRestaurantDTO(restaurant),
+	})
+}
+
+func (h *TabelogHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Restaurant not found",
+		})
+	case errors.Is(err, domainerrors.ErrTabelogListingNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "listing_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrInvalidTabelogListing):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_listing",
+			Message: err.Error(),
+		})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,442 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/application"
+	domainerrors "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/errors"
+	"github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// VisitHandler serves users' visit history and the stats computed from it
+type VisitHandler struct {
+	service application.VisitService
+	logger  *zap.Logger
+}
+
+func NewVisitHandler(service application.VisitService, logger *zap.Logger) *VisitHandler {
+	return &VisitHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListVisits godoc
+// @Summary List visits
+// @Description List the user's visits, newest first, optionally of one restaurant or within a date range
+// @Tags visits
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param restaurant_id query string false "Only visits of this restaurant"
+// @Param from query string false "Visits on or after this date (2006-01-02)"
+// @Param to query string false "Visits on or before this date (2006-01-02)"
+// @Param limit query int false "Limit" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} VisitListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits [get]
+func (h *VisitHandler) ListVisits(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req application.ListVisitsRequest
+	if raw := c.Query("restaurant_id"); raw != "" {
+		restaurantID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "invalid_id",
+				Message: "Invalid restaurant ID",
+			})
+			return
+		}
+		req.RestaurantID = &restaurantID
+	}
+	if req.From, ok = parseDateQuery(c, "from"); !ok {
+		return
+	}
+	if req.To, ok = parseDateQuery(c, "to"); !ok {
+		return
+	}
+
+	var err error
+	req.Limit, err = strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || req.Limit <= 0 || req.Limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+	req.Offset, err = strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || req.Offset < 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_offset",
+			Message: "offset must not be negative",
+		})
+		return
+	}
+
+	page, err := h.service.ListVisits(c.Request.Context(), userID, req)
+	if err != nil {
+		h.writeError(c, err, "Failed to list visits")
+		return
+	}
+
+	c.JSON(http.StatusOK, VisitListResponse{
+		Visits: toVisitDTOList(page.Visits),
+		Total:  page.Total,
+	})
+}
+
+// LogVisit godoc
+// @Summary Log a visit
+// @Description Record a visit of a restaurant with its date, party size, a personal 1-5 rating, the dishes
+// @Description ordered, the amount spent and photo links. Visits of a favorite also update its visit count.
+// @Tags visits
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param request body LogVisitRequest true "Visit"
+// @Success 201 {object} VisitResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits [post]
+func (h *VisitHandler) LogVisit(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req LogVisitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	restaurantID, err := uuid.Parse(req.RestaurantID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid restaurant ID",
+		})
+		return
+	}
+	details, ok := parseVisitDetails(c, req.VisitDetailsRequest)
+	if !ok {
+		return
+	}
+
+	visit, err := h.service.LogVisit(c.Request.Context(), userID, restaurantID, details)
+	if err != nil {
+		h.writeError(c, err, "Failed to log visit")
+		return
+	}
+
+	c.JSON(http.StatusCreated, VisitResponse{Visit: toVisitDTO(visit)})
+}
+
+// GetVisit godoc
+// @Summary Get a visit
+// @Description Get one of the user's visits
+// @Tags visits
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param visitId path string true "Visit ID"
+// @Success 200 {object} VisitResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits/{visitId} [get]
+func (h *VisitHandler) GetVisit(c *gin.Context) {
+	userID, visitID, ok := parseVisitPath(c)
+	if !ok {
+		return
+	}
+
+	visit, err := h.service.GetVisit(c.Request.Context(), userID, visitID)
+	if err != nil {
+		h.writeError(c, err, "Failed to get visit")
+		return
+	}
+
+	c.JSON(http.StatusOK, VisitResponse{Visit: toVisitDTO(visit)})
+}
+
+// UpdateVisit godoc
+// @Summary Update a visit
+// @Description Replace what is recorded about a visit; fields left out are cleared
+// @Tags visits
+// @Accept json
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param visitId path string true "Visit ID"
+// @Param request body VisitDetailsRequest true "Visit details"
+// @Success 200 {object} VisitResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits/{visitId} [put]
+func (h *VisitHandler) UpdateVisit(c *gin.Context) {
+	userID, visitID, ok := parseVisitPath(c)
+	if !ok {
+		return
+	}
+
+	var req VisitDetailsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+	details, ok := parseVisitDetails(c, req)
+	if !ok {
+		return
+	}
+
+	visit, err := h.service.UpdateVisit(c.Request.Context(), userID, visitID, details)
+	if err != nil {
+		h.writeError(c, err, "Failed to update visit")
+		return
+	}
+
+	c.JSON(http.StatusOK, VisitResponse{Visit: toVisitDTO(visit)})
+}
+
+// DeleteVisit godoc
+// @Summary Delete a visit
+// @Description Delete one of the user's visits
+// @Tags visits
+// @Param userId path string true "User ID"
+// @Param visitId path string true "Visit ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits/{visitId} [delete]
+func (h *VisitHandler) DeleteVisit(c *gin.Context) {
+	userID, visitID, ok := parseVisitPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteVisit(c.Request.Context(), userID, visitID); err != nil {
+		h.writeError(c, err, "Failed to delete visit")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetMonthlyVisits godoc
+// @Summary Visits per month
+// @Description Count the user's visits and distinct restaurants in each of the last months, oldest first
+// @Tags visits
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param months query int false "Number of months" default(12)
+// @Success 200 {object} MonthlyVisitsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits/stats/monthly [get]
+func (h *VisitHandler) GetMonthlyVisits(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	months, err := strconv.Atoi(c.DefaultQuery("months", strconv.Itoa(application.DefaultVisitStatsMonths)))
+	if err != nil || months <= 0 || months > application.MaxVisitStatsMonths {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_months",
+			Message: "months must be between 1 and " + strconv.Itoa(application.MaxVisitStatsMonths),
+		})
+		return
+	}
+
+	counts, err := h.service.GetMonthlyVisits(c.Request.Context(), userID, months)
+	if err != nil {
+		h.writeError(c, err, "Failed to count visits")
+		return
+	}
+
+	c.JSON(http.StatusOK, toMonthlyVisitsResponse(counts))
+}
+
+// GetSpendByCuisine godoc
+// @Summary Spend per cuisine
+// @Description Sum the user's recorded spend per cuisine, separately for each currency, highest total first
+// @Tags visits
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} SpendByCuisineResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits/stats/spend-by-cuisine [get]
+func (h *VisitHandler) GetSpendByCuisine(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	spend, err := h.service.GetSpendByCuisine(c.Request.Context(), userID)
+	if err != nil {
+		h.writeError(c, err, "Failed to sum spend")
+		return
+	}
+
+	c.JSON(http.StatusOK, toSpendByCuisineResponse(spend))
+}
+
+// GetMostRevisited godoc
+// @Summary Most revisited restaurants
+// @Description List the restaurants the user visited more than once, most visits first
+// @Tags visits
+// @Produce json
+// @Param userId path string true "User ID"
+// @Param limit query int false "Limit" default(10)
+// @Success 200 {object} MostRevisitedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{userId}/visits/stats/most-revisited [get]
+func (h *VisitHandler) GetMostRevisited(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit <= 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_limit",
+			Message: "limit must be between 1 and 100",
+		})
+		return
+	}
+
+	places, err := h.service.GetMostRevisited(c.Request.Context(), userID, limit)
+	if err != nil {
+		h.writeError(c, err, "Failed to find most revisited restaurants")
+		return
+	}
+
+	c.JSON(http.StatusOK, toMostRevisitedResponse(places))
+}
+
+// parseVisitPath parses the userId and visitId path parameters, writing a 400
+// response when either is invalid
+func parseVisitPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	visitID, err := uuid.Parse(c.Param("visitId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid visit ID",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, visitID, true
+}
+
+// parseDateQuery parses an optional date query parameter, writing a 400
+// response when it is not a date
+func parseDateQuery(c *gin.Context, param string) (*time.Time, bool) {
+	raw := c.Query(param)
+	if raw == "" {
+		return nil, true
+	}
+
+	date, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_date",
+			Message: param + " must be a date such as 2006-01-02",
+		})
+		return nil, false
+	}
+	return &date, true
+}
+
+// parseVisitDetails converts the request, writing a 400 response when the
+// visit date is not a date
+func parseVisitDetails(c *gin.Context, req VisitDetailsRequest) (model.VisitDetails, bool) {
+	visitedOn, err := time.Parse(time.DateOnly, req.VisitedOn)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_date",
+			Message: "visited_on must be a date such as 2006-01-02",
+		})
+		return model.VisitDetails{}, false
+	}
+
+	details := model.VisitDetails{
+		VisitedOn: visitedOn,
+		PartySize: req.PartySize,
+		Rating:    req.Rating,
+		Dishes:    req.Dishes,
+		PhotoURLs: req.PhotoURLs,
+	}
+	if req.Spend != nil {
+		spend := model.NewMoney(req.Spend.Amount, req.Spend.Currency)
+		details.Spend = &spend
+	}
+	return details, true
+}
+
+func (h *VisitHandler) writeError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidVisitDate),
+		errors.Is(err, domainerrors.ErrInvalidPartySize),
+		errors.Is(err, domainerrors.ErrInvalidVisitRating),
+		errors.Is(err, domainerrors.ErrInvalidVisitSpend),
+		errors.Is(err, domainerrors.ErrInvalidVisitDishes),
+		errors.Is(err, domainerrors.ErrInvalidVisitPhotos):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domainerrors.ErrVisitNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Visit not found",
+		})
+	case errors.Is(err, domainerrors.ErrRestaurantNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "not_found",
+			Message: "Restaurant not found",
+		})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
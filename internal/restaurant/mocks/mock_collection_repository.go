@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/collection_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/collection_repository.go -destination=internal/restaurant/mocks/mock_collection_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCollectionRepository is a mock of CollectionRepository interface.
+type MockCollectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCollectionRepositoryMockRecorder is the mock recorder for MockCollectionRepository.
+type MockCollectionRepositoryMockRecorder struct {
+	mock *MockCollectionRepository
+}
+
+// NewMockCollectionRepository creates a new mock instance.
+func NewMockCollectionRepository(ctrl *gomock.Controller) *MockCollectionRepository {
+	mock := &MockCollectionRepository{ctrl: ctrl}
+	mock.recorder = &MockCollectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollectionRepository) EXPECT() *MockCollectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockCollectionRepository) AddItem(ctx context.Context, collectionID, favoriteID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, collectionID, favoriteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockCollectionRepositoryMockRecorder) AddItem(ctx, collectionID, favoriteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockCollectionRepository)(nil).AddItem), ctx, collectionID, favoriteID)
+}
+
+// Create mocks base method.
+func (m *MockCollectionRepository) Create(ctx context.Context, collection *model.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCollectionRepositoryMockRecorder) Create(ctx, collection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollectionRepository)(nil).Create), ctx, collection)
+}
+
+// Delete mocks base method.
+func (m *MockCollectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCollectionRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCollectionRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockCollectionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCollectionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCollectionRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByShareSlug mocks base method.
+func (m *MockCollectionRepository) FindByShareSlug(ctx context.Context, slug string) (*model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByShareSlug", ctx, slug)
+	ret0, _ := ret[0].(*model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByShareSlug indicates an expected call of FindByShareSlug.
+func (mr *MockCollectionRepositoryMockRecorder) FindByShareSlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByShareSlug", reflect.TypeOf((*MockCollectionRepository)(nil).FindByShareSlug), ctx, slug)
+}
+
+// FindByUserID mocks base method.
+func (m *MockCollectionRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockCollectionRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockCollectionRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// ListItems mocks base method.
+func (m *MockCollectionRepository) ListItems(ctx context.Context, collectionID uuid.UUID) ([]*model.CollectionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", ctx, collectionID)
+	ret0, _ := ret[0].([]*model.CollectionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockCollectionRepositoryMockRecorder) ListItems(ctx, collectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockCollectionRepository)(nil).ListItems), ctx, collectionID)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCollectionRepository) RemoveItem(ctx context.Context, collectionID, favoriteID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, collectionID, favoriteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCollectionRepositoryMockRecorder) RemoveItem(ctx, collectionID, favoriteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCollectionRepository)(nil).RemoveItem), ctx, collectionID, favoriteID)
+}
+
+// ReorderItems mocks base method.
+func (m *MockCollectionRepository) ReorderItems(ctx context.Context, collectionID uuid.UUID, favoriteIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItems", ctx, collectionID, favoriteIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderItems indicates an expected call of ReorderItems.
+func (mr *MockCollectionRepositoryMockRecorder) ReorderItems(ctx, collectionID, favoriteIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItems", reflect.TypeOf((*MockCollectionRepository)(nil).ReorderItems), ctx, collectionID, favoriteIDs)
+}
+
+// Update mocks base method.
+func (m *MockCollectionRepository) Update(ctx context.Context, collection *model.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCollectionRepositoryMockRecorder) Update(ctx, collection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCollectionRepository)(nil).Update), ctx, collection)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -175,6 +176,22 @@ func (mr *MockFavoriteRepositoryMockRecorder) FindByUserID(ctx, userID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockFavoriteRepository)(nil).FindByUserID), ctx, userID)
 }
 
+// FindByUserIDAfter mocks base method.
+func (m *MockFavoriteRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, after *repository.Cursor, limit int) ([]*model.Favorite, *repository.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAfter", ctx, userID, after, limit)
+	ret0, _ := ret[0].([]*model.Favorite)
+	ret1, _ := ret[1].(*repository.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUserIDAfter indicates an expected call of FindByUserIDAfter.
+func (mr *MockFavoriteRepositoryMockRecorder) FindByUserIDAfter(ctx, userID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAfter", reflect.TypeOf((*MockFavoriteRepository)(nil).FindByUserIDAfter), ctx, userID, after, limit)
+}
+
 // Update mocks base method.
 func (m *MockFavoriteRepository) Update(ctx context.Context, favorite *model.Favorite) error {
 	m.ctrl.T.Helper()
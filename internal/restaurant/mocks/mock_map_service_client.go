@@ -41,17 +41,48 @@ func (m *MockMapServiceClient) EXPECT() *MockMapServiceClientMockRecorder {
 	return m.recorder
 }
 
+// BatchGetPlaces mocks base method.
+func (m *MockMapServiceClient) BatchGetPlaces(ctx context.Context, placeIDs []string, languageCode string) ([]*mapv1.Place, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetPlaces", ctx, placeIDs, languageCode)
+	ret0, _ := ret[0].([]*mapv1.Place)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetPlaces indicates an expected call of BatchGetPlaces.
+func (mr *MockMapServiceClientMockRecorder) BatchGetPlaces(ctx, placeIDs, languageCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetPlaces", reflect.TypeOf((*MockMapServiceClient)(nil).BatchGetPlaces), ctx, placeIDs, languageCode)
+}
+
+// GetPhotoMedia mocks base method.
+func (m *MockMapServiceClient) GetPhotoMedia(ctx context.Context, photoName string, maxWidthPx int) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhotoMedia", ctx, photoName, maxWidthPx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPhotoMedia indicates an expected call of GetPhotoMedia.
+func (mr *MockMapServiceClientMockRecorder) GetPhotoMedia(ctx, photoName, maxWidthPx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhotoMedia", reflect.TypeOf((*MockMapServiceClient)(nil).GetPhotoMedia), ctx, photoName, maxWidthPx)
+}
+
 // QuickSearch mocks base method.
-func (m *MockMapServiceClient) QuickSearch(ctx context.Context, placeID string) (*mapv1.Place, error) {
+func (m *MockMapServiceClient) QuickSearch(ctx context.Context, placeID, languageCode string) (*mapv1.Place, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QuickSearch", ctx, placeID)
+	ret := m.ctrl.Call(m, "QuickSearch", ctx, placeID, languageCode)
 	ret0, _ := ret[0].(*mapv1.Place)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QuickSearch indicates an expected call of QuickSearch.
-func (mr *MockMapServiceClientMockRecorder) QuickSearch(ctx, placeID any) *gomock.Call {
+func (mr *MockMapServiceClientMockRecorder) QuickSearch(ctx, placeID, languageCode any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuickSearch", reflect.TypeOf((*MockMapServiceClient)(nil).QuickSearch), ctx, placeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuickSearch", reflect.TypeOf((*MockMapServiceClient)(nil).QuickSearch), ctx, placeID, languageCode)
 }
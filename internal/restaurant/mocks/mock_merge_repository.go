@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/merge_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/merge_repository.go -destination=internal/restaurant/mocks/mock_merge_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMergeRepository is a mock of MergeRepository interface.
+type MockMergeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMergeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMergeRepositoryMockRecorder is the mock recorder for MockMergeRepository.
+type MockMergeRepositoryMockRecorder struct {
+	mock *MockMergeRepository
+}
+
+// NewMockMergeRepository creates a new mock instance.
+func NewMockMergeRepository(ctrl *gomock.Controller) *MockMergeRepository {
+	mock := &MockMergeRepository{ctrl: ctrl}
+	mock.recorder = &MockMergeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMergeRepository) EXPECT() *MockMergeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByCanonicalID mocks base method.
+func (m *MockMergeRepository) FindByCanonicalID(ctx context.Context, canonicalID uuid.UUID) ([]*model.RestaurantMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCanonicalID", ctx, canonicalID)
+	ret0, _ := ret[0].([]*model.RestaurantMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCanonicalID indicates an expected call of FindByCanonicalID.
+func (mr *MockMergeRepositoryMockRecorder) FindByCanonicalID(ctx, canonicalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCanonicalID", reflect.TypeOf((*MockMergeRepository)(nil).FindByCanonicalID), ctx, canonicalID)
+}
+
+// FindByID mocks base method.
+func (m *MockMergeRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.RestaurantMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMergeRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMergeRepository)(nil).FindByID), ctx, id)
+}
+
+// FindDuplicatePairs mocks base method.
+func (m *MockMergeRepository) FindDuplicatePairs(ctx context.Context, query repository.DuplicateQuery) ([]*repository.RestaurantPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicatePairs", ctx, query)
+	ret0, _ := ret[0].([]*repository.RestaurantPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicatePairs indicates an expected call of FindDuplicatePairs.
+func (mr *MockMergeRepositoryMockRecorder) FindDuplicatePairs(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicatePairs", reflect.TypeOf((*MockMergeRepository)(nil).FindDuplicatePairs), ctx, query)
+}
+
+// Merge mocks base method.
+func (m *MockMergeRepository) Merge(ctx context.Context, merge *model.RestaurantMerge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, merge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockMergeRepositoryMockRecorder) Merge(ctx, merge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockMergeRepository)(nil).Merge), ctx, merge)
+}
+
+// Unmerge mocks base method.
+func (m *MockMergeRepository) Unmerge(ctx context.Context, merge *model.RestaurantMerge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmerge", ctx, merge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmerge indicates an expected call of Unmerge.
+func (mr *MockMergeRepositoryMockRecorder) Unmerge(ctx, merge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmerge", reflect.TypeOf((*MockMergeRepository)(nil).Unmerge), ctx, merge)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/outbox_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/outbox_repository.go -destination=internal/restaurant/mocks/mock_outbox_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountPending mocks base method.
+func (m *MockOutboxRepository) CountPending(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPending", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPending indicates an expected call of CountPending.
+func (mr *MockOutboxRepositoryMockRecorder) CountPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPending", reflect.TypeOf((*MockOutboxRepository)(nil).CountPending), ctx)
+}
+
+// DeadLetterPending mocks base method.
+func (m *MockOutboxRepository) DeadLetterPending(ctx context.Context, maxAttempts int) ([]*model.DomainEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterPending", ctx, maxAttempts)
+	ret0, _ := ret[0].([]*model.DomainEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetterPending indicates an expected call of DeadLetterPending.
+func (mr *MockOutboxRepositoryMockRecorder) DeadLetterPending(ctx, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterPending", reflect.TypeOf((*MockOutboxRepository)(nil).DeadLetterPending), ctx, maxAttempts)
+}
+
+// DeletePublishedBefore mocks base method.
+func (m *MockOutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublishedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePublishedBefore indicates an expected call of DeletePublishedBefore.
+func (mr *MockOutboxRepositoryMockRecorder) DeletePublishedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublishedBefore", reflect.TypeOf((*MockOutboxRepository)(nil).DeletePublishedBefore), ctx, before)
+}
+
+// ProcessPending mocks base method.
+func (m *MockOutboxRepository) ProcessPending(ctx context.Context, limit int, handler repository.OutboxHandler) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessPending", ctx, limit, handler)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessPending indicates an expected call of ProcessPending.
+func (mr *MockOutboxRepositoryMockRecorder) ProcessPending(ctx, limit, handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPending", reflect.TypeOf((*MockOutboxRepository)(nil).ProcessPending), ctx, limit, handler)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/photo_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/photo_repository.go -destination=internal/restaurant/mocks/mock_photo_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPhotoRepository is a mock of PhotoRepository interface.
+type MockPhotoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPhotoRepositoryMockRecorder is the mock recorder for MockPhotoRepository.
+type MockPhotoRepositoryMockRecorder struct {
+	mock *MockPhotoRepository
+}
+
+// NewMockPhotoRepository creates a new mock instance.
+func NewMockPhotoRepository(ctrl *gomock.Controller) *MockPhotoRepository {
+	mock := &MockPhotoRepository{ctrl: ctrl}
+	mock.recorder = &MockPhotoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoRepository) EXPECT() *MockPhotoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPhotoRepository) Create(ctx context.Context, photo *model.Photo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPhotoRepositoryMockRecorder) Create(ctx, photo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPhotoRepository)(nil).Create), ctx, photo)
+}
+
+// FindByID mocks base method.
+func (m *MockPhotoRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPhotoRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPhotoRepository)(nil).FindByID), ctx, id)
+}
+
+// ListByRestaurant mocks base method.
+func (m *MockPhotoRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*model.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRestaurant", ctx, restaurantID)
+	ret0, _ := ret[0].([]*model.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRestaurant indicates an expected call of ListByRestaurant.
+func (mr *MockPhotoRepositoryMockRecorder) ListByRestaurant(ctx, restaurantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRestaurant", reflect.TypeOf((*MockPhotoRepository)(nil).ListByRestaurant), ctx, restaurantID)
+}
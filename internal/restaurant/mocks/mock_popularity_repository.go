@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/popularity_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/popularity_repository.go -destination=internal/restaurant/mocks/mock_popularity_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPopularityCounter is a mock of PopularityCounter interface.
+type MockPopularityCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPopularityCounterMockRecorder
+	isgomock struct{}
+}
+
+// MockPopularityCounterMockRecorder is the mock recorder for MockPopularityCounter.
+type MockPopularityCounterMockRecorder struct {
+	mock *MockPopularityCounter
+}
+
+// NewMockPopularityCounter creates a new mock instance.
+func NewMockPopularityCounter(ctrl *gomock.Controller) *MockPopularityCounter {
+	mock := &MockPopularityCounter{ctrl: ctrl}
+	mock.recorder = &MockPopularityCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPopularityCounter) EXPECT() *MockPopularityCounterMockRecorder {
+	return m.recorder
+}
+
+// Buckets mocks base method.
+func (m *MockPopularityCounter) Buckets(ctx context.Context) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Buckets", ctx)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Buckets indicates an expected call of Buckets.
+func (mr *MockPopularityCounterMockRecorder) Buckets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Buckets", reflect.TypeOf((*MockPopularityCounter)(nil).Buckets), ctx)
+}
+
+// Counts mocks base method.
+func (m *MockPopularityCounter) Counts(ctx context.Context, bucket time.Time) ([]repository.PopularityCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Counts", ctx, bucket)
+	ret0, _ := ret[0].([]repository.PopularityCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Counts indicates an expected call of Counts.
+func (mr *MockPopularityCounterMockRecorder) Counts(ctx, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Counts", reflect.TypeOf((*MockPopularityCounter)(nil).Counts), ctx, bucket)
+}
+
+// DeleteBucket mocks base method.
+func (m *MockPopularityCounter) DeleteBucket(ctx context.Context, bucket time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucket", ctx, bucket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBucket indicates an expected call of DeleteBucket.
+func (mr *MockPopularityCounterMockRecorder) DeleteBucket(ctx, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockPopularityCounter)(nil).DeleteBucket), ctx, bucket)
+}
+
+// Incr mocks base method.
+func (m *MockPopularityCounter) Incr(ctx context.Context, restaurantID uuid.UUID, event model.PopularityEvent, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, restaurantID, event, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockPopularityCounterMockRecorder) Incr(ctx, restaurantID, event, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockPopularityCounter)(nil).Incr), ctx, restaurantID, event, at)
+}
+
+// MockPopularityRepository is a mock of PopularityRepository interface.
+type MockPopularityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPopularityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPopularityRepositoryMockRecorder is the mock recorder for MockPopularityRepository.
+type MockPopularityRepositoryMockRecorder struct {
+	mock *MockPopularityRepository
+}
+
+// NewMockPopularityRepository creates a new mock instance.
+func NewMockPopularityRepository(ctrl *gomock.Controller) *MockPopularityRepository {
+	mock := &MockPopularityRepository{ctrl: ctrl}
+	mock.recorder = &MockPopularityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPopularityRepository) EXPECT() *MockPopularityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindTrending mocks base method.
+func (m *MockPopularityRepository) FindTrending(ctx context.Context, query repository.TrendingQuery) ([]*repository.TrendingRestaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrending", ctx, query)
+	ret0, _ := ret[0].([]*repository.TrendingRestaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrending indicates an expected call of FindTrending.
+func (mr *MockPopularityRepositoryMockRecorder) FindTrending(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrending", reflect.TypeOf((*MockPopularityRepository)(nil).FindTrending), ctx, query)
+}
+
+// SaveCounts mocks base method.
+func (m *MockPopularityRepository) SaveCounts(ctx context.Context, counts []repository.PopularityCount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCounts", ctx, counts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCounts indicates an expected call of SaveCounts.
+func (mr *MockPopularityRepositoryMockRecorder) SaveCounts(ctx, counts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCounts", reflect.TypeOf((*MockPopularityRepository)(nil).SaveCounts), ctx, counts)
+}
@@ -12,8 +12,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -57,6 +59,21 @@ func (mr *MockRestaurantRepositoryMockRecorder) Count(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRestaurantRepository)(nil).Count), ctx)
 }
 
+// CountStale mocks base method.
+func (m *MockRestaurantRepository) CountStale(ctx context.Context, query repository.StaleQuery) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStale", ctx, query)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStale indicates an expected call of CountStale.
+func (mr *MockRestaurantRepositoryMockRecorder) CountStale(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStale", reflect.TypeOf((*MockRestaurantRepository)(nil).CountStale), ctx, query)
+}
+
 // Create mocks base method.
 func (m *MockRestaurantRepository) Create(ctx context.Context, restaurant *model.Restaurant) error {
 	m.ctrl.T.Helper()
@@ -100,6 +117,22 @@ func (mr *MockRestaurantRepositoryMockRecorder) FindByCuisineType(ctx, cuisineTy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCuisineType", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByCuisineType), ctx, cuisineType, limit, offset)
 }
 
+// FindByCuisineTypeAfter mocks base method.
+func (m *MockRestaurantRepository) FindByCuisineTypeAfter(ctx context.Context, cuisineType string, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCuisineTypeAfter", ctx, cuisineType, after, limit)
+	ret0, _ := ret[0].([]*model.Restaurant)
+	ret1, _ := ret[1].(*repository.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCuisineTypeAfter indicates an expected call of FindByCuisineTypeAfter.
+func (mr *MockRestaurantRepositoryMockRecorder) FindByCuisineTypeAfter(ctx, cuisineType, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCuisineTypeAfter", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByCuisineTypeAfter), ctx, cuisineType, after, limit)
+}
+
 // FindByExternalID mocks base method.
 func (m *MockRestaurantRepository) FindByExternalID(ctx context.Context, source model.RestaurantSource, externalID string) (*model.Restaurant, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +148,54 @@ func (mr *MockRestaurantRepositoryMockRecorder) FindByExternalID(ctx, source, ex
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalID", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByExternalID), ctx, source, externalID)
 }
 
+// FindByExternalIDs mocks base method.
+func (m *MockRestaurantRepository) FindByExternalIDs(ctx context.Context, refs []repository.ExternalRef) (map[repository.ExternalRef]*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByExternalIDs", ctx, refs)
+	ret0, _ := ret[0].(map[repository.ExternalRef]*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByExternalIDs indicates an expected call of FindByExternalIDs.
+func (mr *MockRestaurantRepositoryMockRecorder) FindByExternalIDs(ctx, refs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalIDs", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByExternalIDs), ctx, refs)
+}
+
+// FindByFilter mocks base method.
+func (m *MockRestaurantRepository) FindByFilter(ctx context.Context, filter repository.RestaurantFilter, limit, offset int) ([]*repository.FilteredRestaurant, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByFilter", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*repository.FilteredRestaurant)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByFilter indicates an expected call of FindByFilter.
+func (mr *MockRestaurantRepositoryMockRecorder) FindByFilter(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByFilter", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByFilter), ctx, filter, limit, offset)
+}
+
+// FindByFilterAfter mocks base method.
+func (m *MockRestaurantRepository) FindByFilterAfter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, int64, *repository.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByFilterAfter", ctx, filter, after, limit)
+	ret0, _ := ret[0].([]*repository.FilteredRestaurant)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(*repository.Cursor)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// FindByFilterAfter indicates an expected call of FindByFilterAfter.
+func (mr *MockRestaurantRepositoryMockRecorder) FindByFilterAfter(ctx, filter, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByFilterAfter", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByFilterAfter), ctx, filter, after, limit)
+}
+
 // FindByID mocks base method.
 func (m *MockRestaurantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Restaurant, error) {
 	m.ctrl.T.Helper()
@@ -130,11 +211,26 @@ func (mr *MockRestaurantRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByIDs mocks base method.
+func (m *MockRestaurantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockRestaurantRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockRestaurantRepository)(nil).FindByIDs), ctx, ids)
+}
+
 // FindByLocation mocks base method.
-func (m *MockRestaurantRepository) FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*model.Restaurant, error) {
+func (m *MockRestaurantRepository) FindByLocation(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]*repository.NearbyRestaurant, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByLocation", ctx, lat, lng, radiusKm, limit)
-	ret0, _ := ret[0].([]*model.Restaurant)
+	ret0, _ := ret[0].([]*repository.NearbyRestaurant)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -160,6 +256,69 @@ func (mr *MockRestaurantRepositoryMockRecorder) FindBySource(ctx, source, limit,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySource", reflect.TypeOf((*MockRestaurantRepository)(nil).FindBySource), ctx, source, limit, offset)
 }
 
+// FindBySourceAfter mocks base method.
+func (m *MockRestaurantRepository) FindBySourceAfter(ctx context.Context, source model.RestaurantSource, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySourceAfter", ctx, source, after, limit)
+	ret0, _ := ret[0].([]*model.Restaurant)
+	ret1, _ := ret[1].(*repository.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindBySourceAfter indicates an expected call of FindBySourceAfter.
+func (mr *MockRestaurantRepositoryMockRecorder) FindBySourceAfter(ctx, source, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySourceAfter", reflect.TypeOf((*MockRestaurantRepository)(nil).FindBySourceAfter), ctx, source, after, limit)
+}
+
+// FindDeleted mocks base method.
+func (m *MockRestaurantRepository) FindDeleted(ctx context.Context, query repository.DeletedQuery) ([]*model.Restaurant, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeleted", ctx, query)
+	ret0, _ := ret[0].([]*model.Restaurant)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindDeleted indicates an expected call of FindDeleted.
+func (mr *MockRestaurantRepositoryMockRecorder) FindDeleted(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeleted", reflect.TypeOf((*MockRestaurantRepository)(nil).FindDeleted), ctx, query)
+}
+
+// FindPageByFilter mocks base method.
+func (m *MockRestaurantRepository) FindPageByFilter(ctx context.Context, filter repository.RestaurantFilter, after *repository.Cursor, limit int) ([]*repository.FilteredRestaurant, *repository.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPageByFilter", ctx, filter, after, limit)
+	ret0, _ := ret[0].([]*repository.FilteredRestaurant)
+	ret1, _ := ret[1].(*repository.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPageByFilter indicates an expected call of FindPageByFilter.
+func (mr *MockRestaurantRepositoryMockRecorder) FindPageByFilter(ctx, filter, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPageByFilter", reflect.TypeOf((*MockRestaurantRepository)(nil).FindPageByFilter), ctx, filter, after, limit)
+}
+
+// FindStale mocks base method.
+func (m *MockRestaurantRepository) FindStale(ctx context.Context, query repository.StaleQuery) ([]*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStale", ctx, query)
+	ret0, _ := ret[0].([]*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStale indicates an expected call of FindStale.
+func (mr *MockRestaurantRepositoryMockRecorder) FindStale(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStale", reflect.TypeOf((*MockRestaurantRepository)(nil).FindStale), ctx, query)
+}
+
 // List mocks base method.
 func (m *MockRestaurantRepository) List(ctx context.Context, limit, offset int) ([]*model.Restaurant, error) {
 	m.ctrl.T.Helper()
@@ -175,21 +334,99 @@ func (mr *MockRestaurantRepositoryMockRecorder) List(ctx, limit, offset any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRestaurantRepository)(nil).List), ctx, limit, offset)
 }
 
-// Search mocks base method.
-func (m *MockRestaurantRepository) Search(ctx context.Context, query string, limit, offset int) ([]*model.Restaurant, error) {
+// ListAfter mocks base method.
+func (m *MockRestaurantRepository) ListAfter(ctx context.Context, after *repository.Cursor, limit int) ([]*model.Restaurant, *repository.Cursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, query, limit, offset)
+	ret := m.ctrl.Call(m, "ListAfter", ctx, after, limit)
 	ret0, _ := ret[0].([]*model.Restaurant)
+	ret1, _ := ret[1].(*repository.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockRestaurantRepositoryMockRecorder) ListAfter(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockRestaurantRepository)(nil).ListAfter), ctx, after, limit)
+}
+
+// Purge mocks base method.
+func (m *MockRestaurantRepository) Purge(ctx context.Context, ids []uuid.UUID, deletedBefore time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids, deletedBefore)
+	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// Purge indicates an expected call of Purge.
+func (mr *MockRestaurantRepositoryMockRecorder) Purge(ctx, ids, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRestaurantRepository)(nil).Purge), ctx, ids, deletedBefore)
+}
+
+// Reindex mocks base method.
+func (m *MockRestaurantRepository) Reindex(ctx context.Context, restaurants []*model.Restaurant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", ctx, restaurants)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockRestaurantRepositoryMockRecorder) Reindex(ctx, restaurants any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockRestaurantRepository)(nil).Reindex), ctx, restaurants)
+}
+
+// Restore mocks base method.
+func (m *MockRestaurantRepository) Restore(ctx context.Context, id uuid.UUID) (*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRestaurantRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRestaurantRepository)(nil).Restore), ctx, id)
+}
+
+// Search mocks base method.
+func (m *MockRestaurantRepository) Search(ctx context.Context, query string, limit, offset int) ([]*repository.RestaurantSearchHit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit, offset)
+	ret0, _ := ret[0].([]*repository.RestaurantSearchHit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // Search indicates an expected call of Search.
 func (mr *MockRestaurantRepositoryMockRecorder) Search(ctx, query, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRestaurantRepository)(nil).Search), ctx, query, limit, offset)
 }
 
+// SearchAfter mocks base method.
+func (m *MockRestaurantRepository) SearchAfter(ctx context.Context, query string, after *repository.Cursor, limit int) ([]*repository.RestaurantSearchHit, int64, *repository.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAfter", ctx, query, after, limit)
+	ret0, _ := ret[0].([]*repository.RestaurantSearchHit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(*repository.Cursor)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SearchAfter indicates an expected call of SearchAfter.
+func (mr *MockRestaurantRepositoryMockRecorder) SearchAfter(ctx, query, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAfter", reflect.TypeOf((*MockRestaurantRepository)(nil).SearchAfter), ctx, query, after, limit)
+}
+
 // Update mocks base method.
 func (m *MockRestaurantRepository) Update(ctx context.Context, restaurant *model.Restaurant) error {
 	m.ctrl.T.Helper()
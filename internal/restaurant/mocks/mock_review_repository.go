@@ -0,0 +1,175 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/review_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/review_repository.go -destination=internal/restaurant/mocks/mock_review_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddHelpfulVote mocks base method.
+func (m *MockReviewRepository) AddHelpfulVote(ctx context.Context, reviewID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHelpfulVote", ctx, reviewID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHelpfulVote indicates an expected call of AddHelpfulVote.
+func (mr *MockReviewRepositoryMockRecorder) AddHelpfulVote(ctx, reviewID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHelpfulVote", reflect.TypeOf((*MockReviewRepository)(nil).AddHelpfulVote), ctx, reviewID, userID)
+}
+
+// Create mocks base method.
+func (m *MockReviewRepository) Create(ctx context.Context, review *model.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewRepositoryMockRecorder) Create(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewRepository)(nil).Create), ctx, review)
+}
+
+// Delete mocks base method.
+func (m *MockReviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockReviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockReviewRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReviewRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUserAndRestaurant mocks base method.
+func (m *MockReviewRepository) FindByUserAndRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserAndRestaurant", ctx, userID, restaurantID)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserAndRestaurant indicates an expected call of FindByUserAndRestaurant.
+func (mr *MockReviewRepositoryMockRecorder) FindByUserAndRestaurant(ctx, userID, restaurantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAndRestaurant", reflect.TypeOf((*MockReviewRepository)(nil).FindByUserAndRestaurant), ctx, userID, restaurantID)
+}
+
+// List mocks base method.
+func (m *MockReviewRepository) List(ctx context.Context, query repository.ReviewQuery) ([]*model.Review, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]*model.Review)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReviewRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReviewRepository)(nil).List), ctx, query)
+}
+
+// ListEdits mocks base method.
+func (m *MockReviewRepository) ListEdits(ctx context.Context, reviewID uuid.UUID) ([]*model.ReviewEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEdits", ctx, reviewID)
+	ret0, _ := ret[0].([]*model.ReviewEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEdits indicates an expected call of ListEdits.
+func (mr *MockReviewRepositoryMockRecorder) ListEdits(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdits", reflect.TypeOf((*MockReviewRepository)(nil).ListEdits), ctx, reviewID)
+}
+
+// RemoveHelpfulVote mocks base method.
+func (m *MockReviewRepository) RemoveHelpfulVote(ctx context.Context, reviewID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveHelpfulVote", ctx, reviewID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveHelpfulVote indicates an expected call of RemoveHelpfulVote.
+func (mr *MockReviewRepositoryMockRecorder) RemoveHelpfulVote(ctx, reviewID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHelpfulVote", reflect.TypeOf((*MockReviewRepository)(nil).RemoveHelpfulVote), ctx, reviewID, userID)
+}
+
+// Update mocks base method.
+func (m *MockReviewRepository) Update(ctx context.Context, review *model.Review, edit *model.ReviewEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, review, edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReviewRepositoryMockRecorder) Update(ctx, review, edit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReviewRepository)(nil).Update), ctx, review, edit)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/revision_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/revision_repository.go -destination=internal/restaurant/mocks/mock_revision_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevisionRepository is a mock of RevisionRepository interface.
+type MockRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRevisionRepositoryMockRecorder is the mock recorder for MockRevisionRepository.
+type MockRevisionRepositoryMockRecorder struct {
+	mock *MockRevisionRepository
+}
+
+// NewMockRevisionRepository creates a new mock instance.
+func NewMockRevisionRepository(ctrl *gomock.Controller) *MockRevisionRepository {
+	mock := &MockRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionRepository) EXPECT() *MockRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockRevisionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.RestaurantRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.RestaurantRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRevisionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRevisionRepository)(nil).FindByID), ctx, id)
+}
+
+// ListByRestaurant mocks base method.
+func (m *MockRevisionRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, limit, offset int) ([]*model.RestaurantRevision, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRestaurant", ctx, restaurantID, limit, offset)
+	ret0, _ := ret[0].([]*model.RestaurantRevision)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByRestaurant indicates an expected call of ListByRestaurant.
+func (mr *MockRevisionRepositoryMockRecorder) ListByRestaurant(ctx, restaurantID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRestaurant", reflect.TypeOf((*MockRevisionRepository)(nil).ListByRestaurant), ctx, restaurantID, limit, offset)
+}
+
+// ListNewerThan mocks base method.
+func (m *MockRevisionRepository) ListNewerThan(ctx context.Context, revision *model.RestaurantRevision) ([]*model.RestaurantRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNewerThan", ctx, revision)
+	ret0, _ := ret[0].([]*model.RestaurantRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNewerThan indicates an expected call of ListNewerThan.
+func (mr *MockRevisionRepositoryMockRecorder) ListNewerThan(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNewerThan", reflect.TypeOf((*MockRevisionRepository)(nil).ListNewerThan), ctx, revision)
+}
+
+// UpdateWithRevision mocks base method.
+func (m *MockRevisionRepository) UpdateWithRevision(ctx context.Context, restaurant *model.Restaurant, revision *model.RestaurantRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithRevision", ctx, restaurant, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithRevision indicates an expected call of UpdateWithRevision.
+func (mr *MockRevisionRepositoryMockRecorder) UpdateWithRevision(ctx, restaurant, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithRevision", reflect.TypeOf((*MockRevisionRepository)(nil).UpdateWithRevision), ctx, restaurant, revision)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/suggestion_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/suggestion_repository.go -destination=internal/restaurant/mocks/mock_suggestion_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSuggestionRepository is a mock of SuggestionRepository interface.
+type MockSuggestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSuggestionRepositoryMockRecorder is the mock recorder for MockSuggestionRepository.
+type MockSuggestionRepositoryMockRecorder struct {
+	mock *MockSuggestionRepository
+}
+
+// NewMockSuggestionRepository creates a new mock instance.
+func NewMockSuggestionRepository(ctrl *gomock.Controller) *MockSuggestionRepository {
+	mock := &MockSuggestionRepository{ctrl: ctrl}
+	mock.recorder = &MockSuggestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestionRepository) EXPECT() *MockSuggestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountApprovedByAuthor mocks base method.
+func (m *MockSuggestionRepository) CountApprovedByAuthor(ctx context.Context, authorID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountApprovedByAuthor", ctx, authorID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountApprovedByAuthor indicates an expected call of CountApprovedByAuthor.
+func (mr *MockSuggestionRepositoryMockRecorder) CountApprovedByAuthor(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountApprovedByAuthor", reflect.TypeOf((*MockSuggestionRepository)(nil).CountApprovedByAuthor), ctx, authorID)
+}
+
+// Create mocks base method.
+func (m *MockSuggestionRepository) Create(ctx context.Context, suggestion *model.EditSuggestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, suggestion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSuggestionRepositoryMockRecorder) Create(ctx, suggestion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSuggestionRepository)(nil).Create), ctx, suggestion)
+}
+
+// FindByID mocks base method.
+func (m *MockSuggestionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.EditSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.EditSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSuggestionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSuggestionRepository)(nil).FindByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockSuggestionRepository) List(ctx context.Context, query repository.SuggestionQuery) ([]*model.EditSuggestion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]*model.EditSuggestion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSuggestionRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSuggestionRepository)(nil).List), ctx, query)
+}
+
+// Reopen mocks base method.
+func (m *MockSuggestionRepository) Reopen(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockSuggestionRepositoryMockRecorder) Reopen(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockSuggestionRepository)(nil).Reopen), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockSuggestionRepository) Update(ctx context.Context, suggestion *model.EditSuggestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, suggestion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSuggestionRepositoryMockRecorder) Update(ctx, suggestion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSuggestionRepository)(nil).Update), ctx, suggestion)
+}
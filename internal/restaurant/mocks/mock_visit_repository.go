@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/restaurant/domain/repository/visit_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/restaurant/domain/repository/visit_repository.go -destination=internal/restaurant/mocks/mock_visit_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/model"
+	repository "github.com/Leon180/tabelogo-v2/internal/restaurant/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVisitRepository is a mock of VisitRepository interface.
+type MockVisitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVisitRepositoryMockRecorder is the mock recorder for MockVisitRepository.
+type MockVisitRepositoryMockRecorder struct {
+	mock *MockVisitRepository
+}
+
+// NewMockVisitRepository creates a new mock instance.
+func NewMockVisitRepository(ctrl *gomock.Controller) *MockVisitRepository {
+	mock := &MockVisitRepository{ctrl: ctrl}
+	mock.recorder = &MockVisitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisitRepository) EXPECT() *MockVisitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByMonth mocks base method.
+func (m *MockVisitRepository) CountByMonth(ctx context.Context, userID uuid.UUID, since time.Time) ([]*repository.MonthlyVisits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByMonth", ctx, userID, since)
+	ret0, _ := ret[0].([]*repository.MonthlyVisits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByMonth indicates an expected call of CountByMonth.
+func (mr *MockVisitRepositoryMockRecorder) CountByMonth(ctx, userID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByMonth", reflect.TypeOf((*MockVisitRepository)(nil).CountByMonth), ctx, userID, since)
+}
+
+// CountByRestaurant mocks base method.
+func (m *MockVisitRepository) CountByRestaurant(ctx context.Context, userID, restaurantID uuid.UUID) (*repository.RestaurantVisits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByRestaurant", ctx, userID, restaurantID)
+	ret0, _ := ret[0].(*repository.RestaurantVisits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByRestaurant indicates an expected call of CountByRestaurant.
+func (mr *MockVisitRepositoryMockRecorder) CountByRestaurant(ctx, userID, restaurantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByRestaurant", reflect.TypeOf((*MockVisitRepository)(nil).CountByRestaurant), ctx, userID, restaurantID)
+}
+
+// Create mocks base method.
+func (m *MockVisitRepository) Create(ctx context.Context, visit *model.Visit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVisitRepositoryMockRecorder) Create(ctx, visit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVisitRepository)(nil).Create), ctx, visit)
+}
+
+// Delete mocks base method.
+func (m *MockVisitRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVisitRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVisitRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockVisitRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Visit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.Visit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockVisitRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockVisitRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUser mocks base method.
+func (m *MockVisitRepository) FindByUser(ctx context.Context, query repository.VisitQuery) ([]*model.Visit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", ctx, query)
+	ret0, _ := ret[0].([]*model.Visit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockVisitRepositoryMockRecorder) FindByUser(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockVisitRepository)(nil).FindByUser), ctx, query)
+}
+
+// MostRevisited mocks base method.
+func (m *MockVisitRepository) MostRevisited(ctx context.Context, userID uuid.UUID, limit int) ([]*repository.RevisitedRestaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MostRevisited", ctx, userID, limit)
+	ret0, _ := ret[0].([]*repository.RevisitedRestaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MostRevisited indicates an expected call of MostRevisited.
+func (mr *MockVisitRepositoryMockRecorder) MostRevisited(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MostRevisited", reflect.TypeOf((*MockVisitRepository)(nil).MostRevisited), ctx, userID, limit)
+}
+
+// SpendByCuisine mocks base method.
+func (m *MockVisitRepository) SpendByCuisine(ctx context.Context, userID uuid.UUID) ([]*repository.CuisineSpend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendByCuisine", ctx, userID)
+	ret0, _ := ret[0].([]*repository.CuisineSpend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendByCuisine indicates an expected call of SpendByCuisine.
+func (mr *MockVisitRepositoryMockRecorder) SpendByCuisine(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendByCuisine", reflect.TypeOf((*MockVisitRepository)(nil).SpendByCuisine), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockVisitRepository) Update(ctx context.Context, visit *model.Visit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVisitRepositoryMockRecorder) Update(ctx, visit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVisitRepository)(nil).Update), ctx, visit)
+}
@@ -10,6 +10,10 @@ type SpiderConfig struct {
 	// Cache configuration
 	CacheTTL time.Duration `env:"SPIDER_CACHE_TTL" envDefault:"24h"`
 
+	// JobCacheTTL is how long jobs stay in the Redis hot cache; Postgres
+	// keeps them for good
+	JobCacheTTL time.Duration `env:"SPIDER_JOB_CACHE_TTL" envDefault:"1h"`
+
 	// Circuit breaker configuration
 	CircuitBreaker CircuitBreakerConfig
 
@@ -36,6 +40,7 @@ func DefaultConfig() *SpiderConfig {
 	return &SpiderConfig{
 		WorkerCount: 20,
 		CacheTTL:    24 * time.Hour,
+		JobCacheTTL: time.Hour,
 		CircuitBreaker: CircuitBreakerConfig{
 			MaxRequests: 3,
 			Interval:    60 * time.Second,
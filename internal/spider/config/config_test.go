@@ -15,6 +15,7 @@ func TestDefaultConfig_Defaults(t *testing.T) {
 	assert.NotNil(t, cfg)
 	assert.Equal(t, 20, cfg.WorkerCount)
 	assert.Equal(t, 24*time.Hour, cfg.CacheTTL)
+	assert.Equal(t, time.Hour, cfg.JobCacheTTL)
 	assert.Equal(t, uint32(3), cfg.CircuitBreaker.MaxRequests)
 	assert.Equal(t, 60*time.Second, cfg.CircuitBreaker.Interval)
 	assert.Equal(t, 30*time.Second, cfg.CircuitBreaker.Timeout)
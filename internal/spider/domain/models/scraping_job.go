@@ -68,6 +68,36 @@ func NewScrapingJob(googleID, area, placeName string) *ScrapingJob {
 	}
 }
 
+// ReconstructScrapingJob is used by repositories to reconstruct a job from persistence
+func ReconstructScrapingJob(
+	id JobID,
+	googleID string,
+	area string,
+	placeName string,
+	status JobStatus,
+	results []TabelogRestaurant,
+	errorMsg string,
+	createdAt time.Time,
+	startedAt *time.Time,
+	completedAt *time.Time,
+) *ScrapingJob {
+	if results == nil {
+		results = []TabelogRestaurant{}
+	}
+	return &ScrapingJob{
+		id:          id,
+		googleID:    googleID,
+		area:        area,
+		placeName:   placeName,
+		status:      status,
+		results:     results,
+		errorMsg:    errorMsg,
+		createdAt:   createdAt,
+		startedAt:   startedAt,
+		completedAt: completedAt,
+	}
+}
+
 // ID returns the job ID
 func (j *ScrapingJob) ID() JobID {
 	return j.id
@@ -10,6 +10,10 @@ type TabelogRestaurant struct {
 	phone       string
 	types       []string
 	photos      []string
+	// Per-person budgets as shown on Tabelog, e.g. "￥3,000～￥3,999";
+	// empty when the listing has none
+	lunchBudget  string
+	dinnerBudget string
 }
 
 // NewTabelogRestaurant creates a new TabelogRestaurant
@@ -72,6 +76,22 @@ func (r *TabelogRestaurant) Photos() []string {
 	return r.photos
 }
 
+// LunchBudget returns the lunch budget text
+func (r *TabelogRestaurant) LunchBudget() string {
+	return r.lunchBudget
+}
+
+// DinnerBudget returns the dinner budget text
+func (r *TabelogRestaurant) DinnerBudget() string {
+	return r.dinnerBudget
+}
+
+// SetBudgets sets the lunch and dinner budget texts
+func (r *TabelogRestaurant) SetBudgets(lunch, dinner string) {
+	r.lunchBudget = lunch
+	r.dinnerBudget = dinner
+}
+
 // AddPhotos adds photos to the restaurant
 func (r *TabelogRestaurant) AddPhotos(photos []string) {
 	r.photos = append(r.photos, photos...)
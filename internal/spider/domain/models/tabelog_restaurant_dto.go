@@ -11,6 +11,9 @@ type TabelogRestaurantDTO struct {
 	Phone       string   `json:"phone"`
 	Types       []string `json:"types"`
 	Photos      []string `json:"photos"`
+
+	LunchBudget  string `json:"lunch_budget,omitempty"`
+	DinnerBudget string `json:"dinner_budget,omitempty"`
 }
 
 // ToDTO converts TabelogRestaurant domain model to DTO
@@ -24,6 +27,9 @@ func (r *TabelogRestaurant) ToDTO() TabelogRestaurantDTO {
 		Phone:       r.phone,
 		Types:       r.types,
 		Photos:      r.photos,
+
+		LunchBudget:  r.lunchBudget,
+		DinnerBudget: r.dinnerBudget,
 	}
 }
 
@@ -38,5 +44,8 @@ func (dto TabelogRestaurantDTO) ToDomain() *TabelogRestaurant {
 		phone:       dto.Phone,
 		types:       dto.Types,
 		photos:      dto.Photos,
+
+		lunchBudget:  dto.LunchBudget,
+		dinnerBudget: dto.DinnerBudget,
 	}
 }
@@ -1,6 +1,8 @@
 package infrastructure
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/Leon180/tabelogo-v2/internal/spider/config"
@@ -14,6 +16,8 @@ import (
 	"github.com/sony/gobreaker"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 // Module provides infrastructure layer dependencies
@@ -27,10 +31,14 @@ var Module = fx.Module("spider.infrastructure",
 	// Redis client
 	fx.Provide(newRedisClient),
 
-	// Persistence
+	// Database
+	fx.Provide(newDatabase),
+
+	// Persistence: jobs live in Postgres, Redis is their hot cache
 	fx.Provide(
+		persistence.NewPostgresJobRepository,
 		fx.Annotate(
-			persistence.NewRedisJobStore,
+			newCachedJobRepository,
 			fx.As(new(repositories.JobRepository)),
 		),
 		fx.Annotate(
@@ -55,6 +63,46 @@ func newRedisClient(cfg *pkgconfig.Config) *redis.Client {
 	})
 }
 
+// newDatabase creates the spider database connection with lifecycle management
+func newDatabase(cfg *pkgconfig.Config, lc fx.Lifecycle, logger *zap.Logger) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(cfg.GetDatabaseDSN()), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	// Configure connection pool
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("Connecting to spider database", zap.String("host", cfg.Database.Host))
+			if err := sqlDB.PingContext(ctx); err != nil {
+				return fmt.Errorf("failed to ping database: %w", err)
+			}
+			logger.Info("Spider database connected successfully")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Closing spider database connection")
+			return sqlDB.Close()
+		},
+	})
+
+	return db, nil
+}
+
+// newCachedJobRepository caches the Postgres job repository in Redis with configured TTL
+func newCachedJobRepository(store *persistence.PostgresJobRepository, client *redis.Client, logger *zap.Logger, cfg *config.SpiderConfig) *persistence.CachedJobRepository {
+	return persistence.NewCachedJobRepository(store, client, cfg.JobCacheTTL, logger)
+}
+
 // newRedisResultCache creates a Redis result cache with configured TTL
 func newRedisResultCache(client *redis.Client, logger *zap.Logger, cfg *config.SpiderConfig) repositories.ResultCacheRepository {
 	return persistence.NewRedisResultCache(client, cfg.CacheTTL, logger)
@@ -0,0 +1,102 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/spider/domain/models"
+	"github.com/Leon180/tabelogo-v2/internal/spider/domain/repositories"
+	redisclient "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// CachedJobRepository implements JobRepository with a durable store and
+// Redis as a hot cache of job documents. The store is the source of truth:
+// cache failures are logged and never fail a call.
+type CachedJobRepository struct {
+	store  repositories.JobRepository
+	helper *RedisHelper
+	logger *zap.Logger
+	ttl    time.Duration
+}
+
+// NewCachedJobRepository creates a new job repository caching store in Redis
+func NewCachedJobRepository(store repositories.JobRepository, client *redisclient.Client, ttl time.Duration, logger *zap.Logger) *CachedJobRepository {
+	return &CachedJobRepository{
+		store:  store,
+		helper: NewRedisHelper(client, logger),
+		logger: logger.With(zap.String("component", "cached_job_repository")),
+		ttl:    ttl,
+	}
+}
+
+var _ repositories.JobRepository = (*CachedJobRepository)(nil)
+
+// Save saves a job to the store and caches it
+func (r *CachedJobRepository) Save(ctx context.Context, job *models.ScrapingJob) error {
+	if err := r.store.Save(ctx, job); err != nil {
+		return err
+	}
+	r.cache(ctx, job)
+	return nil
+}
+
+// FindByID finds a job in the cache, falling back to the store
+func (r *CachedJobRepository) FindByID(ctx context.Context, id models.JobID) (*models.ScrapingJob, error) {
+	var cached models.ScrapingJob
+	if err := r.helper.GetJSON(ctx, r.jobKey(id), &cached); err == nil {
+		return &cached, nil
+	}
+
+	job, err := r.store.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.cache(ctx, job)
+	return job, nil
+}
+
+// FindByGoogleID finds jobs by Google Place ID in the store
+func (r *CachedJobRepository) FindByGoogleID(ctx context.Context, googleID string) ([]*models.ScrapingJob, error) {
+	return r.store.FindByGoogleID(ctx, googleID)
+}
+
+// Update updates a job in the store and refreshes the cache
+func (r *CachedJobRepository) Update(ctx context.Context, job *models.ScrapingJob) error {
+	if err := r.store.Update(ctx, job); err != nil {
+		// Drop the cached copy so readers don't see a state the store rejected
+		r.evict(ctx, job.ID())
+		return err
+	}
+	r.cache(ctx, job)
+	return nil
+}
+
+// Delete deletes a job from the store and the cache
+func (r *CachedJobRepository) Delete(ctx context.Context, id models.JobID) error {
+	r.evict(ctx, id)
+	return r.store.Delete(ctx, id)
+}
+
+// FindPending finds pending jobs in the store
+func (r *CachedJobRepository) FindPending(ctx context.Context, limit int) ([]*models.ScrapingJob, error) {
+	return r.store.FindPending(ctx, limit)
+}
+
+func (r *CachedJobRepository) cache(ctx context.Context, job *models.ScrapingJob) {
+	if err := r.helper.SetJSON(ctx, r.jobKey(job.ID()), job, r.ttl); err != nil {
+		r.logger.Warn("Failed to cache job", zap.Error(err), zap.String("job_id", job.ID().String()))
+	}
+}
+
+func (r *CachedJobRepository) evict(ctx context.Context, id models.JobID) {
+	if err := r.helper.Delete(ctx, r.jobKey(id)); err != nil {
+		r.logger.Warn("Failed to evict cached job", zap.Error(err), zap.String("job_id", id.String()))
+	}
+}
+
+// jobKey shares RedisJobStore's key layout
+func (r *CachedJobRepository) jobKey(id models.JobID) string {
+	return fmt.Sprintf("spider:jobs:%s", id.String())
+}
@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/spider/domain/models"
+	"github.com/alicebob/miniredis/v2"
+	redisclient "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTestCachedJobRepository(t *testing.T) (*CachedJobRepository, *InMemoryJobRepository, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client := redisclient.NewClient(&redisclient.Options{Addr: mr.Addr()})
+	store := NewInMemoryJobRepository()
+
+	return NewCachedJobRepository(store, client, time.Hour, zap.NewNop()), store, mr
+}
+
+func TestCachedJobRepository_SaveCachesJob(t *testing.T) {
+	repo, store, mr := setupTestCachedJobRepository(t)
+	ctx := context.Background()
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+
+	require.NoError(t, repo.Save(ctx, job))
+
+	_, err := store.FindByID(ctx, job.ID())
+	assert.NoError(t, err)
+	assert.True(t, mr.Exists("spider:jobs:"+job.ID().String()))
+	assert.Equal(t, time.Hour, mr.TTL("spider:jobs:"+job.ID().String()))
+}
+
+func TestCachedJobRepository_FindByID_FallsBackToStore(t *testing.T) {
+	repo, _, mr := setupTestCachedJobRepository(t)
+	ctx := context.Background()
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+	require.NoError(t, repo.Save(ctx, job))
+
+	// The cache expired but the store still has the job
+	mr.FlushAll()
+
+	found, err := repo.FindByID(ctx, job.ID())
+	require.NoError(t, err)
+	assert.Equal(t, job.ID().String(), found.ID().String())
+	assert.True(t, mr.Exists("spider:jobs:"+job.ID().String()), "job should be cached again")
+}
+
+func TestCachedJobRepository_FindByID_ReadsCache(t *testing.T) {
+	repo, store, _ := setupTestCachedJobRepository(t)
+	ctx := context.Background()
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+	require.NoError(t, repo.Save(ctx, job))
+	require.NoError(t, store.Delete(ctx, job.ID()))
+
+	found, err := repo.FindByID(ctx, job.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "test-google-id", found.GoogleID())
+}
+
+func TestCachedJobRepository_FindByID_NotFound(t *testing.T) {
+	repo, _, _ := setupTestCachedJobRepository(t)
+
+	_, err := repo.FindByID(context.Background(), models.NewJobID())
+	assert.Error(t, err)
+}
+
+func TestCachedJobRepository_Update(t *testing.T) {
+	repo, _, _ := setupTestCachedJobRepository(t)
+	ctx := context.Background()
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+	require.NoError(t, repo.Save(ctx, job))
+
+	job.Start()
+	job.Complete([]models.TabelogRestaurant{
+		*models.NewTabelogRestaurant("https://tabelog.com/tokyo/A1303/A130301/13001234/", "Test", 3.5, 100, 50, "", nil, nil),
+	})
+	require.NoError(t, repo.Update(ctx, job))
+
+	found, err := repo.FindByID(ctx, job.ID())
+	require.NoError(t, err)
+	assert.Equal(t, models.JobStatusCompleted, found.Status())
+	assert.Len(t, found.Results(), 1)
+}
+
+func TestCachedJobRepository_Update_StoreErrorEvictsCache(t *testing.T) {
+	repo, store, mr := setupTestCachedJobRepository(t)
+	ctx := context.Background()
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+	require.NoError(t, repo.Save(ctx, job))
+	require.NoError(t, store.Delete(ctx, job.ID()))
+
+	job.Start()
+	assert.Error(t, repo.Update(ctx, job))
+	assert.False(t, mr.Exists("spider:jobs:"+job.ID().String()))
+}
+
+func TestCachedJobRepository_Delete(t *testing.T) {
+	repo, _, mr := setupTestCachedJobRepository(t)
+	ctx := context.Background()
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+	require.NoError(t, repo.Save(ctx, job))
+
+	require.NoError(t, repo.Delete(ctx, job.ID()))
+
+	assert.False(t, mr.Exists("spider:jobs:"+job.ID().String()))
+	_, err := repo.FindByID(ctx, job.ID())
+	assert.Error(t, err)
+}
@@ -0,0 +1,392 @@
+package persistence
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/spider/domain/models"
+	"github.com/Leon180/tabelogo-v2/internal/spider/domain/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// sourceTabelog is the crawl source of scraping jobs and their results
+const sourceTabelog = "tabelog"
+
+// crawl_results statuses: a result is a duplicate when its checksum matches
+// the previous scrape of the same listing
+const (
+	resultStatusPending   = "pending"
+	resultStatusDuplicate = "duplicate"
+)
+
+// CrawlJobORM is the database model of a scraping job
+type CrawlJobORM struct {
+	ID             string     `gorm:"type:uuid;primaryKey"`
+	Source         string     `gorm:"type:varchar(50);not null"`
+	Region         string     `gorm:"type:varchar(100)"`
+	GoogleID       string     `gorm:"type:varchar(255)"`
+	PlaceName      string     `gorm:"type:varchar(255)"`
+	Status         string     `gorm:"type:varchar(20)"`
+	CompletedItems int        `gorm:"type:int"`
+	ErrorMessage   *string    `gorm:"type:text"`
+	StartedAt      *time.Time `gorm:"type:timestamp"`
+	CompletedAt    *time.Time `gorm:"type:timestamp"`
+	CreatedAt      time.Time  `gorm:"type:timestamp"`
+	UpdatedAt      time.Time  `gorm:"type:timestamp"`
+}
+
+// TableName overrides the table name
+func (CrawlJobORM) TableName() string {
+	return "crawl_jobs"
+}
+
+// CrawlResultORM is the database model of one result of a scraping job
+type CrawlResultORM struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	JobID      string    `gorm:"type:uuid;not null"`
+	ExternalID string    `gorm:"type:varchar(255);not null"` // Tabelog restaurant ID
+	Source     string    `gorm:"type:varchar(50);not null"`
+	URL        string    `gorm:"column:url;type:text"`
+	RawData    string    `gorm:"type:jsonb;not null"` // models.TabelogRestaurantDTO
+	Checksum   string    `gorm:"type:varchar(64)"`    // SHA-256 of RawData
+	Status     string    `gorm:"type:varchar(20)"`
+	Position   int       `gorm:"type:int;not null"`
+	CreatedAt  time.Time `gorm:"type:timestamp"`
+	UpdatedAt  time.Time `gorm:"type:timestamp"`
+}
+
+// TableName overrides the table name
+func (CrawlResultORM) TableName() string {
+	return "crawl_results"
+}
+
+// PostgresJobRepository implements JobRepository on the crawl_jobs and
+// crawl_results tables. Jobs and their results are kept, so it holds the
+// history of every scrape.
+type PostgresJobRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewPostgresJobRepository creates a new Postgres job repository
+func NewPostgresJobRepository(db *gorm.DB, logger *zap.Logger) *PostgresJobRepository {
+	return &PostgresJobRepository{
+		db:     db,
+		logger: logger.With(zap.String("component", "postgres_job_repository")),
+	}
+}
+
+var _ repositories.JobRepository = (*PostgresJobRepository)(nil)
+
+// Save saves a new job with its results
+func (r *PostgresJobRepository) Save(ctx context.Context, job *models.ScrapingJob) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(toCrawlJobORM(job)).Error; err != nil {
+			return fmt.Errorf("failed to save job: %w", err)
+		}
+		return r.saveResults(tx, job)
+	})
+}
+
+// FindByID finds a job by ID
+func (r *PostgresJobRepository) FindByID(ctx context.Context, id models.JobID) (*models.ScrapingJob, error) {
+	var orm CrawlJobORM
+	if err := r.db.WithContext(ctx).Where("id = ?", id.String()).First(&orm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("job not found: %s", id.String())
+		}
+		return nil, err
+	}
+
+	jobs, err := r.withResults(r.db.WithContext(ctx), []CrawlJobORM{orm})
+	if err != nil {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// FindByGoogleID finds every job of a Google Place ID, newest first
+func (r *PostgresJobRepository) FindByGoogleID(ctx context.Context, googleID string) ([]*models.ScrapingJob, error) {
+	var orms []CrawlJobORM
+	if err := r.db.WithContext(ctx).
+		Where("source = ? AND google_id = ?", sourceTabelog, googleID).
+		Order("created_at DESC").
+		Find(&orms).Error; err != nil {
+		return nil, err
+	}
+
+	return r.withResults(r.db.WithContext(ctx), orms)
+}
+
+// Update updates a job and replaces its results
+func (r *PostgresJobRepository) Update(ctx context.Context, job *models.ScrapingJob) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		orm := toCrawlJobORM(job)
+		// A map so cleared columns are written too
+		result := tx.Model(&CrawlJobORM{}).Where("id = ?", orm.ID).Updates(map[string]interface{}{
+			"region":          orm.Region,
+			"google_id":       orm.GoogleID,
+			"place_name":      orm.PlaceName,
+			"status":          orm.Status,
+			"completed_items": orm.CompletedItems,
+			"error_message":   orm.ErrorMessage,
+			"started_at":      orm.StartedAt,
+			"completed_at":    orm.CompletedAt,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update job: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("job not found: %s", orm.ID)
+		}
+
+		if err := tx.Where("job_id = ?", orm.ID).Delete(&CrawlResultORM{}).Error; err != nil {
+			return fmt.Errorf("failed to replace job results: %w", err)
+		}
+		return r.saveResults(tx, job)
+	})
+}
+
+// Delete deletes a job; its results are deleted with it
+func (r *PostgresJobRepository) Delete(ctx context.Context, id models.JobID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id.String()).Delete(&CrawlJobORM{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("job not found: %s", id.String())
+	}
+
+	r.logger.Info("Job deleted", zap.String("job_id", id.String()))
+	return nil
+}
+
+// FindPending finds pending jobs, highest priority and oldest first
+func (r *PostgresJobRepository) FindPending(ctx context.Context, limit int) ([]*models.ScrapingJob, error) {
+	query := r.db.WithContext(ctx).
+		Where("source = ? AND status = ?", sourceTabelog, crawlJobStatus(models.JobStatusPending)).
+		Order("priority, created_at")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var orms []CrawlJobORM
+	if err := query.Find(&orms).Error; err != nil {
+		return nil, err
+	}
+
+	// Pending jobs have no results yet
+	jobs := make([]*models.ScrapingJob, 0, len(orms))
+	for _, orm := range orms {
+		job, err := orm.toDomain(nil)
+		if err != nil {
+			r.logger.Warn("Skipping job with invalid ID", zap.String("job_id", orm.ID), zap.Error(err))
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// saveResults inserts the results of a job. A listing found twice in one job
+// is kept once, at its first position.
+func (r *PostgresJobRepository) saveResults(tx *gorm.DB, job *models.ScrapingJob) error {
+	if len(job.Results()) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([]CrawlResultORM, 0, len(job.Results()))
+	externalIDs := make([]string, 0, len(job.Results()))
+	seen := make(map[string]bool)
+	for i, result := range job.Results() {
+		dto := result.ToDTO()
+		raw, err := json.Marshal(dto)
+		if err != nil {
+			return fmt.Errorf("failed to marshal result: %w", err)
+		}
+
+		externalID := tabelogExternalID(dto.Link)
+		if seen[externalID] {
+			continue
+		}
+		seen[externalID] = true
+		externalIDs = append(externalIDs, externalID)
+
+		rows = append(rows, CrawlResultORM{
+			ID:         uuid.New(),
+			JobID:      job.ID().String(),
+			ExternalID: externalID,
+			Source:     sourceTabelog,
+			URL:        dto.Link,
+			RawData:    string(raw),
+			Checksum:   resultChecksum(raw),
+			Status:     resultStatusPending,
+			Position:   i,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+	}
+
+	previous, err := latestChecksums(tx, job.ID().String(), externalIDs)
+	if err != nil {
+		return err
+	}
+	duplicates := 0
+	for i := range rows {
+		if previous[rows[i].ExternalID] == rows[i].Checksum {
+			rows[i].Status = resultStatusDuplicate
+			duplicates++
+		}
+	}
+
+	if err := tx.Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to save job results: %w", err)
+	}
+
+	r.logger.Info("Job results saved",
+		zap.String("job_id", job.ID().String()),
+		zap.Int("results", len(rows)),
+		zap.Int("duplicates", duplicates),
+	)
+	return nil
+}
+
+// latestChecksums returns the checksum of the latest result of each listing
+// scraped by a job other than jobID
+func latestChecksums(tx *gorm.DB, jobID string, externalIDs []string) (map[string]string, error) {
+	var latest []struct {
+		ExternalID string
+		Checksum   string
+	}
+	if err := tx.Model(&CrawlResultORM{}).
+		Select("DISTINCT ON (external_id) external_id, checksum").
+		Where("source = ? AND external_id IN ? AND job_id <> ?", sourceTabelog, externalIDs, jobID).
+		Order("external_id, created_at DESC").
+		Scan(&latest).Error; err != nil {
+		return nil, fmt.Errorf("failed to find previous results: %w", err)
+	}
+
+	checksums := make(map[string]string, len(latest))
+	for _, l := range latest {
+		checksums[l.ExternalID] = l.Checksum
+	}
+	return checksums, nil
+}
+
+// withResults converts jobs to domain models with their results
+func (r *PostgresJobRepository) withResults(tx *gorm.DB, orms []CrawlJobORM) ([]*models.ScrapingJob, error) {
+	if len(orms) == 0 {
+		return []*models.ScrapingJob{}, nil
+	}
+
+	ids := make([]string, len(orms))
+	for i, orm := range orms {
+		ids[i] = orm.ID
+	}
+	var rows []CrawlResultORM
+	if err := tx.Where("job_id IN ?", ids).Order("job_id, position").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	results := make(map[string][]models.TabelogRestaurant)
+	for _, row := range rows {
+		var dto models.TabelogRestaurantDTO
+		if err := json.Unmarshal([]byte(row.RawData), &dto); err != nil {
+			r.logger.Warn("Skipping invalid job result", zap.String("result_id", row.ID.String()), zap.Error(err))
+			continue
+		}
+		results[row.JobID] = append(results[row.JobID], *dto.ToDomain())
+	}
+
+	jobs := make([]*models.ScrapingJob, 0, len(orms))
+	for _, orm := range orms {
+		job, err := orm.toDomain(results[orm.ID])
+		if err != nil {
+			r.logger.Warn("Skipping job with invalid ID", zap.String("job_id", orm.ID), zap.Error(err))
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func toCrawlJobORM(job *models.ScrapingJob) *CrawlJobORM {
+	orm := &CrawlJobORM{
+		ID:             job.ID().String(),
+		Source:         sourceTabelog,
+		Region:         job.Area(),
+		GoogleID:       job.GoogleID(),
+		PlaceName:      job.PlaceName(),
+		Status:         crawlJobStatus(job.Status()),
+		CompletedItems: len(job.Results()),
+		StartedAt:      job.StartedAt(),
+		CompletedAt:    job.CompletedAt(),
+		CreatedAt:      job.CreatedAt(),
+		UpdatedAt:      time.Now(),
+	}
+	if msg := job.Error(); msg != "" {
+		orm.ErrorMessage = &msg
+	}
+	return orm
+}
+
+func (o *CrawlJobORM) toDomain(results []models.TabelogRestaurant) (*models.ScrapingJob, error) {
+	id, err := models.ParseJobID(o.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var errorMsg string
+	if o.ErrorMessage != nil {
+		errorMsg = *o.ErrorMessage
+	}
+	return models.ReconstructScrapingJob(
+		id,
+		o.GoogleID,
+		o.Region,
+		o.PlaceName,
+		models.JobStatus(strings.ToUpper(o.Status)),
+		results,
+		errorMsg,
+		o.CreatedAt,
+		o.StartedAt,
+		o.CompletedAt,
+	), nil
+}
+
+// crawlJobStatus is the crawl_jobs status of a job status
+func crawlJobStatus(status models.JobStatus) string {
+	return strings.ToLower(string(status))
+}
+
+// tabelogRestaurantID matches the restaurant ID in a Tabelog URL such as
+// https://tabelog.com/tokyo/A1303/A130301/13001234/
+var tabelogRestaurantID = regexp.MustCompile(`/A\d{4}/A\d{6}/(\d+)`)
+
+// tabelogExternalID returns the Tabelog restaurant ID of a listing, or its
+// link when the link has none
+func tabelogExternalID(link string) string {
+	if m := tabelogRestaurantID.FindStringSubmatch(link); m != nil {
+		return m[1]
+	}
+	if len(link) > 255 {
+		return link[:255]
+	}
+	return link
+}
+
+// resultChecksum is the SHA-256 of a result's raw data, hex encoded
+func resultChecksum(raw []byte) string {
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
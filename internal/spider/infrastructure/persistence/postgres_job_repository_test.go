@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Leon180/tabelogo-v2/internal/spider/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTabelogExternalID(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{"https://tabelog.com/tokyo/A1303/A130301/13001234/", "13001234"},
+		{"https://tabelog.com/tokyo/A1303/A130301/13001234/dtlrvwlst/", "13001234"},
+		{"https://tabelog.com/en/osaka/A2701/A270101/27012345/", "27012345"},
+		{"https://example.com/restaurant", "https://example.com/restaurant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			assert.Equal(t, tt.want, tabelogExternalID(tt.link))
+		})
+	}
+}
+
+func TestResultChecksum(t *testing.T) {
+	a := resultChecksum([]byte(`{"name":"Test","rating":3.5}`))
+
+	assert.Len(t, a, 64)
+	assert.Equal(t, a, resultChecksum([]byte(`{"name":"Test","rating":3.5}`)))
+	assert.NotEqual(t, a, resultChecksum([]byte(`{"name":"Test","rating":3.6}`)))
+}
+
+func TestCrawlJobORM_RoundTrip(t *testing.T) {
+	job := models.NewScrapingJob("test-google-id", "Tokyo", "Test Restaurant")
+	job.Start()
+	job.Fail(assert.AnError)
+
+	orm := toCrawlJobORM(job)
+	assert.Equal(t, "failed", orm.Status)
+	assert.Equal(t, sourceTabelog, orm.Source)
+	require.NotNil(t, orm.ErrorMessage)
+
+	restored, err := orm.toDomain(nil)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID().String(), restored.ID().String())
+	assert.Equal(t, models.JobStatusFailed, restored.Status())
+	assert.Equal(t, job.Error(), restored.Error())
+	assert.Equal(t, "Tokyo", restored.Area())
+	assert.Equal(t, "Test Restaurant", restored.PlaceName())
+	assert.WithinDuration(t, job.CreatedAt(), restored.CreatedAt(), time.Millisecond)
+	assert.NotNil(t, restored.Results())
+}
@@ -233,6 +233,20 @@ func (s *Scraper) scrapeRestaurantDetails(link string) (*models.TabelogRestauran
 		data["phone"] = []string{e.ChildText(".rstinfo-table__tel-num")}
 	})
 
+	// Scrape budgets; Tabelog shows "-" when a budget is unknown
+	budgets := make(map[string]string)
+	c.OnHTML(".rdheader-budget__icon--dinner, .rdheader-budget__icon--lunch", func(e *colly.HTMLElement) {
+		budget := strings.TrimSpace(e.ChildText(".rdheader-budget__price-target"))
+		if budget == "" || budget == "-" {
+			return
+		}
+		if e.DOM.HasClass("rdheader-budget__icon--dinner") {
+			budgets["dinner"] = budget
+		} else {
+			budgets["lunch"] = budget
+		}
+	})
+
 	// Scrape types
 	types := []string{}
 	c.OnHTML(".rdheader-subinfo__item", func(e *colly.HTMLElement) {
@@ -256,7 +270,7 @@ func (s *Scraper) scrapeRestaurantDetails(link string) (*models.TabelogRestauran
 	bookmarks := parseInt(getFirst(data["bookmarks"]))
 	phone := getFirst(data["phone"])
 
-	return models.NewTabelogRestaurant(
+	restaurant := models.NewTabelogRestaurant(
 		link,
 		name,
 		rating,
@@ -265,7 +279,10 @@ func (s *Scraper) scrapeRestaurantDetails(link string) (*models.TabelogRestauran
 		phone,
 		types,
 		[]string{}, // Photos will be scraped separately
-	), nil
+	)
+	restaurant.SetBudgets(budgets["lunch"], budgets["dinner"])
+
+	return restaurant, nil
 }
 
 // ScrapePhotos scrapes photos for a restaurant
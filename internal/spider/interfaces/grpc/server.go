@@ -70,6 +70,9 @@ func (s *SpiderServer) SearchSimilarRestaurants(
 			Bookmarks:   int32(r.Bookmarks()),
 			Phone:       r.Phone(),
 			Types:       r.Types(),
+
+			LunchBudget:  r.LunchBudget(),
+			DinnerBudget: r.DinnerBudget(),
 		})
 	}
 
@@ -135,5 +138,8 @@ func toProtoRestaurant(r *models.TabelogRestaurant) *spiderv1.TabelogRestaurant
 		Bookmarks:   int32(r.Bookmarks()),
 		Phone:       r.Phone(),
 		Types:       r.Types(),
+
+		LunchBudget:  r.LunchBudget(),
+		DinnerBudget: r.DinnerBudget(),
 	}
 }
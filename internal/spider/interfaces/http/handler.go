@@ -91,6 +91,9 @@ func (h *SpiderHandler) Scrape(c *gin.Context) {
 				Phone:       r.Phone,
 				Types:       r.Types,
 				Photos:      r.Photos,
+
+				LunchBudget:  r.LunchBudget,
+				DinnerBudget: r.DinnerBudget,
 			}
 		}
 
@@ -148,6 +151,9 @@ type TabelogRestaurantDTO struct {
 	Phone       string   `json:"phone"`
 	Types       []string `json:"types"`
 	Photos      []string `json:"photos"`
+
+	LunchBudget  string `json:"lunch_budget,omitempty"`
+	DinnerBudget string `json:"dinner_budget,omitempty"`
 }
 
 // GetJobStatus handles GET /api/v1/spider/jobs/:job_id
@@ -188,6 +194,9 @@ func (h *SpiderHandler) GetJobStatus(c *gin.Context) {
 				Phone:       r.Phone(),
 				Types:       r.Types(),
 				Photos:      r.Photos(),
+
+				LunchBudget:  r.LunchBudget(),
+				DinnerBudget: r.DinnerBudget(),
 			}
 		}
 	}
@@ -263,6 +272,9 @@ func (h *SpiderHandler) StreamJobStatus(c *gin.Context) {
 						Phone:       r.Phone(),
 						Types:       r.Types(),
 						Photos:      r.Photos(),
+
+						LunchBudget:  r.LunchBudget(),
+						DinnerBudget: r.DinnerBudget(),
 					}
 				}
 
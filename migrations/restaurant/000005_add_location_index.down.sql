@@ -0,0 +1,6 @@
+-- Drop location indexes
+DROP INDEX IF EXISTS idx_restaurants_lat_lng;
+DROP INDEX IF EXISTS idx_restaurants_location_geog;
+DROP INDEX IF EXISTS idx_restaurants_location_earth;
+
+-- Extensions are left installed; other database objects may depend on them
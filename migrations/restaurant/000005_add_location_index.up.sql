@@ -0,0 +1,39 @@
+-- Enable earthdistance (requires cube) for index-backed radius search.
+-- Managed databases may not allow extensions; the repository detects what is
+-- installed at runtime and falls back to a plain haversine query.
+DO $$
+BEGIN
+    CREATE EXTENSION IF NOT EXISTS cube;
+    CREATE EXTENSION IF NOT EXISTS earthdistance;
+EXCEPTION WHEN OTHERS THEN
+    RAISE NOTICE 'earthdistance unavailable, radius search will use haversine fallback: %', SQLERRM;
+END
+$$;
+
+-- GiST index for earth_box/earth_distance queries
+-- The expression must match earthPointExpr in infrastructure/postgres/geo.go
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'earthdistance') THEN
+        CREATE INDEX IF NOT EXISTS idx_restaurants_location_earth
+            ON restaurants USING GIST (ll_to_earth(latitude::float8, longitude::float8))
+            WHERE deleted_at IS NULL;
+    END IF;
+END
+$$;
+
+-- GiST index for ST_DWithin queries when PostGIS is installed
+-- The expression must match geogPointExpr in infrastructure/postgres/geo.go
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis') THEN
+        EXECUTE 'CREATE INDEX IF NOT EXISTS idx_restaurants_location_geog
+            ON restaurants USING GIST ((ST_SetSRID(ST_MakePoint(longitude::float8, latitude::float8), 4326)::geography))
+            WHERE deleted_at IS NULL';
+    END IF;
+END
+$$;
+
+-- B-tree index for the bounding-box prefilter of the haversine fallback
+CREATE INDEX IF NOT EXISTS idx_restaurants_lat_lng
+    ON restaurants(latitude, longitude) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+-- Drop search indexes and columns
+DROP INDEX IF EXISTS idx_restaurants_search_text_trgm;
+DROP INDEX IF EXISTS idx_restaurants_search_vector;
+
+ALTER TABLE restaurants DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS search_text;
+
+-- pg_trgm is left installed; other database objects may depend on it
@@ -0,0 +1,32 @@
+-- Full-text and fuzzy search over restaurant names (including name_ja),
+-- cuisine, area and address.
+--
+-- search_text is maintained by the application (see domain/textnorm): it holds
+-- normalized tokens where Japanese runs are split into character bigrams and
+-- kana words carry a romaji reading, so "ラーメン", "らーめん" and "ramen" match.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE restaurants
+ADD COLUMN search_text TEXT NOT NULL DEFAULT '';
+
+-- The 'simple' configuration does no stemming, which suits pre-tokenized
+-- Japanese bigrams and romanized names alike
+ALTER TABLE restaurants
+ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', search_text)) STORED;
+
+-- Backfill existing rows with a plain lowercase concatenation so they stay
+-- searchable until restaurant-service reindex rewrites them through
+-- textnorm.IndexText. Run it after this migration.
+UPDATE restaurants
+SET search_text = lower(concat_ws(' ', name, name_ja, cuisine_type, area, address));
+
+-- GIN index for tsvector matches
+CREATE INDEX IF NOT EXISTS idx_restaurants_search_vector
+    ON restaurants USING GIN (search_vector) WHERE deleted_at IS NULL;
+
+-- Trigram index for typo-tolerant word_similarity matches (<% operator)
+CREATE INDEX IF NOT EXISTS idx_restaurants_search_text_trgm
+    ON restaurants USING GIN (search_text gin_trgm_ops) WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN restaurants.search_text IS 'Normalized search tokens (bigrams and romaji readings) maintained by the application';
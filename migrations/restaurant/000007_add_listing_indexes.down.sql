@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_restaurants_view_count;
+DROP INDEX IF EXISTS idx_restaurants_source;
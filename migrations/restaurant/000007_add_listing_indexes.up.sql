@@ -0,0 +1,7 @@
+-- Indexes for the filtered restaurant listing (GET /api/v1/restaurants).
+-- area, cuisine_type and rating are already indexed by earlier migrations.
+CREATE INDEX IF NOT EXISTS idx_restaurants_source
+    ON restaurants(source) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_view_count
+    ON restaurants(view_count DESC) WHERE deleted_at IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_user_favorites_user_created_at_id;
+DROP INDEX IF EXISTS idx_restaurants_source_created_at_id;
+DROP INDEX IF EXISTS idx_restaurants_cuisine_created_at_id;
+DROP INDEX IF EXISTS idx_restaurants_created_at_id;
@@ -0,0 +1,12 @@
+-- Indexes matching the keyset (cursor) orderings: sort key, then id as tie-breaker.
+CREATE INDEX IF NOT EXISTS idx_restaurants_created_at_id
+    ON restaurants(created_at DESC, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_cuisine_created_at_id
+    ON restaurants(cuisine_type, created_at DESC, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_source_created_at_id
+    ON restaurants(source, created_at DESC, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_favorites_user_created_at_id
+    ON user_favorites(user_id, created_at DESC, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,24 @@
+-- Merges of duplicate restaurants (e.g. the same shop from Google and Tabelog).
+-- The merged restaurant is soft-deleted and its (source, external_id) stays
+-- linked to the canonical restaurant while the merge is active.
+CREATE TABLE IF NOT EXISTS restaurant_merges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    canonical_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    merged_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    source VARCHAR(50) NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    score DECIMAL(4, 3),
+    merged_by VARCHAR(255),
+    moved_favorite_ids UUID[],    -- favorites repointed to the canonical restaurant
+    dropped_favorite_ids UUID[],  -- favorites removed because the user had the canonical one
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    unmerged_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_restaurant_merges_canonical_id
+    ON restaurant_merges(canonical_id) WHERE unmerged_at IS NULL;
+CREATE INDEX idx_restaurant_merges_merged_id ON restaurant_merges(merged_id);
+
+-- Linked identity lookup; an identity can only be merged once at a time
+CREATE UNIQUE INDEX idx_restaurant_merges_identity
+    ON restaurant_merges(source, external_id) WHERE unmerged_at IS NULL;
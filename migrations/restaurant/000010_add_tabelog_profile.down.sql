@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_restaurants_tabelog_url;
+
+ALTER TABLE restaurants
+    DROP COLUMN IF EXISTS tabelog_synced_at,
+    DROP COLUMN IF EXISTS tabelog_confirmed,
+    DROP COLUMN IF EXISTS tabelog_match_score,
+    DROP COLUMN IF EXISTS tabelog_genres,
+    DROP COLUMN IF EXISTS tabelog_bookmarks,
+    DROP COLUMN IF EXISTS tabelog_review_count,
+    DROP COLUMN IF EXISTS tabelog_rating,
+    DROP COLUMN IF EXISTS tabelog_url;
@@ -0,0 +1,14 @@
+-- Tabelog listing matched to a restaurant (scraped by the spider service),
+-- stored as typed columns so it can be shown next to the Google rating.
+ALTER TABLE restaurants
+    ADD COLUMN IF NOT EXISTS tabelog_url VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS tabelog_rating DECIMAL(3, 2),
+    ADD COLUMN IF NOT EXISTS tabelog_review_count INT,
+    ADD COLUMN IF NOT EXISTS tabelog_bookmarks INT,
+    ADD COLUMN IF NOT EXISTS tabelog_genres VARCHAR(100)[],
+    ADD COLUMN IF NOT EXISTS tabelog_match_score DECIMAL(4, 3),   -- confidence of the match, 0-1
+    ADD COLUMN IF NOT EXISTS tabelog_confirmed BOOLEAN,           -- matched by a user rather than automatically
+    ADD COLUMN IF NOT EXISTS tabelog_synced_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_tabelog_url
+    ON restaurants(tabelog_url) WHERE tabelog_url IS NOT NULL;
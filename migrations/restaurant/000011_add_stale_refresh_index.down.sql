@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_restaurants_source_updated_at;
@@ -0,0 +1,3 @@
+-- Stale restaurant lookup of the background refresher (source + updated_at cutoff)
+CREATE INDEX IF NOT EXISTS idx_restaurants_source_updated_at
+    ON restaurants(source, updated_at) WHERE deleted_at IS NULL;
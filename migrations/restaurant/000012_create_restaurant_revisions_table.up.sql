@@ -0,0 +1,12 @@
+-- Append-only change history of restaurants with field-level before/after values
+CREATE TABLE IF NOT EXISTS restaurant_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    seq BIGSERIAL NOT NULL UNIQUE,             -- insertion order
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    source VARCHAR(20) NOT NULL,               -- user_edit, map_refresh, scrape, revert
+    actor_id VARCHAR(255),                     -- user who made the change, empty for system changes
+    changes JSONB NOT NULL,                    -- [{"field", "before", "after"}]
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_restaurant_revisions_restaurant_seq ON restaurant_revisions(restaurant_id, seq DESC);
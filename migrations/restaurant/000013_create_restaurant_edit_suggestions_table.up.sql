@@ -0,0 +1,18 @@
+-- Restaurant edits submitted by regular users, applied once a moderator approves them
+CREATE TABLE IF NOT EXISTS restaurant_edit_suggestions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    author_id VARCHAR(255) NOT NULL,
+    edit JSONB NOT NULL,                       -- proposed fields, omitted fields are unchanged
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, approved, rejected
+    reviewer_id VARCHAR(255),
+    review_note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMP
+);
+
+-- Moderation queue, oldest first
+CREATE INDEX idx_restaurant_edit_suggestions_status ON restaurant_edit_suggestions(status, created_at);
+CREATE INDEX idx_restaurant_edit_suggestions_restaurant ON restaurant_edit_suggestions(restaurant_id);
+-- Reputation: approved suggestions per author
+CREATE INDEX idx_restaurant_edit_suggestions_author ON restaurant_edit_suggestions(author_id, status);
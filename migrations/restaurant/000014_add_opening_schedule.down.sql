@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_restaurants_with_opening_schedule;
+
+ALTER TABLE restaurants
+DROP COLUMN IF EXISTS opening_schedule;
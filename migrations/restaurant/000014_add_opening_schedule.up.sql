@@ -0,0 +1,8 @@
+-- Add structured weekly opening hours to restaurants
+ALTER TABLE restaurants
+ADD COLUMN opening_schedule JSONB;
+
+-- Open-at filtering only scans restaurants with a schedule
+CREATE INDEX idx_restaurants_with_opening_schedule ON restaurants(id) WHERE opening_schedule IS NOT NULL;
+
+COMMENT ON COLUMN restaurants.opening_schedule IS 'Weekly opening periods and closed holidays in Asia/Tokyo time: {"periods": [{"open": {"day", "hour", "minute"}, "close": {...}}], "holidays": ["YYYY-MM-DD"]}';
@@ -0,0 +1,16 @@
+-- Restaurant images copied from Google Places and Tabelog into blob storage
+CREATE TABLE IF NOT EXISTS restaurant_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    source VARCHAR(50) NOT NULL,               -- google, tabelog
+    source_ref TEXT NOT NULL,                  -- Google photo name or Tabelog image URL
+    content_type VARCHAR(100) NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    blob_key VARCHAR(255) NOT NULL,            -- original image
+    thumbnail_key VARCHAR(255) NOT NULL,       -- JPEG thumbnail
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Each source image is ingested once per restaurant
+CREATE UNIQUE INDEX idx_restaurant_photos_source_ref ON restaurant_photos(restaurant_id, source_ref);
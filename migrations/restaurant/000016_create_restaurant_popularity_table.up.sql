@@ -0,0 +1,12 @@
+-- Hourly view, favorite and visit counts, rolled up from the Redis counters
+CREATE TABLE IF NOT EXISTS restaurant_popularity (
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    bucket_start TIMESTAMP NOT NULL,           -- start of the hour, UTC
+    views BIGINT NOT NULL DEFAULT 0,
+    favorites BIGINT NOT NULL DEFAULT 0,
+    visits BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (restaurant_id, bucket_start)
+);
+
+-- Trending scores only read the recent buckets
+CREATE INDEX idx_restaurant_popularity_bucket_start ON restaurant_popularity(bucket_start);
@@ -0,0 +1,23 @@
+-- Named lists of a user's favorites, e.g. "Osaka trip"
+CREATE TABLE IF NOT EXISTS favorite_collections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,  -- Reference to auth_db.users (no FK due to microservices)
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Collection names are unique per user, ignoring case
+CREATE UNIQUE INDEX idx_favorite_collections_user_name ON favorite_collections(user_id, LOWER(name));
+
+-- Favorites in a collection, in the user's order. A favorite can be in many collections.
+CREATE TABLE IF NOT EXISTS favorite_collection_items (
+    collection_id UUID NOT NULL REFERENCES favorite_collections(id) ON DELETE CASCADE,
+    favorite_id UUID NOT NULL REFERENCES user_favorites(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    added_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, favorite_id)
+);
+
+CREATE INDEX idx_favorite_collection_items_favorite_id ON favorite_collection_items(favorite_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_favorite_collections_share_slug;
+
+ALTER TABLE favorite_collections
+    DROP COLUMN IF EXISTS shared_at,
+    DROP COLUMN IF EXISTS share_slug;
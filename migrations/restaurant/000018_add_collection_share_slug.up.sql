@@ -0,0 +1,6 @@
+-- Collections can be published as read-only public lists under a random slug
+ALTER TABLE favorite_collections
+    ADD COLUMN share_slug VARCHAR(32),
+    ADD COLUMN shared_at TIMESTAMP;
+
+CREATE UNIQUE INDEX idx_favorite_collections_share_slug ON favorite_collections(share_slug) WHERE share_slug IS NOT NULL;
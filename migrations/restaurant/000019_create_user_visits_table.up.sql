@@ -0,0 +1,19 @@
+-- Visit history: one row per visit of a user to a restaurant
+CREATE TABLE IF NOT EXISTS user_visits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,  -- Reference to auth_db.users (no FK due to microservices)
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    visited_on DATE NOT NULL,
+    party_size INT NOT NULL DEFAULT 1 CHECK (party_size >= 1),
+    rating SMALLINT CHECK (rating BETWEEN 1 AND 5),  -- NULL when not rated
+    dishes TEXT[] NOT NULL DEFAULT '{}',
+    spend_amount NUMERIC(14, 2) CHECK (spend_amount >= 0),
+    spend_currency CHAR(3),
+    photo_urls TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((spend_amount IS NULL) = (spend_currency IS NULL))
+);
+
+CREATE INDEX idx_user_visits_user_visited_on ON user_visits(user_id, visited_on DESC);
+CREATE INDEX idx_user_visits_user_restaurant ON user_visits(user_id, restaurant_id);
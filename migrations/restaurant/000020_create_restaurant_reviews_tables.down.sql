@@ -0,0 +1,7 @@
+ALTER TABLE restaurants
+    DROP COLUMN IF EXISTS review_count,
+    DROP COLUMN IF EXISTS review_rating;
+
+DROP TABLE IF EXISTS restaurant_review_votes;
+DROP TABLE IF EXISTS restaurant_review_edits;
+DROP TABLE IF EXISTS restaurant_reviews;
@@ -0,0 +1,46 @@
+-- User reviews: at most one per user and restaurant
+CREATE TABLE IF NOT EXISTS restaurant_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,  -- Reference to auth_db.users (no FK due to microservices)
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    score SMALLINT NOT NULL CHECK (score BETWEEN 1 AND 5),
+    text TEXT NOT NULL DEFAULT '',
+    language VARCHAR(35) NOT NULL DEFAULT '',  -- BCP 47 tag, empty when unknown
+    status VARCHAR(20) NOT NULL DEFAULT 'visible',  -- visible, hidden
+    moderator_id VARCHAR(255) NOT NULL DEFAULT '',
+    moderation_note TEXT NOT NULL DEFAULT '',
+    moderated_at TIMESTAMP,
+    helpful_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMP,
+    UNIQUE (user_id, restaurant_id)
+);
+
+CREATE INDEX idx_restaurant_reviews_restaurant_status ON restaurant_reviews(restaurant_id, status, created_at DESC);
+CREATE INDEX idx_restaurant_reviews_status ON restaurant_reviews(status, moderated_at DESC);
+
+-- Previous versions of edited reviews
+CREATE TABLE IF NOT EXISTS restaurant_review_edits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES restaurant_reviews(id) ON DELETE CASCADE,
+    score SMALLINT NOT NULL,
+    text TEXT NOT NULL,
+    language VARCHAR(35) NOT NULL,
+    edited_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_restaurant_review_edits_review_id ON restaurant_review_edits(review_id, edited_at DESC);
+
+-- Helpful votes, one per user and review; restaurant_reviews.helpful_count counts them
+CREATE TABLE IF NOT EXISTS restaurant_review_votes (
+    review_id UUID NOT NULL REFERENCES restaurant_reviews(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (review_id, user_id)
+);
+
+-- Rating from the visible reviews, kept apart from the source rating
+ALTER TABLE restaurants
+    ADD COLUMN IF NOT EXISTS review_rating DECIMAL(3, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS review_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE restaurants
+DROP COLUMN IF EXISTS localizations;
@@ -0,0 +1,11 @@
+-- Add localized display text to restaurants, keyed by language (ja, zh-TW).
+-- English lives in the existing name, address and cuisine_type columns.
+ALTER TABLE restaurants
+ADD COLUMN localizations JSONB NOT NULL DEFAULT '{}';
+
+-- Seed the Japanese name from name_ja
+UPDATE restaurants
+SET localizations = jsonb_build_object('ja', jsonb_build_object('name', name_ja))
+WHERE name_ja IS NOT NULL AND name_ja <> '';
+
+COMMENT ON COLUMN restaurants.localizations IS 'Display text per language: {"ja": {"name", "address", "cuisine_label", "summary"}, "zh-TW": {...}}';
@@ -0,0 +1,18 @@
+-- Transactional outbox: domain events are written in the transaction of the
+-- change they describe and published to Kafka by the outbox relay
+CREATE TABLE IF NOT EXISTS restaurant_outbox (
+    id UUID PRIMARY KEY,
+    seq BIGSERIAL NOT NULL UNIQUE,  -- write order, events are published in this order
+    event_type VARCHAR(100) NOT NULL,  -- e.g. restaurant.created, favorite.added
+    event_version INT NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id UUID NOT NULL,  -- Kafka message key
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    published_at TIMESTAMP,  -- NULL until published
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT
+);
+
+CREATE INDEX idx_restaurant_outbox_pending ON restaurant_outbox(seq) WHERE published_at IS NULL;
+CREATE INDEX idx_restaurant_outbox_published_at ON restaurant_outbox(published_at) WHERE published_at IS NOT NULL;
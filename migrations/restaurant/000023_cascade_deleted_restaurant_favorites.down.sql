@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_restaurants_deleted_at;
+
+-- Favorites deleted with their restaurant become visible again
+UPDATE user_favorites f
+SET deleted_at = NULL
+FROM restaurants r
+WHERE f.restaurant_id = r.id
+  AND r.deleted_at IS NOT NULL
+  AND f.deleted_at = r.deleted_at;
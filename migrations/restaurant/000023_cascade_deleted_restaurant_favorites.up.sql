@@ -0,0 +1,12 @@
+-- Favorites are soft-deleted with their restaurant, at the restaurant's
+-- deletion time, so restoring the restaurant restores exactly those.
+-- Cascade the restaurants deleted before this migration the same way.
+UPDATE user_favorites f
+SET deleted_at = r.deleted_at
+FROM restaurants r
+WHERE f.restaurant_id = r.id
+  AND r.deleted_at IS NOT NULL
+  AND f.deleted_at IS NULL;
+
+-- Listing deleted restaurants and the scheduled purge
+CREATE INDEX IF NOT EXISTS idx_restaurants_deleted_at ON restaurants(deleted_at) WHERE deleted_at IS NOT NULL;
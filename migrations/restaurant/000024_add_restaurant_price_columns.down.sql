@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_restaurants_price_lunch;
+DROP INDEX IF EXISTS idx_restaurants_price_dinner;
+DROP INDEX IF EXISTS idx_restaurants_price_level;
+
+-- Restore the free text of prices without a level
+UPDATE restaurants
+SET price_range = left(price_text, 10)
+WHERE price_level = 0 AND price_text IS NOT NULL;
+
+ALTER TABLE restaurants
+    DROP COLUMN IF EXISTS price_text,
+    DROP COLUMN IF EXISTS price_dinner_max,
+    DROP COLUMN IF EXISTS price_dinner_min,
+    DROP COLUMN IF EXISTS price_lunch_max,
+    DROP COLUMN IF EXISTS price_lunch_min,
+    DROP COLUMN IF EXISTS price_level;
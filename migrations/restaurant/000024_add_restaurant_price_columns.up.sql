@@ -0,0 +1,26 @@
+-- Normalized price: a level from 1 ("$") to 4 ("$$$$"), per-person lunch and
+-- dinner bands in yen (max NULL when open-ended) and the source's text.
+-- price_range keeps the level as "$" symbols.
+ALTER TABLE restaurants
+    ADD COLUMN IF NOT EXISTS price_level SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS price_lunch_min INT,
+    ADD COLUMN IF NOT EXISTS price_lunch_max INT,
+    ADD COLUMN IF NOT EXISTS price_dinner_min INT,
+    ADD COLUMN IF NOT EXISTS price_dinner_max INT,
+    ADD COLUMN IF NOT EXISTS price_text VARCHAR(100);
+
+-- Existing price ranges are "$" symbols, or free text without a level
+UPDATE restaurants
+SET price_level = CASE WHEN price_range ~ '^\$\$?\$?\$?$' THEN length(price_range) ELSE 0 END,
+    price_text = price_range
+WHERE price_range IS NOT NULL AND price_range <> '';
+
+UPDATE restaurants
+SET price_range = ''
+WHERE price_level = 0 AND price_range IS NOT NULL AND price_range <> '';
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_price_level ON restaurants(price_level) WHERE price_level > 0;
+CREATE INDEX IF NOT EXISTS idx_restaurants_price_dinner ON restaurants(price_dinner_min, price_dinner_max)
+    WHERE price_dinner_min IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_restaurants_price_lunch ON restaurants(price_lunch_min, price_lunch_max)
+    WHERE price_lunch_min IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_restaurants_cuisines;
+
+ALTER TABLE restaurants DROP COLUMN IF EXISTS cuisines;
@@ -0,0 +1,10 @@
+-- Cuisine categories of the restaurant, as model.Cuisines category IDs. A
+-- restaurant can be in many categories; filtering by a category expands it
+-- to its descendants in the application, so only the IDs are stored.
+ALTER TABLE restaurants
+    ADD COLUMN IF NOT EXISTS cuisines VARCHAR(50)[] NOT NULL DEFAULT '{}';
+
+-- Existing rows are backfilled from model.Cuisines by the application, see
+-- restaurant-service reindex
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_cuisines ON restaurants USING GIN (cuisines);
@@ -0,0 +1,12 @@
+-- Phone key for duplicate detection: the last nine digits of the phone
+-- number, which match between the domestic ("03-...") and international
+-- ("+81 3-...") spellings. NULL when the number has fewer digits.
+ALTER TABLE restaurants
+    ADD COLUMN IF NOT EXISTS phone_key VARCHAR(9) GENERATED ALWAYS AS (
+        CASE WHEN LENGTH(regexp_replace(phone, '[^0-9]', '', 'g')) >= 9
+             THEN RIGHT(regexp_replace(phone, '[^0-9]', '', 'g'), 9)
+        END
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_phone_key
+    ON restaurants(phone_key) WHERE deleted_at IS NULL AND phone_key IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_restaurants_updated_at ON restaurants;
+
+CREATE TRIGGER update_restaurants_updated_at BEFORE UPDATE ON restaurants
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+DROP FUNCTION IF EXISTS update_restaurants_updated_at_column();
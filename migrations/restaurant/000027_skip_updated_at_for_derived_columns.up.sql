@@ -0,0 +1,27 @@
+-- updated_at tells when a restaurant's details last changed: the refresher
+-- picks stale restaurants by it, quick search checks its freshness and
+-- incremental syncs resend rows by it. Counters and columns derived from
+-- other columns are rewritten without the restaurant changing, so an update
+-- that only touches the columns named in the trigger arguments keeps
+-- updated_at. Generated columns are always ignored: they are not computed yet
+-- in NEW.
+CREATE OR REPLACE FUNCTION update_restaurants_updated_at_column()
+RETURNS TRIGGER AS $$
+DECLARE
+    ignored TEXT[] := TG_ARGV || ARRAY['updated_at', 'search_vector', 'phone_key'];
+BEGIN
+    IF (to_jsonb(NEW) - ignored) IS DISTINCT FROM (to_jsonb(OLD) - ignored) THEN
+        NEW.updated_at = NOW();
+    ELSE
+        NEW.updated_at = OLD.updated_at;
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS update_restaurants_updated_at ON restaurants;
+
+-- view_count is rolled up from the view counters; cuisines and search_text
+-- are rewritten by restaurant-service reindex
+CREATE TRIGGER update_restaurants_updated_at BEFORE UPDATE ON restaurants
+    FOR EACH ROW EXECUTE FUNCTION update_restaurants_updated_at_column('view_count', 'cuisines', 'search_text');
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_restaurants_updated_at ON restaurants;
+
+CREATE TRIGGER update_restaurants_updated_at BEFORE UPDATE ON restaurants
+    FOR EACH ROW EXECUTE FUNCTION update_restaurants_updated_at_column('view_count', 'cuisines', 'search_text');
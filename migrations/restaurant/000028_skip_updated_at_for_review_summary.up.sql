@@ -0,0 +1,8 @@
+-- review_rating and review_count are recomputed from the reviews on every
+-- post, edit, vote and moderation; like view_count they do not change the
+-- restaurant's updated_at (see migration 000027)
+DROP TRIGGER IF EXISTS update_restaurants_updated_at ON restaurants;
+
+CREATE TRIGGER update_restaurants_updated_at BEFORE UPDATE ON restaurants
+    FOR EACH ROW EXECUTE FUNCTION update_restaurants_updated_at_column(
+        'view_count', 'cuisines', 'search_text', 'review_rating', 'review_count');
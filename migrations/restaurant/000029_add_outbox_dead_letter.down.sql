@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_restaurant_outbox_dead_lettered_at;
+DROP INDEX IF EXISTS idx_restaurant_outbox_pending;
+CREATE INDEX idx_restaurant_outbox_pending ON restaurant_outbox(seq) WHERE published_at IS NULL;
+
+ALTER TABLE restaurant_outbox DROP COLUMN IF EXISTS dead_lettered_at;
@@ -0,0 +1,8 @@
+-- Events that keep failing to publish on their own are dead-lettered so that
+-- the relay moves past them. They stay in the outbox for inspection and are
+-- requeued by clearing dead_lettered_at and attempts.
+ALTER TABLE restaurant_outbox ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMP;
+
+DROP INDEX IF EXISTS idx_restaurant_outbox_pending;
+CREATE INDEX idx_restaurant_outbox_pending ON restaurant_outbox(seq) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+CREATE INDEX idx_restaurant_outbox_dead_lettered_at ON restaurant_outbox(dead_lettered_at) WHERE dead_lettered_at IS NOT NULL;
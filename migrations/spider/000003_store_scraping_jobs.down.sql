@@ -0,0 +1,20 @@
+DROP INDEX IF EXISTS idx_crawl_results_source_external_id;
+DROP INDEX IF EXISTS idx_crawl_results_job_external_id;
+
+-- Keep only the latest result of each listing so it is unique again
+DELETE FROM crawl_results r
+USING crawl_results newer
+WHERE r.source = newer.source
+  AND r.external_id = newer.external_id
+  AND (r.created_at, r.id) < (newer.created_at, newer.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_crawl_results_source_external_id
+    ON crawl_results(source, external_id);
+
+ALTER TABLE crawl_results DROP COLUMN IF EXISTS position;
+
+DROP INDEX IF EXISTS idx_crawl_jobs_google_id;
+
+ALTER TABLE crawl_jobs
+    DROP COLUMN IF EXISTS place_name,
+    DROP COLUMN IF EXISTS google_id;
@@ -0,0 +1,22 @@
+-- Scraping jobs look up Tabelog listings for one Google place
+ALTER TABLE crawl_jobs
+    ADD COLUMN IF NOT EXISTS google_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS place_name VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_crawl_jobs_google_id ON crawl_jobs(google_id, created_at DESC);
+
+-- Results are kept per job so job history survives re-scrapes; a result
+-- whose checksum matches the previous scrape of the same listing is marked
+-- 'duplicate'. position is the rank of the result within its job.
+ALTER TABLE crawl_results
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+
+DROP INDEX IF EXISTS idx_crawl_results_source_external_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_crawl_results_job_external_id
+    ON crawl_results(job_id, source, external_id);
+CREATE INDEX IF NOT EXISTS idx_crawl_results_source_external_id
+    ON crawl_results(source, external_id, created_at DESC);
+
+COMMENT ON COLUMN crawl_jobs.google_id IS 'Google Place ID the job looks up Tabelog listings for';
+COMMENT ON COLUMN crawl_results.position IS 'Rank of the result within its job';
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -29,6 +30,24 @@ type Config struct {
 
 	// Map Service integration (for Restaurant Service)
 	MapService MapServiceConfig
+
+	// Spider Service integration (for Restaurant Service)
+	SpiderService SpiderServiceConfig
+
+	// Photo storage (for Restaurant Service)
+	Photos PhotoConfig
+
+	// Popularity counters and trending ranking (for Restaurant Service)
+	Popularity PopularityConfig
+
+	// Domain event outbox and relay (for Restaurant Service)
+	Events EventsConfig
+
+	// Retention of deleted restaurants (for Restaurant Service)
+	Deletion DeletionConfig
+
+	// Currency conversion of prices (for Restaurant Service)
+	Pricing PricingConfig
 }
 
 // MapServiceConfig holds Map Service integration configuration
@@ -36,6 +55,56 @@ type MapServiceConfig struct {
 	GRPCAddr         string        `env:"MAP_SERVICE_GRPC_ADDR" envDefault:"map-service:19083"`
 	Timeout          time.Duration `env:"MAP_SERVICE_TIMEOUT" envDefault:"10s"`
 	DataFreshnessTTL time.Duration `env:"DATA_FRESHNESS_TTL" envDefault:"259200s"` // 3 days
+
+	// Background refresh of stale restaurants
+	RefreshInterval  time.Duration `env:"DATA_REFRESH_INTERVAL" envDefault:"1h"` // 0 disables the scheduled refresh
+	RefreshBatchSize int           `env:"DATA_REFRESH_BATCH_SIZE" envDefault:"20"`
+	RefreshQuota     int           `env:"DATA_REFRESH_QUOTA" envDefault:"200"` // max place lookups per run, one per place and language
+	RefreshDryRun    bool          `env:"DATA_REFRESH_DRY_RUN" envDefault:"false"`
+}
+
+// SpiderServiceConfig holds Spider Service integration configuration
+type SpiderServiceConfig struct {
+	GRPCAddr string        `env:"SPIDER_SERVICE_GRPC_ADDR" envDefault:"spider-service:19084"`
+	Timeout  time.Duration `env:"SPIDER_SERVICE_TIMEOUT" envDefault:"30s"` // a search scrapes Tabelog
+}
+
+// PhotoConfig holds restaurant photo storage configuration
+type PhotoConfig struct {
+	StorageDir     string        `env:"PHOTO_STORAGE_DIR" envDefault:"./data/photos"`
+	SigningKey     string        `env:"PHOTO_URL_SIGNING_KEY"` // required by the restaurant service, must differ from JWT_SECRET
+	URLTTL         time.Duration `env:"PHOTO_URL_TTL" envDefault:"24h"`
+	ThumbnailWidth int           `env:"PHOTO_THUMBNAIL_WIDTH" envDefault:"320"`
+}
+
+// PopularityConfig holds restaurant popularity configuration
+type PopularityConfig struct {
+	RollupInterval   time.Duration `env:"POPULARITY_ROLLUP_INTERVAL" envDefault:"5m"` // 0 disables the rollup
+	TrendingHalfLife time.Duration `env:"TRENDING_HALF_LIFE" envDefault:"48h"`
+}
+
+// EventsConfig holds domain event publishing configuration
+type EventsConfig struct {
+	Topic           string        `env:"EVENTS_TOPIC" envDefault:"restaurant.events"`
+	RelayInterval   time.Duration `env:"EVENTS_RELAY_INTERVAL" envDefault:"1s"` // 0 disables the outbox relay
+	RelayBatchSize  int           `env:"EVENTS_RELAY_BATCH_SIZE" envDefault:"100"`
+	MaxAttempts     int           `env:"EVENTS_MAX_ATTEMPTS" envDefault:"20"`       // 0 retries a failing event forever
+	OutboxRetention time.Duration `env:"EVENTS_OUTBOX_RETENTION" envDefault:"168h"` // 0 keeps published events
+}
+
+// DeletionConfig holds soft-deleted restaurant retention configuration
+type DeletionConfig struct {
+	Retention     time.Duration `env:"DELETED_RESTAURANT_RETENTION" envDefault:"720h"`    // 0 keeps deleted restaurants
+	PurgeInterval time.Duration `env:"DELETED_RESTAURANT_PURGE_INTERVAL" envDefault:"1h"` // 0 disables the scheduled purge
+}
+
+// defaultCurrencyRates are indicative units of each currency per yen
+const defaultCurrencyRates = "USD=0.0067,EUR=0.0062,GBP=0.0053,CNY=0.048,KRW=9.2,TWD=0.21"
+
+// PricingConfig holds restaurant price conversion configuration
+type PricingConfig struct {
+	// Units of each currency per yen, e.g. "USD=0.0067,EUR=0.0062"
+	CurrencyRates string `env:"PRICE_CURRENCY_RATES" envDefault:"USD=0.0067,EUR=0.0062,GBP=0.0053,CNY=0.048,KRW=9.2,TWD=0.21"`
 }
 
 // DatabaseConfig holds database configuration
@@ -127,6 +196,50 @@ func LoadWithPrefix(prefix string) (*Config, error) {
 		GRPCAddr:         getEnvWithDefault(buildEnvKey(prefix, "MAP_SERVICE_GRPC_ADDR"), "map-service:19083"),
 		Timeout:          getEnvAsDuration(buildEnvKey(prefix, "MAP_SERVICE_TIMEOUT"), 10*time.Second),
 		DataFreshnessTTL: getEnvAsDuration(buildEnvKey(prefix, "DATA_FRESHNESS_TTL"), 259200*time.Second), // 3 days
+		RefreshInterval:  getEnvAsDuration(buildEnvKey(prefix, "DATA_REFRESH_INTERVAL"), time.Hour),
+		RefreshBatchSize: getEnvAsInt(buildEnvKey(prefix, "DATA_REFRESH_BATCH_SIZE"), 20),
+		RefreshQuota:     getEnvAsInt(buildEnvKey(prefix, "DATA_REFRESH_QUOTA"), 200),
+		RefreshDryRun:    getEnvAsBool(buildEnvKey(prefix, "DATA_REFRESH_DRY_RUN"), false),
+	}
+
+	// Load Spider Service config (for Restaurant Service)
+	cfg.SpiderService = SpiderServiceConfig{
+		GRPCAddr: getEnvWithDefault(buildEnvKey(prefix, "SPIDER_SERVICE_GRPC_ADDR"), "spider-service:19084"),
+		Timeout:  getEnvAsDuration(buildEnvKey(prefix, "SPIDER_SERVICE_TIMEOUT"), 30*time.Second),
+	}
+
+	// Load photo storage config (for Restaurant Service)
+	cfg.Photos = PhotoConfig{
+		StorageDir:     getEnvWithDefault(buildEnvKey(prefix, "PHOTO_STORAGE_DIR"), "./data/photos"),
+		SigningKey:     getEnvWithDefault(buildEnvKey(prefix, "PHOTO_URL_SIGNING_KEY"), ""),
+		URLTTL:         getEnvAsDuration(buildEnvKey(prefix, "PHOTO_URL_TTL"), 24*time.Hour),
+		ThumbnailWidth: getEnvAsInt(buildEnvKey(prefix, "PHOTO_THUMBNAIL_WIDTH"), 320),
+	}
+
+	// Load popularity config (for Restaurant Service)
+	cfg.Popularity = PopularityConfig{
+		RollupInterval:   getEnvAsDuration(buildEnvKey(prefix, "POPULARITY_ROLLUP_INTERVAL"), 5*time.Minute),
+		TrendingHalfLife: getEnvAsDuration(buildEnvKey(prefix, "TRENDING_HALF_LIFE"), 48*time.Hour),
+	}
+
+	// Load domain events config (for Restaurant Service)
+	cfg.Events = EventsConfig{
+		Topic:           getEnvWithDefault(buildEnvKey(prefix, "EVENTS_TOPIC"), "restaurant.events"),
+		RelayInterval:   getEnvAsDuration(buildEnvKey(prefix, "EVENTS_RELAY_INTERVAL"), time.Second),
+		RelayBatchSize:  getEnvAsInt(buildEnvKey(prefix, "EVENTS_RELAY_BATCH_SIZE"), 100),
+		MaxAttempts:     getEnvAsInt(buildEnvKey(prefix, "EVENTS_MAX_ATTEMPTS"), 20),
+		OutboxRetention: getEnvAsDuration(buildEnvKey(prefix, "EVENTS_OUTBOX_RETENTION"), 7*24*time.Hour),
+	}
+
+	// Load deleted restaurant retention config (for Restaurant Service)
+	cfg.Deletion = DeletionConfig{
+		Retention:     getEnvAsDuration(buildEnvKey(prefix, "DELETED_RESTAURANT_RETENTION"), 30*24*time.Hour),
+		PurgeInterval: getEnvAsDuration(buildEnvKey(prefix, "DELETED_RESTAURANT_PURGE_INTERVAL"), time.Hour),
+	}
+
+	// Load pricing config (for Restaurant Service)
+	cfg.Pricing = PricingConfig{
+		CurrencyRates: getEnvWithDefault(buildEnvKey(prefix, "PRICE_CURRENCY_RATES"), defaultCurrencyRates),
 	}
 
 	// Validate required fields
@@ -206,6 +319,11 @@ func (c *Config) Validate() error {
 			c.JWT.RefreshTokenExpire, c.JWT.AccessTokenExpire)
 	}
 
+	// Validate pricing configuration
+	if _, err := parseCurrencyRates(c.Pricing.CurrencyRates); err != nil {
+		return err
+	}
+
 	// Validate environment
 	validEnvs := map[string]bool{
 		"development": true,
@@ -258,6 +376,37 @@ func (c *Config) GetKafkaBrokers() []string {
 	return result
 }
 
+// Rates returns the currency rates as a map of currency code to units per
+// yen. Invalid entries, which Validate reports, are left out.
+func (p PricingConfig) Rates() map[string]float64 {
+	rates, _ := parseCurrencyRates(p.CurrencyRates)
+	return rates
+}
+
+// parseCurrencyRates parses a comma-separated list of CODE=rate entries
+func parseCurrencyRates(s string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	var invalid []string
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		code, value, ok := strings.Cut(entry, "=")
+		code = strings.ToUpper(strings.TrimSpace(code))
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if !ok || len(code) != 3 || err != nil || rate <= 0 {
+			invalid = append(invalid, entry)
+			continue
+		}
+		rates[code] = rate
+	}
+	if len(invalid) > 0 {
+		return rates, fmt.Errorf("PRICE_CURRENCY_RATES entries must be CODE=positive rate, got %s", strings.Join(invalid, ", "))
+	}
+	return rates, nil
+}
+
 // IsDevelopment returns true if environment is development
 func (c *Config) IsDevelopment() bool {
 	return c.Environment == "development"
@@ -274,6 +274,27 @@ func TestGetKafkaBrokers(t *testing.T) {
 	}
 }
 
+func TestPricingConfigRates(t *testing.T) {
+	pricing := PricingConfig{CurrencyRates: "usd=0.0067, EUR=0.0062,,KRW=9.2"}
+
+	rates := pricing.Rates()
+	want := map[string]float64{"USD": 0.0067, "EUR": 0.0062, "KRW": 9.2}
+	if len(rates) != len(want) {
+		t.Fatalf("Rates() = %v, want %v", rates, want)
+	}
+	for code, rate := range want {
+		if rates[code] != rate {
+			t.Errorf("Rates()[%s] = %v, want %v", code, rates[code], rate)
+		}
+	}
+
+	for _, invalid := range []string{"USD", "USD=abc", "USD=-1", "DOLLAR=1"} {
+		if _, err := parseCurrencyRates(invalid); err == nil {
+			t.Errorf("parseCurrencyRates(%q) expected error", invalid)
+		}
+	}
+}
+
 func TestEnvironmentChecks(t *testing.T) {
 	tests := []struct {
 		name        string
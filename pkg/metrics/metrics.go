@@ -113,4 +113,67 @@ var (
 			Help: "Total number of times stale data was returned due to Map Service failure",
 		},
 	)
+
+	// Restaurant Service - Background Refresh Metrics
+	RestaurantRefreshRunsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "restaurant_refresh_runs_total",
+			Help: "Total number of stale restaurant refresh runs",
+		},
+		[]string{"mode", "status"}, // mode: live, dry_run; status: success, error
+	)
+
+	RestaurantRefreshedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "restaurant_refreshed_total",
+			Help: "Total number of restaurants processed by the stale refresh",
+		},
+		[]string{"result"}, // result: refreshed, missing, failed
+	)
+
+	RestaurantRefreshDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "restaurant_refresh_duration_seconds",
+			Help:    "Duration of stale restaurant refresh runs",
+			Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600},
+		},
+	)
+
+	RestaurantStaleCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "restaurant_stale_count",
+			Help: "Number of restaurants past the data freshness TTL at the start of the last refresh run",
+		},
+	)
+
+	// Restaurant Service - Domain Event Metrics
+	RestaurantEventsPublishedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "restaurant_events_published_total",
+			Help: "Total number of domain events published from the outbox",
+		},
+		[]string{"type"}, // type: restaurant.created, favorite.added, ...
+	)
+
+	RestaurantEventPublishFailuresTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "restaurant_event_publish_failures_total",
+			Help: "Total number of outbox batches that failed to publish",
+		},
+	)
+
+	RestaurantEventsDeadLetteredTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "restaurant_events_dead_lettered_total",
+			Help: "Total number of domain events given up on after failing to publish too many times",
+		},
+		[]string{"type"},
+	)
+
+	RestaurantOutboxPending = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "restaurant_outbox_pending",
+			Help: "Number of domain events waiting in the outbox after the last relay run",
+		},
+	)
 )